@@ -10,50 +10,19 @@ DIR="$(cd "$(dirname "$0")" && pwd)/.."
 function buildAndInstallDependencies {
   cd "${DIR}"
 
-  echo "Building and Installing the Data API onto the GOPATH"
-  cd ./tools/data-api
-  go install
-  cd "${DIR}"
-
   echo "Building and Installing the Data API Differ onto the GOPATH"
   cd ./tools/data-api-differ
   go install
   cd "${DIR}"
 }
 
-function checkoutAPIDefinitionsFromMainInto {
-  local workingDirectory="$1"
-  local existingPandoraDirectory="${DIR}"
-
+function fetchMain {
   cd "${DIR}"
 
+  # the initial API Definitions are read from 'origin/main' within the git object database, so a secondary
+  # checkout of the repository isn't required
   echo "Fetching the current 'main'.."
   git fetch origin main
-
-  # This is to enable running this script locally as well as in automation
-  echo "Removing any temporary existing 'upstream-main' branch.."
-  git branch -D upstream-main || true
-  echo "Creating a temporary 'upstream-main' branch tracking 'origin/main'.."
-  git branch upstream-main origin/main
-
-  echo "Outputting the available branches.."
-  git branch
-
-  echo "Outputting git status.."
-  git status
-
-  echo "Removing any existing directory at ${workingDirectory}.."
-  rm -rf "$workingDirectory"
-
-  echo "Checking out a secondary copy of hashicorp/pandora from this repository.."
-  git clone --depth 1 --branch upstream-main "file://$existingPandoraDirectory" "$workingDirectory"
-  cd "$workingDirectory"
-
-  echo "Resetting the secondary working directory"
-  git reset --hard
-
-  echo "Returning to the original working directory.."
-  cd "${DIR}"
 }
 
 function ensureDirectoryExists {
@@ -67,49 +36,48 @@ function ensureDirectoryExists {
 }
 
 function runBreakingChangeDetector {
-  local initialApiDefinitionsDirectory="$1"
+  local initialApiDefinitionsDataSource="$1"
   local updatedApiDefinitionsDirectory="$2"
   local sourceDataType="$3"
   local outputFilePath="$4"
 
-  echo "Detecting Breaking Changes between ${initialApiDefinitionsDirectory} and ${updatedApiDefinitionsDirectory}.."
-  data-api-differ "${sourceDataType}" detect-breaking-changes --initial-path="${initialApiDefinitionsDirectory}" --updated-path="${updatedApiDefinitionsDirectory}" --output-file-path="${outputFilePath}"
+  echo "Detecting Breaking Changes between ${initialApiDefinitionsDataSource} and ${updatedApiDefinitionsDirectory}.."
+  data-api-differ "${sourceDataType}" detect-breaking-changes --initial-data-source="${initialApiDefinitionsDataSource}" --updated-path="${updatedApiDefinitionsDirectory}" --output-file-path="${outputFilePath}"
 }
 
 function runChangeDetector {
-  local initialApiDefinitionsDirectory="$1"
+  local initialApiDefinitionsDataSource="$1"
   local updatedApiDefinitionsDirectory="$2"
   local sourceDataType="$3"
   local outputFilePath="$4"
 
-  echo "Detecting Changes between ${initialApiDefinitionsDirectory} and ${updatedApiDefinitionsDirectory}.."
-  data-api-differ "${sourceDataType}" detect-changes --initial-path="${initialApiDefinitionsDirectory}" --updated-path="${updatedApiDefinitionsDirectory}" --output-file-path="${outputFilePath}"
+  echo "Detecting Changes between ${initialApiDefinitionsDataSource} and ${updatedApiDefinitionsDirectory}.."
+  data-api-differ "${sourceDataType}" detect-changes --initial-data-source="${initialApiDefinitionsDataSource}" --updated-path="${updatedApiDefinitionsDirectory}" --output-file-path="${outputFilePath}"
 }
 
 function runStaticIdentifierDetector {
-  local initialApiDefinitionsDirectory="$1"
+  local initialApiDefinitionsDataSource="$1"
   local updatedApiDefinitionsDirectory="$2"
   local sourceDataType="$3"
   local outputFilePath="$4"
 
-  echo "Detecting any new Static Identifiers between ${initialApiDefinitionsDirectory} and ${updatedApiDefinitionsDirectory}.."
-  data-api-differ "${sourceDataType}" output-resource-id-segments --initial-path="${initialApiDefinitionsDirectory}" --updated-path="${updatedApiDefinitionsDirectory}" --output-file-path="${outputFilePath}"
+  echo "Detecting any new Static Identifiers between ${initialApiDefinitionsDataSource} and ${updatedApiDefinitionsDirectory}.."
+  data-api-differ "${sourceDataType}" output-resource-id-segments --initial-data-source="${initialApiDefinitionsDataSource}" --updated-path="${updatedApiDefinitionsDirectory}" --output-file-path="${outputFilePath}"
 }
 
 function main {
-  local tempDirectory="./pandora-from-main"
-  local initialApiDefinitionsDirectory="${tempDirectory}/api-definitions"
+  local initialApiDefinitionsDataSource="git:origin/main:api-definitions"
   local updatedApiDefinitionsDirectory="${DIR}/api-definitions"
   local sourceDataType="$1"
   local outputDirectory="$2"
 
   buildAndInstallDependencies
-  checkoutAPIDefinitionsFromMainInto "$tempDirectory"
+  fetchMain
   ensureDirectoryExists "$outputDirectory"
 
-  runBreakingChangeDetector "$initialApiDefinitionsDirectory" "$updatedApiDefinitionsDirectory" "$sourceDataType" "${outputDirectory}/resource-manager-breaking-changes.md"
-  runChangeDetector "$initialApiDefinitionsDirectory" "$updatedApiDefinitionsDirectory" "$sourceDataType" "${outputDirectory}/resource-manager-changes.md"
-  runStaticIdentifierDetector "$initialApiDefinitionsDirectory" "$updatedApiDefinitionsDirectory" "$sourceDataType" "${outputDirectory}/resource-manager-static-identifiers.md"
+  runBreakingChangeDetector "$initialApiDefinitionsDataSource" "$updatedApiDefinitionsDirectory" "$sourceDataType" "${outputDirectory}/resource-manager-breaking-changes.md"
+  runChangeDetector "$initialApiDefinitionsDataSource" "$updatedApiDefinitionsDirectory" "$sourceDataType" "${outputDirectory}/resource-manager-changes.md"
+  runStaticIdentifierDetector "$initialApiDefinitionsDataSource" "$updatedApiDefinitionsDirectory" "$sourceDataType" "${outputDirectory}/resource-manager-static-identifiers.md"
 }
 
 main "$1" "$2"
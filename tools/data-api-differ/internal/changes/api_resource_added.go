@@ -8,16 +8,30 @@ var _ Change = ApiResourceAdded{}
 // ApiResourceAdded defines information about an API Resource that has been added.
 type ApiResourceAdded struct {
 	// ServiceName specifies the name of the Service which contains this API Resource.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this API Resource.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Resource.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ApiResourceAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ApiResourceAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ApiResourceAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -8,16 +8,30 @@ var _ Change = ApiResourceRemoved{}
 // ApiResourceRemoved defines information about an API Resource which has been removed.
 type ApiResourceRemoved struct {
 	// ServiceName specifies the name of the Service which contained this API Resource.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contained this API Resource.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contained this Resource.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ApiResourceRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ApiResourceRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ApiResourceRemoved) Category() Category {
+	return SdkFixCategory
+}
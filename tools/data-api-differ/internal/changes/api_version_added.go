@@ -8,13 +8,26 @@ var _ Change = ApiVersionAdded{}
 // ApiVersionAdded defines information about a new API Version for an existing Service.
 type ApiVersionAdded struct {
 	// ServiceName specifies the name of this Service (e.g. `Compute`).
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the API Version (e.g. `2023-01-01-preview`).
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ApiVersionAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ApiVersionAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName: c.ServiceName,
+		ApiVersion:  c.ApiVersion,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ApiVersionAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -9,13 +9,26 @@ var _ Change = ApiVersionRemoved{}
 // supported for an existing Service but is no longer present.
 type ApiVersionRemoved struct {
 	// ServiceName specifies the name of this Service (e.g. `Compute`).
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the API Version (e.g. `2023-01-01-preview`).
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ApiVersionRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ApiVersionRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName: c.ServiceName,
+		ApiVersion:  c.ApiVersion,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ApiVersionRemoved) Category() Category {
+	return SdkFixCategory
+}
@@ -9,4 +9,85 @@ package changes
 type Change interface {
 	// IsBreaking returns whether this Change is considered a Breaking Change.
 	IsBreaking() bool
+
+	// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+	Identifiers() Identifiers
+
+	// Category returns whether this Change is to the Go SDK or to the Terraform Definitions - and for the Go SDK,
+	// whether this Change adds new functionality.
+	Category() Category
+}
+
+// Category specifies whether a Change is to the Go SDK or to the Terraform Definitions - and for the Go SDK,
+// whether the Change adds new functionality (which determines how a Non-Breaking Change is released).
+type Category string
+
+const (
+	// SdkFeatureCategory specifies that the Change adds new functionality to the Go SDK.
+	SdkFeatureCategory Category = "SdkFeature"
+
+	// SdkFixCategory specifies any other Change to the Go SDK, which is considered a Bug Fix when it's
+	// not a Breaking Change.
+	SdkFixCategory Category = "SdkFix"
+
+	// TerraformCategory specifies that the Change is to the Terraform Definitions, which don't affect the Go SDK.
+	TerraformCategory Category = "Terraform"
+)
+
+// Identifiers contains the names identifying the item changed by a Change (e.g. the name of a Constant, or the names
+// of a Model and Field) and where that item is located - any names which don't apply to the Change are empty.
+type Identifiers struct {
+	// ServiceName specifies the name of the Service containing the item.
+	ServiceName string
+
+	// ApiVersion specifies the name of the API Version containing the item.
+	ApiVersion string
+
+	// ResourceName specifies the name of the API Resource containing the item.
+	ResourceName string
+
+	// ResourceLabel specifies the label of the Terraform Resource containing the item.
+	ResourceLabel string
+
+	// ConstantName specifies the name of the Constant.
+	ConstantName string
+
+	// ModelName specifies the name of the Model (or for a Field, the Model containing the Field).
+	ModelName string
+
+	// FieldName specifies the name of the Field.
+	FieldName string
+
+	// OperationName specifies the name of the Operation (or for an Option, the Operation containing the Option).
+	OperationName string
+
+	// OptionName specifies the name of the Option.
+	OptionName string
+
+	// ResourceIdName specifies the name of the Resource ID.
+	ResourceIdName string
+
+	// SchemaModelName specifies the name of the Terraform Schema Model (or for a Field, the Schema Model containing
+	// the Field).
+	SchemaModelName string
+
+	// TestName specifies the name of the Terraform Test.
+	TestName string
+
+	// Mapping specifies the human-readable description of the Terraform Mapping.
+	Mapping string
+}
+
+// CommonTypeChange is implemented by the Changes which can be raised for a Common Type (that is, Constants, Models and
+// Fields) - which are attributed to the Services referencing the Common Type.
+type CommonTypeChange interface {
+	Change
+
+	// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+	WithReferencedByServices(referencedByServices []string) Change
 }
+
+// CommonTypesServiceName is used as the ServiceName for Changes to the Common Types, which exist across the
+// entire API rather than being scoped to a Service - in which case the ApiVersion and ResourceName are empty
+// and the Services referencing the Common Type are listed in ReferencedByServices.
+const CommonTypesServiceName = "Common Types"
@@ -3,30 +3,55 @@
 
 package changes
 
-var _ Change = ConstantKeyValueAdded{}
+var _ CommonTypeChange = ConstantKeyValueAdded{}
 
 // ConstantKeyValueAdded specifies when a new Key/Value combination is added to an existing Constant.
 type ConstantKeyValueAdded struct {
 	// ServiceName specifies the name of the Service which contains this Constant.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Constant.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Constant.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ConstantName specifies the name of the Constant which has been updated.
-	ConstantName string
+	ConstantName string `json:"constantName"`
 
 	// ConstantKey specifies the key for this new Constant Key/Value.
-	ConstantKey string
+	ConstantKey string `json:"constantKey"`
 
 	// ConstantValue specifies the value for this new Constant Key/Value.
-	ConstantValue string
+	ConstantValue string `json:"constantValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Constant, when this Constant
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ConstantKeyValueAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ConstantKeyValueAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ConstantName: c.ConstantName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ConstantKeyValueAdded) Category() Category {
+	return SdkFeatureCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ConstantKeyValueAdded) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
@@ -3,33 +3,58 @@
 
 package changes
 
-var _ Change = ConstantKeyValueChanged{}
+var _ CommonTypeChange = ConstantKeyValueChanged{}
 
 // ConstantKeyValueChanged specifies when Constant Key has a new Value
 type ConstantKeyValueChanged struct {
 	// ServiceName specifies the name of the Service which contains this Constant.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Constant.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Constant.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ConstantName specifies the name of the Constant which has an updated value.
-	ConstantName string
+	ConstantName string `json:"constantName"`
 
 	// ConstantKey specifies the key within the Constant which has changed.
-	ConstantKey string
+	ConstantKey string `json:"constantKey"`
 
 	// OldConstantValue specifies the old Value for this Constant Key.
-	OldConstantValue string
+	OldConstantValue string `json:"oldConstantValue"`
 
 	// NewConstantValue specifies the new/updated Value for this Constant Key.
-	NewConstantValue string
+	NewConstantValue string `json:"newConstantValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Constant, when this Constant
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ConstantKeyValueChanged) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ConstantKeyValueChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ConstantName: c.ConstantName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ConstantKeyValueChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ConstantKeyValueChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
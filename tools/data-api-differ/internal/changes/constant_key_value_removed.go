@@ -3,30 +3,55 @@
 
 package changes
 
-var _ Change = ConstantKeyValueRemoved{}
+var _ CommonTypeChange = ConstantKeyValueRemoved{}
 
 // ConstantKeyValueRemoved specifies when a Key/Value combination is removed to an existing Constant.
 type ConstantKeyValueRemoved struct {
 	// ServiceName specifies the name of the Service which contains this Constant.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Constant.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Constant.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ConstantName specifies the name of the Constant which has been updated.
-	ConstantName string
+	ConstantName string `json:"constantName"`
 
 	// ConstantKey specifies the key for the Constant Key/Value which has been removed.
-	ConstantKey string
+	ConstantKey string `json:"constantKey"`
 
 	// ConstantValue specifies the value for the Constant Key/Value which has been removed
-	ConstantValue string
+	ConstantValue string `json:"constantValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Constant, when this Constant
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ConstantKeyValueRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ConstantKeyValueRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ConstantName: c.ConstantName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ConstantKeyValueRemoved) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ConstantKeyValueRemoved) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
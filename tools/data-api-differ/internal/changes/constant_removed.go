@@ -3,30 +3,55 @@
 
 package changes
 
-var _ Change = ConstantRemoved{}
+var _ CommonTypeChange = ConstantRemoved{}
 
 // ConstantRemoved defines information about a Constant which has been removed.
 type ConstantRemoved struct {
 	// ServiceName specifies the name of the Service which contained this Constant.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contained this Constant.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contained this Constant.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ConstantName specifies the name of the Constant which has been removed.
-	ConstantName string
+	ConstantName string `json:"constantName"`
 
 	// ConstantType specifies the type of Constant (e.g. Int/String) that this is.
-	ConstantType string
+	ConstantType string `json:"constantType"`
 
 	// KeysAndValues specifies the Keys and Values for the Constant which has been removed.
-	KeysAndValues map[string]string
+	KeysAndValues map[string]string `json:"keysAndValues"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Constant, when this Constant
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ConstantRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ConstantRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ConstantName: c.ConstantName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ConstantRemoved) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ConstantRemoved) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
@@ -3,27 +3,31 @@
 
 package changes
 
-var _ Change = ConstantTypeChanged{}
+var _ CommonTypeChange = ConstantTypeChanged{}
 
 // ConstantTypeChanged specifies when a Constant has changed Type (e.g. `int` -> `string`)
 type ConstantTypeChanged struct {
 	// ServiceName specifies the name of the Service which contains this Constant.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Constant.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Constant.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ConstantName specifies the name of the Constant which has changed.
-	ConstantName string
+	ConstantName string `json:"constantName"`
 
 	// OldType specifies the old type value for this Constant
-	OldType string
+	OldType string `json:"oldType"`
 
 	// NewType specifies the new/updated type value for this Constant
-	NewType string
+	NewType string `json:"newType"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Constant, when this Constant
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -32,3 +36,24 @@ func (c ConstantTypeChanged) IsBreaking() bool {
 	// If a constant changes type, this is going to require code changes to account for this
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ConstantTypeChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ConstantName: c.ConstantName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ConstantTypeChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ConstantTypeChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
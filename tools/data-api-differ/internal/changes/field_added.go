@@ -3,27 +3,53 @@
 
 package changes
 
-var _ Change = FieldAdded{}
+var _ CommonTypeChange = FieldAdded{}
 
 // FieldAdded defines information about a new Field.
 type FieldAdded struct {
 	// ServiceName specifies the name of the Service which contains this Field.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Field.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Field.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which contains this Field.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// FieldName specifies the name of the Field which has been added.
-	FieldName string
+	FieldName string `json:"fieldName"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (FieldAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldAdded) Category() Category {
+	return SdkFeatureCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldAdded) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
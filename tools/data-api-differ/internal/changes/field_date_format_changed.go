@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ CommonTypeChange = FieldDateFormatChanged{}
+
+// FieldDateFormatChanged defines when the DateFormat for an existing Field within an existing Model changes
+// (including where a DateFormat has been added or removed).
+type FieldDateFormatChanged struct {
+	// ServiceName specifies the name of the Service which contains this Field.
+	ServiceName string `json:"serviceName"`
+
+	// ApiVersion specifies the name of the API Version which contains this Field.
+	ApiVersion string `json:"apiVersion"`
+
+	// ResourceName specifies the name of the API Resource which contains this Field.
+	ResourceName string `json:"resourceName"`
+
+	// ModelName specifies the name of the Model which contains this Field.
+	ModelName string `json:"modelName"`
+
+	// FieldName specifies the name of the Field which has an updated DateFormat.
+	FieldName string `json:"fieldName"`
+
+	// OldValue specifies the old/existing DateFormat for this Field, or an empty string if unset.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated DateFormat for this Field, or an empty string if unset.
+	NewValue string `json:"newValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (FieldDateFormatChanged) IsBreaking() bool {
+	// The DateFormat determines how this value is parsed/formatted in the generated SDK, meaning that
+	// existing values may no longer be parsed/formatted as expected.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldDateFormatChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldDateFormatChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldDateFormatChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
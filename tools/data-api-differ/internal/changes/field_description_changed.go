@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ CommonTypeChange = FieldDescriptionChanged{}
+
+// FieldDescriptionChanged defines when the Description for an existing Field within an existing Model changes.
+type FieldDescriptionChanged struct {
+	// ServiceName specifies the name of the Service which contains this Field.
+	ServiceName string `json:"serviceName"`
+
+	// ApiVersion specifies the name of the API Version which contains this Field.
+	ApiVersion string `json:"apiVersion"`
+
+	// ResourceName specifies the name of the API Resource which contains this Field.
+	ResourceName string `json:"resourceName"`
+
+	// ModelName specifies the name of the Model which contains this Field.
+	ModelName string `json:"modelName"`
+
+	// FieldName specifies the name of the Field which has an updated Description.
+	FieldName string `json:"fieldName"`
+
+	// OldValue specifies the old/existing Description for this Field.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated Description for this Field.
+	NewValue string `json:"newValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (FieldDescriptionChanged) IsBreaking() bool {
+	// The Description is only used for documentation purposes.
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldDescriptionChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldDescriptionChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldDescriptionChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
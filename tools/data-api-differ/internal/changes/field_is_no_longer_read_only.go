@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ CommonTypeChange = FieldIsNoLongerReadOnly{}
+
+// FieldIsNoLongerReadOnly defines when an existing Field within an existing Model is no longer ReadOnly.
+type FieldIsNoLongerReadOnly struct {
+	// ServiceName specifies the name of the Service which contains this Field.
+	ServiceName string `json:"serviceName"`
+
+	// ApiVersion specifies the name of the API Version which contains this Field.
+	ApiVersion string `json:"apiVersion"`
+
+	// ResourceName specifies the name of the API Resource which contains this Field.
+	ResourceName string `json:"resourceName"`
+
+	// ModelName specifies the name of the Model which contains this Field.
+	ModelName string `json:"modelName"`
+
+	// FieldName specifies the name of the Field which is no longer ReadOnly.
+	FieldName string `json:"fieldName"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (FieldIsNoLongerReadOnly) IsBreaking() bool {
+	// This Field can now be sent in Requests, which is additive.
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldIsNoLongerReadOnly) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldIsNoLongerReadOnly) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldIsNoLongerReadOnly) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
@@ -3,25 +3,29 @@
 
 package changes
 
-var _ Change = FieldIsNowOptional{}
+var _ CommonTypeChange = FieldIsNowOptional{}
 
 // FieldIsNowOptional defines a change where an existing Field in an existing Model
 // has become Optional.
 type FieldIsNowOptional struct {
 	// ServiceName specifies the name of the Service which contains this Field.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Field.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Field.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which contains this Field.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// FieldName specifies the name of the Field which is now Optional.
-	FieldName string
+	FieldName string `json:"fieldName"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -30,3 +34,25 @@ func (FieldIsNowOptional) IsBreaking() bool {
 	// making this field a pointer in the Go SDK - meaning this will require code changes.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldIsNowOptional) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldIsNowOptional) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldIsNowOptional) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
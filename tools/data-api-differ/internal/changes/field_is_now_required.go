@@ -3,25 +3,29 @@
 
 package changes
 
-var _ Change = FieldIsNowRequired{}
+var _ CommonTypeChange = FieldIsNowRequired{}
 
 // FieldIsNowRequired defines a change where an existing Field in an existing Model
 // has become Required.
 type FieldIsNowRequired struct {
 	// ServiceName specifies the name of the Service which contains this Field.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Field.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Field.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which contains this Field.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// FieldName specifies the name of the Field which is now Required.
-	FieldName string
+	FieldName string `json:"fieldName"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -31,3 +35,25 @@ func (FieldIsNowRequired) IsBreaking() bool {
 	// code changes.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldIsNowRequired) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldIsNowRequired) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldIsNowRequired) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
@@ -3,31 +3,35 @@
 
 package changes
 
-var _ Change = FieldJsonNameChanged{}
+var _ CommonTypeChange = FieldJsonNameChanged{}
 
 // FieldJsonNameChanged defines when the JsonName for an existing Field within an existing Model
 // changes - indicating this field represents a different field in the API Request/Response.
 type FieldJsonNameChanged struct {
 	// ServiceName specifies the name of the Service which contains this Field.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Field.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Field.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which contains this Field.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// FieldName specifies the name of the Field which has an updated JsonName.
-	FieldName string
+	FieldName string `json:"fieldName"`
 
 	// OldValue specifies the old/existing JsonName for this Field.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated JsonName for this Field.
-	NewValue string
+	NewValue string `json:"newValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 func (FieldJsonNameChanged) IsBreaking() bool {
@@ -36,3 +40,25 @@ func (FieldJsonNameChanged) IsBreaking() bool {
 	// As such this requires additional investigation.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldJsonNameChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldJsonNameChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldJsonNameChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
@@ -3,34 +3,60 @@
 
 package changes
 
-var _ Change = FieldObjectDefinitionChanged{}
+var _ CommonTypeChange = FieldObjectDefinitionChanged{}
 
 // FieldObjectDefinitionChanged defines when an existing Field within an existing Model gets an
 // updated ObjectDefinition (e.g. a String becomes a Constant).
 type FieldObjectDefinitionChanged struct {
 	// ServiceName specifies the name of the Service which contains this Field.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Field.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Field.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which contains this Field.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// FieldName specifies the name of the Field which has an updated Object Definition.
-	FieldName string
+	FieldName string `json:"fieldName"`
 
 	// OldValue specifies the old/existing ObjectDefinition for this Field.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated ObjectDefinition for this Field.
-	NewValue string
+	NewValue string `json:"newValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference the Model containing this Field,
+	// when this Model is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 func (FieldObjectDefinitionChanged) IsBreaking() bool {
 	// If the ObjectDefinition has changed this is going to require code changes
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c FieldObjectDefinitionChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+		FieldName:    c.FieldName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (FieldObjectDefinitionChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c FieldObjectDefinitionChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
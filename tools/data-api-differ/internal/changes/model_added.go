@@ -3,24 +3,49 @@
 
 package changes
 
-var _ Change = ModelAdded{}
+var _ CommonTypeChange = ModelAdded{}
 
 // ModelAdded defines information about a new Model.
 type ModelAdded struct {
 	// ServiceName specifies the name of the Service which contains this Model.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Model.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Model.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which has been added.
-	ModelName string
+	ModelName string `json:"modelName"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Model, when this Model
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ModelAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ModelAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ModelAdded) Category() Category {
+	return SdkFeatureCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ModelAdded) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
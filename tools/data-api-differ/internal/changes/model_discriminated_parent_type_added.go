@@ -3,27 +3,31 @@
 
 package changes
 
-var _ Change = ModelDiscriminatedParentTypeAdded{}
+var _ CommonTypeChange = ModelDiscriminatedParentTypeAdded{}
 
 // ModelDiscriminatedParentTypeAdded defines that an existing Model is now
 // a Discriminated Implementation of another Parent Type.
 type ModelDiscriminatedParentTypeAdded struct {
 	// ServiceName specifies the name of the Service which contains this Model.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Model.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Model.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which has become a Discriminated
 	// Implementation.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// NewParentModelName specifies the name of the Parent Model that this Model is an
 	// Implementation of.
-	NewParentModelName string
+	NewParentModelName string `json:"newParentModelName"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Model, when this Model
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -32,3 +36,24 @@ func (ModelDiscriminatedParentTypeAdded) IsBreaking() bool {
 	// change since we'll need to update the codebase to account for it.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ModelDiscriminatedParentTypeAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ModelDiscriminatedParentTypeAdded) Category() Category {
+	return SdkFeatureCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ModelDiscriminatedParentTypeAdded) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
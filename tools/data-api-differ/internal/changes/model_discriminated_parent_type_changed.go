@@ -3,29 +3,33 @@
 
 package changes
 
-var _ Change = ModelDiscriminatedParentTypeChanged{}
+var _ CommonTypeChange = ModelDiscriminatedParentTypeChanged{}
 
 // ModelDiscriminatedParentTypeChanged defines that the Parent Model Name for this
 // Discriminated Type has changed.
 type ModelDiscriminatedParentTypeChanged struct {
 	// ServiceName specifies the name of the Service which contains this Model.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Model.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Model.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model which has become a Discriminated
 	// Implementation.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// OldParentModelName specifies the name of the old Parent Model for this Model.
-	OldParentModelName string
+	OldParentModelName string `json:"oldParentModelName"`
 
 	// NewParentModelName specifies the name of the new Parent Model for this Model.
-	NewParentModelName string
+	NewParentModelName string `json:"newParentModelName"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Model, when this Model
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -33,3 +37,24 @@ func (ModelDiscriminatedParentTypeChanged) IsBreaking() bool {
 	// If a Model changes Parent Type then this is a breaking change
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ModelDiscriminatedParentTypeChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ModelDiscriminatedParentTypeChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ModelDiscriminatedParentTypeChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
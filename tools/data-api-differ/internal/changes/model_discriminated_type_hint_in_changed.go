@@ -3,30 +3,34 @@
 
 package changes
 
-var _ Change = ModelDiscriminatedTypeHintInChanged{}
+var _ CommonTypeChange = ModelDiscriminatedTypeHintInChanged{}
 
 // ModelDiscriminatedTypeHintInChanged defines that the TypeHintIn field has changed for the
 // Model in question.
 type ModelDiscriminatedTypeHintInChanged struct {
 	// ServiceName specifies the name of the Service which contains this Model.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Model.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Model.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model where the Discriminated TypeHintIn has changed.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// OldValue specifies the old name of the Field that was used to uniquely identify this
 	// Discriminated Implementation.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// OldValue specifies the new/updated name of the Field that was used to uniquely identify this
 	// Discriminated Implementation.
-	NewValue string
+	NewValue string `json:"newValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Model, when this Model
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -35,3 +39,24 @@ func (ModelDiscriminatedTypeHintInChanged) IsBreaking() bool {
 	// Implementation has changed this will likely break all existing implementations.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ModelDiscriminatedTypeHintInChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ModelDiscriminatedTypeHintInChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ModelDiscriminatedTypeHintInChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
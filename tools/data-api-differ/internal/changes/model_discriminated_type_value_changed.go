@@ -3,30 +3,34 @@
 
 package changes
 
-var _ Change = ModelDiscriminatedTypeValueChanged{}
+var _ CommonTypeChange = ModelDiscriminatedTypeValueChanged{}
 
 // ModelDiscriminatedTypeValueChanged defines that the Discriminated Value used to uniquely
 // identify this Discriminated Type has changed.
 type ModelDiscriminatedTypeValueChanged struct {
 	// ServiceName specifies the name of the Service which contains this Model.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Model.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Model.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ModelName specifies the name of the Model where the Discriminated Type Value has changed.
-	ModelName string
+	ModelName string `json:"modelName"`
 
 	// OldValue specifies the old Value that was used to uniquely identify this Discriminated
 	// Implementation.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated Value used to uniquely identify this Discriminated
 	// Implementation.
-	NewValue string
+	NewValue string `json:"newValue"`
+
+	// ReferencedByServices specifies the names of the Services which reference this Model, when this Model
+	// is a Common Type (see CommonTypesServiceName).
+	ReferencedByServices []string `json:"referencedByServices,omitempty"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -36,3 +40,24 @@ func (ModelDiscriminatedTypeValueChanged) IsBreaking() bool {
 	// additional investigation.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ModelDiscriminatedTypeValueChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:  c.ServiceName,
+		ApiVersion:   c.ApiVersion,
+		ResourceName: c.ResourceName,
+		ModelName:    c.ModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ModelDiscriminatedTypeValueChanged) Category() Category {
+	return SdkFixCategory
+}
+
+// WithReferencedByServices returns a copy of this Change with ReferencedByServices set to referencedByServices.
+func (c ModelDiscriminatedTypeValueChanged) WithReferencedByServices(referencedByServices []string) Change {
+	c.ReferencedByServices = referencedByServices
+	return c
+}
@@ -8,22 +8,22 @@ var _ Change = OperationContentTypeChanged{}
 // OperationContentTypeChanged defines that the ContentType for an existing Operation has changed.
 type OperationContentTypeChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has been an updated ContentType.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldContentType specifies the old/existing value for the Content-Type field for this Operation.
-	OldContentType string
+	OldContentType string `json:"oldContentType"`
 
 	// NewContentType specifies the new/updated value for the Content-Type field for this Operation.
-	NewContentType string
+	NewContentType string `json:"newContentType"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -37,3 +37,18 @@ func (OperationContentTypeChanged) IsBreaking() bool {
 	// a breaking change.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationContentTypeChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationContentTypeChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -9,22 +9,22 @@ var _ Change = OperationExpectedStatusCodesChanged{}
 // have changed.
 type OperationExpectedStatusCodesChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has an updated set of Expected Status Codes.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldExpectedStatusCodes specifies the old/existing Expected Status Codes for this Operation.
-	OldExpectedStatusCodes []int
+	OldExpectedStatusCodes []int `json:"oldExpectedStatusCodes"`
 
 	// NewExpectedStatusCodes specifies the new/updated Expected Status Codes for this Operation.
-	NewExpectedStatusCodes []int
+	NewExpectedStatusCodes []int `json:"newExpectedStatusCodes"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -51,3 +51,18 @@ func (c OperationExpectedStatusCodesChanged) IsBreaking() bool {
 	isBreakingChange := len(removed) > 0
 	return isBreakingChange
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationExpectedStatusCodesChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationExpectedStatusCodesChanged) Category() Category {
+	return SdkFixCategory
+}
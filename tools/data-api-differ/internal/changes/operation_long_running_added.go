@@ -8,19 +8,34 @@ var _ Change = OperationLongRunningAdded{}
 // OperationLongRunningAdded defines when an existing Operation is now Long Running.
 type OperationLongRunningAdded struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which is now a Long Running Operation.
-	OperationName string
+	OperationName string `json:"operationName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (OperationLongRunningAdded) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationLongRunningAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationLongRunningAdded) Category() Category {
+	return SdkFeatureCategory
+}
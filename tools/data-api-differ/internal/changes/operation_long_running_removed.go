@@ -8,19 +8,34 @@ var _ Change = OperationLongRunningRemoved{}
 // OperationLongRunningRemoved defines when an existing Operation is no longer Long Running.
 type OperationLongRunningRemoved struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which is no longer a Long Running Operation.
-	OperationName string
+	OperationName string `json:"operationName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (OperationLongRunningRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationLongRunningRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationLongRunningRemoved) Category() Category {
+	return SdkFixCategory
+}
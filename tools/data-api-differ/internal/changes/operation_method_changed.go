@@ -8,22 +8,22 @@ var _ Change = OperationMethodChanged{}
 // OperationMethodChanged defines when the HTTP Method used for an existing Operation changes.
 type OperationMethodChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has an updated HTTP Method.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldValue specifies the old/existing HTTP Method for this Operation.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated HTTP Method for this Operation.
-	NewValue string
+	NewValue string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -32,3 +32,18 @@ func (OperationMethodChanged) IsBreaking() bool {
 	// and this is a breaking change.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationMethodChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationMethodChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = OperationOptionQueryStringNameChanged{}
+
+// OperationOptionQueryStringNameChanged defines when the QueryString Name used for an existing Option within an existing Operation changes.
+type OperationOptionQueryStringNameChanged struct {
+	// ServiceName specifies the name of the Service which contains this Operation.
+	ServiceName string `json:"serviceName"`
+
+	// ApiVersion specifies the name of the API Version which contains this Operation.
+	ApiVersion string `json:"apiVersion"`
+
+	// ResourceName specifies the name of the API Resource which contains this Operation.
+	ResourceName string `json:"resourceName"`
+
+	// OperationName specifies the name of the Operation which contains this Option.
+	OperationName string `json:"operationName"`
+
+	// OptionName specifies the name of the Option which has an updated QueryString Name.
+	OptionName string `json:"optionName"`
+
+	// OldValue specifies the old/existing QueryString Name for this Option, or an empty string if unset.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated QueryString Name for this Option, or an empty string if unset.
+	NewValue string `json:"newValue"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (OperationOptionQueryStringNameChanged) IsBreaking() bool {
+	// The Option itself remains the same in the generated SDK, only the QueryString Name sent in the Request changes.
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationOptionQueryStringNameChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+		OptionName:    c.OptionName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationOptionQueryStringNameChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -8,19 +8,19 @@ var _ Change = OperationOptionsAdded{}
 // OperationOptionsAdded defines where an existing Operation now supports Options.
 type OperationOptionsAdded struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has had Options added.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// NewValue specifies a slice of the new/updated Options for this Operation.
-	NewValue map[string]string
+	NewValue map[string]string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -28,3 +28,18 @@ func (OperationOptionsAdded) IsBreaking() bool {
 	// This will require code changes so is a breaking change
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationOptionsAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationOptionsAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -8,22 +8,22 @@ var _ Change = OperationOptionsChanged{}
 // OperationOptionsChanged defines an existing Operation which has had its Options changed.
 type OperationOptionsChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has had its Options changed.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldValue specifies a slice of the old/existing Options for this Operation.
-	OldValue map[string]string
+	OldValue map[string]string `json:"oldValue"`
 
 	// NewValue specifies a slice of the new/updated Options for this Operation.
-	NewValue map[string]string
+	NewValue map[string]string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -44,3 +44,19 @@ func (o OperationOptionsChanged) IsBreaking() bool {
 
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (o OperationOptionsChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   o.ServiceName,
+		ApiVersion:    o.ApiVersion,
+		ResourceName:  o.ResourceName,
+		OperationName: o.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationOptionsChanged) Category() Category {
+	// a Non-Breaking change to the Options for an Operation means that new Options have been added
+	return SdkFeatureCategory
+}
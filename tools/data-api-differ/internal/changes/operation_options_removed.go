@@ -8,19 +8,19 @@ var _ Change = OperationOptionsRemoved{}
 // OperationOptionsRemoved defines where an existing Operation no longer supports Options.
 type OperationOptionsRemoved struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which no longer supports Options.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldValue specifies a slice of the old/existing Options for this Operation.
-	OldValue map[string]string
+	OldValue map[string]string `json:"oldValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -28,3 +28,18 @@ func (OperationOptionsRemoved) IsBreaking() bool {
 	// This will require code changes so is a breaking change
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationOptionsRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationOptionsRemoved) Category() Category {
+	return SdkFixCategory
+}
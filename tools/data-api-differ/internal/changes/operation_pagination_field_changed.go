@@ -9,22 +9,22 @@ var _ Change = OperationPaginationFieldChanged{}
 // Pagination Field.
 type OperationPaginationFieldChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation where the Pagination Field has changed.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldValue specifies the old/existing value for the Pagination Field for this operation.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated value for the Pagination Field for this operation.
-	NewValue string
+	NewValue string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -33,3 +33,18 @@ func (OperationPaginationFieldChanged) IsBreaking() bool {
 	// a regression) - or in the updated code - and will require manual investigation.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationPaginationFieldChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationPaginationFieldChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -8,22 +8,37 @@ var _ Change = OperationRemoved{}
 // OperationRemoved defines an Operation which has been removed from an existing API Resource.
 type OperationRemoved struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has been removed.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// Uri specifies the URI of the Operation which has been removed.
-	Uri string
+	Uri string `json:"uri"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (OperationRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationRemoved) Category() Category {
+	return SdkFixCategory
+}
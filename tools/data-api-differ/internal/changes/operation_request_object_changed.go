@@ -8,25 +8,40 @@ var _ Change = OperationRequestObjectChanged{}
 // OperationRequestObjectChanged defines an existing Operation where the Request Object has changed.
 type OperationRequestObjectChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which now has a Request Object.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// NewRequestObject specifies the new/updated value for the Request Object.
-	NewRequestObject string
+	NewRequestObject string `json:"newRequestObject"`
 
 	// OldRequestObject specifies the old/existing value for the Request Object.
-	OldRequestObject string
+	OldRequestObject string `json:"oldRequestObject"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (OperationRequestObjectChanged) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationRequestObjectChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationRequestObjectChanged) Category() Category {
+	return SdkFixCategory
+}
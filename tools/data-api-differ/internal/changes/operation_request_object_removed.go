@@ -8,19 +8,19 @@ var _ Change = OperationRequestObjectRemoved{}
 // OperationRequestObjectRemoved defines that a Request Object has been removed from an existing Operation.
 type OperationRequestObjectRemoved struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which no longer has a Request Object.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldRequestObject specifies the old/existing value for the Request Object.
-	OldRequestObject string
+	OldRequestObject string `json:"oldRequestObject"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -28,3 +28,18 @@ func (OperationRequestObjectRemoved) IsBreaking() bool {
 	// This will require code changes
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationRequestObjectRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationRequestObjectRemoved) Category() Category {
+	return SdkFixCategory
+}
@@ -8,22 +8,37 @@ var _ Change = OperationResourceIdAdded{}
 // OperationResourceIdAdded defines when a Resource Id is added to an existing Operation.
 type OperationResourceIdAdded struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which now has a Resource Id Name.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// NewResourceIdName specifies the new/updated value for the Resource Id Name.
-	NewResourceIdName string
+	NewResourceIdName string `json:"newResourceIdName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (OperationResourceIdAdded) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationResourceIdAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationResourceIdAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -11,28 +11,28 @@ var _ Change = OperationResourceIdChanged{}
 // Resource ID Value (i.e. URI) has changed (i.e. a new Resource) - rather than being renamed.
 type OperationResourceIdChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has a new/updated Resource Id Name.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldResourceIdName specifies the old/existing value for the Resource ID Name.
-	OldResourceIdName string
+	OldResourceIdName string `json:"oldResourceIdName"`
 
 	// OldValue specifies the old/existing value for this Resource ID.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewResourceIdName specifies the new/updated value for the Resource ID Name.
-	NewResourceIdName string
+	NewResourceIdName string `json:"newResourceIdName"`
 
 	// NewValue specifies the new/updated value for this Resource ID.
-	NewValue string
+	NewValue string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -40,3 +40,18 @@ func (OperationResourceIdChanged) IsBreaking() bool {
 	// If the ResourceId used by this Operation has changed this would require code changes.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationResourceIdChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationResourceIdChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -8,19 +8,19 @@ var _ Change = OperationResourceIdRemoved{}
 // OperationResourceIdRemoved defines that an existing Operation no longer requires a Resource ID.
 type OperationResourceIdRemoved struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which now has a Resource Id Name.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldResourceIdName specifies the old/existing value for the Resource Id Name.
-	OldResourceIdName string
+	OldResourceIdName string `json:"oldResourceIdName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -28,3 +28,18 @@ func (OperationResourceIdRemoved) IsBreaking() bool {
 	// If a Resource ID is removed from an Operation then this will be a breaking change.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationResourceIdRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationResourceIdRemoved) Category() Category {
+	return SdkFixCategory
+}
@@ -12,22 +12,22 @@ var _ Change = OperationResourceIdRenamed{}
 // thus whilst this IS a breaking change (to the code) it's not a breaking change in the API.
 type OperationResourceIdRenamed struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which has a new/updated Resource Id Name.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// NewResourceIdName specifies the new/updated value for the Resource Id Name.
-	NewResourceIdName string
+	NewResourceIdName string `json:"newResourceIdName"`
 
 	// OldResourceIdName specifies the old/existing value for the Resource Id Name.
-	OldResourceIdName string
+	OldResourceIdName string `json:"oldResourceIdName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -36,3 +36,18 @@ func (OperationResourceIdRenamed) IsBreaking() bool {
 	// will require code changes to fix.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationResourceIdRenamed) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationResourceIdRenamed) Category() Category {
+	return SdkFixCategory
+}
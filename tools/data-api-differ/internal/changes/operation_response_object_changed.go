@@ -8,25 +8,40 @@ var _ Change = OperationResponseObjectChanged{}
 // OperationResponseObjectChanged defines an existing Operation where the Response Object has changed.
 type OperationResponseObjectChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation which now has a Response Object.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// NewResponseObject specifies the new/updated value for the Response Object.
-	NewResponseObject string
+	NewResponseObject string `json:"newResponseObject"`
 
 	// OldResponseObject specifies the old/existing value for the Response Object.
-	OldResponseObject string
+	OldResponseObject string `json:"oldResponseObject"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (OperationResponseObjectChanged) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationResponseObjectChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationResponseObjectChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -8,19 +8,19 @@ var _ Change = OperationUriSuffixAdded{}
 // OperationUriSuffixAdded defines when an existing Operation now has a Uri Suffix.
 type OperationUriSuffixAdded struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation where the Uri Suffix has been added.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// NewValue specifies the new/updated Uri Suffix for this Operation.
-	NewValue string
+	NewValue string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -28,3 +28,18 @@ func (OperationUriSuffixAdded) IsBreaking() bool {
 	// This would be operating on a different Resource, so is a breaking change.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationUriSuffixAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationUriSuffixAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -8,22 +8,22 @@ var _ Change = OperationUriSuffixChanged{}
 // OperationUriSuffixChanged defines when an existing Operation has an updated Uri Suffix.
 type OperationUriSuffixChanged struct {
 	// ServiceName specifies the name of the Service which contains this Operation.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Operation.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Operation.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// OperationName specifies the name of the Operation where the Uri Suffix has changed.
-	OperationName string
+	OperationName string `json:"operationName"`
 
 	// OldValue specifies the old/existing Uri Suffix for this Operation.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated Uri Suffix for this Operation.
-	NewValue string
+	NewValue string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -31,3 +31,18 @@ func (OperationUriSuffixChanged) IsBreaking() bool {
 	// This would be operating on a different Resource, so is a breaking change.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c OperationUriSuffixChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ApiVersion:    c.ApiVersion,
+		ResourceName:  c.ResourceName,
+		OperationName: c.OperationName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (OperationUriSuffixChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -9,28 +9,43 @@ var _ Change = ResourceIdAdded{}
 type ResourceIdAdded struct {
 	// ServiceName specifies the name of the Service which contains this
 	// Resource ID.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this
 	// Resource ID.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this
 	// Resource ID.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ResourceIdName specifies the name of the Resource ID which has been added.
-	ResourceIdName string
+	ResourceIdName string `json:"resourceIdName"`
 
 	// ResourceIdValue specifies the value used for this Resource ID e.g. `/foo/{bar}`
-	ResourceIdValue string
+	ResourceIdValue string `json:"resourceIdValue"`
 
 	// StaticIdentifiersInNewValue specifies a unique, sorted list of Static Identifiers (such as Resource
 	// Provider Name and any Static Values) present within the new/updated value for this Resource ID.
-	StaticIdentifiersInNewValue []string
+	StaticIdentifiersInNewValue []string `json:"staticIdentifiersInNewValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ResourceIdAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ResourceIdAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:    c.ServiceName,
+		ApiVersion:     c.ApiVersion,
+		ResourceName:   c.ResourceName,
+		ResourceIdName: c.ResourceIdName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ResourceIdAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -10,30 +10,30 @@ var _ Change = ResourceIdCommonIdChanged{}
 type ResourceIdCommonIdChanged struct {
 	// ServiceName specifies the name of the Service which contained this
 	// Resource ID.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contained this
 	// Resource ID.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contained this
 	// Resource ID.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ResourceIdName specifies the name of the Resource ID which is now a Common ID.
-	ResourceIdName string
+	ResourceIdName string `json:"resourceIdName"`
 
 	// NewCommonAliasName specifies the new/updated value for the Common Alias associated with this Resource ID.
-	NewCommonAliasName string
+	NewCommonAliasName string `json:"newCommonAliasName"`
 
 	// OldCommonAliasName specifies the old/existing value for the Common Alias associated with this Resource ID.
-	OldCommonAliasName string
+	OldCommonAliasName string `json:"oldCommonAliasName"`
 
 	// OldValue specifies the old/existing value for this Resource ID.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated value for this Resource ID.
-	NewValue string
+	NewValue string `json:"newValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -41,3 +41,18 @@ func (ResourceIdCommonIdChanged) IsBreaking() bool {
 	// This is going to require code changes
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ResourceIdCommonIdChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:    c.ServiceName,
+		ApiVersion:     c.ApiVersion,
+		ResourceName:   c.ResourceName,
+		ResourceIdName: c.ResourceIdName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ResourceIdCommonIdChanged) Category() Category {
+	return SdkFixCategory
+}
@@ -10,24 +10,24 @@ var _ Change = ResourceIdCommonIdRemoved{}
 type ResourceIdCommonIdRemoved struct {
 	// ServiceName specifies the name of the Service which contained this
 	// Resource ID.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contained this
 	// Resource ID.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contained this
 	// Resource ID.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ResourceIdName specifies the name of the Resource ID which is no longer a Common ID.
-	ResourceIdName string
+	ResourceIdName string `json:"resourceIdName"`
 
 	// CommonAliasName specifies the name of the Common Alias for this Resource ID.
-	CommonAliasName string
+	CommonAliasName string `json:"commonAliasName"`
 
 	// ResourceIdValue specifies the value used for this Resource ID e.g. `/foo/{bar}`
-	ResourceIdValue string
+	ResourceIdValue string `json:"resourceIdValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -35,3 +35,18 @@ func (ResourceIdCommonIdRemoved) IsBreaking() bool {
 	// If a Resource ID is no longer a Common ID this is going to require code changes
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ResourceIdCommonIdRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:    c.ServiceName,
+		ApiVersion:     c.ApiVersion,
+		ResourceName:   c.ResourceName,
+		ResourceIdName: c.ResourceIdName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ResourceIdCommonIdRemoved) Category() Category {
+	return SdkFixCategory
+}
@@ -9,24 +9,39 @@ var _ Change = ResourceIdRemoved{}
 type ResourceIdRemoved struct {
 	// ServiceName specifies the name of the Service which contained this
 	// Resource ID.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contained this
 	// Resource ID.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contained this
 	// Resource ID.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ResourceIdName specifies the name of the Resource ID which has been removed.
-	ResourceIdName string
+	ResourceIdName string `json:"resourceIdName"`
 
 	// ResourceIdValue specifies the value used for this Resource ID e.g. `/foo/{bar}`
-	ResourceIdValue string
+	ResourceIdValue string `json:"resourceIdValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ResourceIdRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ResourceIdRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:    c.ServiceName,
+		ApiVersion:     c.ApiVersion,
+		ResourceName:   c.ResourceName,
+		ResourceIdName: c.ResourceIdName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ResourceIdRemoved) Category() Category {
+	return SdkFixCategory
+}
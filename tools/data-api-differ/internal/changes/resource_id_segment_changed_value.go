@@ -10,31 +10,46 @@ var _ Change = ResourceIdSegmentChangedValue{}
 // String -> Constant.
 type ResourceIdSegmentChangedValue struct {
 	// ServiceName specifies the name of the Service which contains this Resource ID.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Resource ID.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Resource ID.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ResourceIdName specifies the name of the Resource ID which contains the Segment that has changed.
-	ResourceIdName string
+	ResourceIdName string `json:"resourceIdName"`
 
 	// SegmentIndex specifies the index of this Resource ID Segment which has changed.
-	SegmentIndex int
+	SegmentIndex int `json:"segmentIndex"`
 
 	// OldValue specifies the old/existing value for this Resource ID Segment.
-	OldValue string
+	OldValue string `json:"oldValue"`
 
 	// NewValue specifies the new/updated value for this Resource ID Segment.
-	NewValue string
+	NewValue string `json:"newValue"`
 
 	// StaticIdentifierInNewValue specifies any static identifier present in the updated Resource ID Segment.
-	StaticIdentifierInNewValue *string
+	StaticIdentifierInNewValue *string `json:"staticIdentifierInNewValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (r ResourceIdSegmentChangedValue) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (r ResourceIdSegmentChangedValue) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:    r.ServiceName,
+		ApiVersion:     r.ApiVersion,
+		ResourceName:   r.ResourceName,
+		ResourceIdName: r.ResourceIdName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ResourceIdSegmentChangedValue) Category() Category {
+	return SdkFixCategory
+}
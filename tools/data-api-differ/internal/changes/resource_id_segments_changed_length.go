@@ -10,26 +10,26 @@ var _ Change = ResourceIdSegmentsChangedLength{}
 // and updated Resource ID.
 type ResourceIdSegmentsChangedLength struct {
 	// ServiceName specifies the name of the Service which contains this Resource ID.
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 
 	// ApiVersion specifies the name of the API Version which contains this Resource ID.
-	ApiVersion string
+	ApiVersion string `json:"apiVersion"`
 
 	// ResourceName specifies the name of the API Resource which contains this Resource ID.
-	ResourceName string
+	ResourceName string `json:"resourceName"`
 
 	// ResourceIdName specifies the name of the Resource ID which contains the Segments that has changed.
-	ResourceIdName string
+	ResourceIdName string `json:"resourceIdName"`
 
 	// OldValue specifies the old/existing value for this Resource ID.
-	OldValue []string
+	OldValue []string `json:"oldValue"`
 
 	// NewValue specifies the new/updated value for this Resource ID.
-	NewValue []string
+	NewValue []string `json:"newValue"`
 
 	// StaticIdentifiersInNewValue specifies a unique, sorted list of Static Identifiers (such as Resource
 	// Provider Name and any Static Values) present within the new/updated value for this Resource ID Segment.
-	StaticIdentifiersInNewValue []string
+	StaticIdentifiersInNewValue []string `json:"staticIdentifiersInNewValue"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
@@ -38,3 +38,18 @@ func (ResourceIdSegmentsChangedLength) IsBreaking() bool {
 	// which requires additional investigation/understanding.
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ResourceIdSegmentsChangedLength) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:    c.ServiceName,
+		ApiVersion:     c.ApiVersion,
+		ResourceName:   c.ResourceName,
+		ResourceIdName: c.ResourceIdName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ResourceIdSegmentsChangedLength) Category() Category {
+	return SdkFixCategory
+}
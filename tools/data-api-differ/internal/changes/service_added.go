@@ -8,10 +8,22 @@ var _ Change = ServiceAdded{}
 // ServiceAdded defines information about a new Service.
 type ServiceAdded struct {
 	// ServiceName is the name of the Service (e.g. `Compute`).
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ServiceAdded) IsBreaking() bool {
 	return false
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ServiceAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName: c.ServiceName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ServiceAdded) Category() Category {
+	return SdkFeatureCategory
+}
@@ -8,10 +8,22 @@ var _ Change = ServiceRemoved{}
 // ServiceRemoved defines information about a Service which has been removed.
 type ServiceRemoved struct {
 	// ServiceName is the name of the Service (e.g. `Compute`).
-	ServiceName string
+	ServiceName string `json:"serviceName"`
 }
 
 // IsBreaking returns whether this Change is considered a Breaking Change.
 func (ServiceRemoved) IsBreaking() bool {
 	return true
 }
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ServiceRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName: c.ServiceName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ServiceRemoved) Category() Category {
+	return SdkFixCategory
+}
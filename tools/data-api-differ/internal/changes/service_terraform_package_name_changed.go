@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = ServiceTerraformPackageNameChanged{}
+
+// ServiceTerraformPackageNameChanged defines when the Terraform Package Name for an existing Service
+// has been changed.
+type ServiceTerraformPackageNameChanged struct {
+	// ServiceName specifies the name of the Service (e.g. `Compute`).
+	ServiceName string `json:"serviceName"`
+
+	// OldValue specifies the old/existing Terraform Package Name for this Service.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated Terraform Package Name for this Service.
+	NewValue string `json:"newValue"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (ServiceTerraformPackageNameChanged) IsBreaking() bool {
+	// The Terraform Package Name determines where the generated code is output within the Provider,
+	// which requires the existing code to be moved - but doesn't affect users of the Provider.
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c ServiceTerraformPackageNameChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName: c.ServiceName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (ServiceTerraformPackageNameChanged) Category() Category {
+	return TerraformCategory
+}
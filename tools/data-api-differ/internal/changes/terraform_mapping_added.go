@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformMappingAdded{}
+
+// TerraformMappingAdded defines when a new Mapping has been added to an existing Terraform Resource.
+type TerraformMappingAdded struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// MappingType specifies the type of Mapping (e.g. `Field`, `ModelToModel` or `ResourceId`).
+	MappingType string `json:"mappingType"`
+
+	// Mapping is a human-readable description of this Mapping.
+	Mapping string `json:"mapping"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformMappingAdded) IsBreaking() bool {
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformMappingAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+		Mapping:       c.Mapping,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformMappingAdded) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformMappingRemoved{}
+
+// TerraformMappingRemoved defines when a Mapping has been removed from an existing Terraform Resource.
+type TerraformMappingRemoved struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// MappingType specifies the type of Mapping (e.g. `Field`, `ModelToModel` or `ResourceId`).
+	MappingType string `json:"mappingType"`
+
+	// Mapping is a human-readable description of this Mapping.
+	Mapping string `json:"mapping"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformMappingRemoved) IsBreaking() bool {
+	// Removing a Mapping means a value is no longer sent to/read from the API, which changes the
+	// behaviour of the Terraform Resource for existing users.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformMappingRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+		Mapping:       c.Mapping,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformMappingRemoved) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,33 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformResourceAdded{}
+
+// TerraformResourceAdded defines information about a new Terraform Resource.
+type TerraformResourceAdded struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformResourceAdded) IsBreaking() bool {
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformResourceAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformResourceAdded) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformResourceApiVersionChanged{}
+
+// TerraformResourceApiVersionChanged defines when the API Version used for an existing Terraform Resource
+// has been changed.
+type TerraformResourceApiVersionChanged struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// OldValue specifies the old/existing API Version used for this Terraform Resource.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated API Version used for this Terraform Resource.
+	NewValue string `json:"newValue"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformResourceApiVersionChanged) IsBreaking() bool {
+	// A different API Version can change the behaviour of (and the values returned from) the API, which
+	// needs to be reviewed - and can require a State Migration for existing users of the Provider.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformResourceApiVersionChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformResourceApiVersionChanged) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformResourceRemoved{}
+
+// TerraformResourceRemoved defines information about a Terraform Resource which has been removed.
+type TerraformResourceRemoved struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformResourceRemoved) IsBreaking() bool {
+	// Removing a Terraform Resource means users of the Provider can no longer use it.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformResourceRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformResourceRemoved) Category() Category {
+	return TerraformCategory
+}
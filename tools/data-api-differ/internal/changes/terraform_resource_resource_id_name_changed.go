@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformResourceResourceIdNameChanged{}
+
+// TerraformResourceResourceIdNameChanged defines when the Resource ID used for an existing Terraform Resource
+// has been changed.
+type TerraformResourceResourceIdNameChanged struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// OldValue specifies the old/existing name of the Resource ID used for this Terraform Resource.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated name of the Resource ID used for this Terraform Resource.
+	NewValue string `json:"newValue"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformResourceResourceIdNameChanged) IsBreaking() bool {
+	// A different Resource ID means the `id` field within the users State changes, requiring a State Migration.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformResourceResourceIdNameChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformResourceResourceIdNameChanged) Category() Category {
+	return TerraformCategory
+}
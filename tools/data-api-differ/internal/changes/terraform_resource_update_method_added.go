@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformResourceUpdateMethodAdded{}
+
+// TerraformResourceUpdateMethodAdded defines when an existing Terraform Resource can now be Updated.
+type TerraformResourceUpdateMethodAdded struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SDKOperationName specifies the name of the SDK Operation used to Update this Terraform Resource.
+	SDKOperationName string `json:"sdkOperationName"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformResourceUpdateMethodAdded) IsBreaking() bool {
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformResourceUpdateMethodAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformResourceUpdateMethodAdded) Category() Category {
+	return TerraformCategory
+}
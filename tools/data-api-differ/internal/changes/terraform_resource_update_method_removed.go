@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformResourceUpdateMethodRemoved{}
+
+// TerraformResourceUpdateMethodRemoved defines when an existing Terraform Resource can no longer be Updated.
+type TerraformResourceUpdateMethodRemoved struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SDKOperationName specifies the name of the SDK Operation which was used to Update this Terraform Resource.
+	SDKOperationName string `json:"sdkOperationName"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformResourceUpdateMethodRemoved) IsBreaking() bool {
+	// Without an Update method each Schema Field has to be ForceNew, meaning that changes which
+	// could previously be applied in-place now require the Terraform Resource to be recreated.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformResourceUpdateMethodRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformResourceUpdateMethodRemoved) Category() Category {
+	return TerraformCategory
+}
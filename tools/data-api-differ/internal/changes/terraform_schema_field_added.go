@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformSchemaFieldAdded{}
+
+// TerraformSchemaFieldAdded defines information about a new Schema Field within an existing Schema Model.
+type TerraformSchemaFieldAdded struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SchemaModelName specifies the name of the Schema Model which contains this Schema Field.
+	SchemaModelName string `json:"schemaModelName"`
+
+	// FieldName specifies the name of the Schema Field which has been added.
+	FieldName string `json:"fieldName"`
+
+	// HclName specifies the name of this Schema Field within the Terraform Configuration (e.g. `resource_group_name`).
+	HclName string `json:"hclName"`
+
+	// Required specifies whether this Schema Field is Required.
+	Required bool `json:"required"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (c TerraformSchemaFieldAdded) IsBreaking() bool {
+	// A new Required field means that existing Terraform Configurations are no longer valid, which
+	// is a breaking change - whereas a new Optional/Computed field isn't.
+	return c.Required
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformSchemaFieldAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:     c.ServiceName,
+		ResourceLabel:   c.ResourceLabel,
+		FieldName:       c.FieldName,
+		SchemaModelName: c.SchemaModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformSchemaFieldAdded) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformSchemaFieldComputedAdded{}
+
+// TerraformSchemaFieldComputedAdded defines when an existing Schema Field has become Computed.
+type TerraformSchemaFieldComputedAdded struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SchemaModelName specifies the name of the Schema Model which contains this Schema Field.
+	SchemaModelName string `json:"schemaModelName"`
+
+	// FieldName specifies the name of the Schema Field which is now Computed.
+	FieldName string `json:"fieldName"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformSchemaFieldComputedAdded) IsBreaking() bool {
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformSchemaFieldComputedAdded) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:     c.ServiceName,
+		ResourceLabel:   c.ResourceLabel,
+		FieldName:       c.FieldName,
+		SchemaModelName: c.SchemaModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformSchemaFieldComputedAdded) Category() Category {
+	return TerraformCategory
+}
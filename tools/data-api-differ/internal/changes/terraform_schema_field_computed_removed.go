@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformSchemaFieldComputedRemoved{}
+
+// TerraformSchemaFieldComputedRemoved defines when an existing Schema Field is no longer Computed.
+type TerraformSchemaFieldComputedRemoved struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SchemaModelName specifies the name of the Schema Model which contains this Schema Field.
+	SchemaModelName string `json:"schemaModelName"`
+
+	// FieldName specifies the name of the Schema Field which is no longer Computed.
+	FieldName string `json:"fieldName"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformSchemaFieldComputedRemoved) IsBreaking() bool {
+	// Users who don't specify this field will now see a diff where the API returns a value for it.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformSchemaFieldComputedRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:     c.ServiceName,
+		ResourceLabel:   c.ResourceLabel,
+		FieldName:       c.FieldName,
+		SchemaModelName: c.SchemaModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformSchemaFieldComputedRemoved) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformSchemaFieldHclNameChanged{}
+
+// TerraformSchemaFieldHclNameChanged defines when the HCL Name for an existing Schema Field has been changed
+// (e.g. the field has been renamed in the Terraform Configuration).
+type TerraformSchemaFieldHclNameChanged struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SchemaModelName specifies the name of the Schema Model which contains this Schema Field.
+	SchemaModelName string `json:"schemaModelName"`
+
+	// FieldName specifies the name of the Schema Field which has been renamed.
+	FieldName string `json:"fieldName"`
+
+	// OldValue specifies the old/existing HCL Name for this Schema Field.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated HCL Name for this Schema Field.
+	NewValue string `json:"newValue"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformSchemaFieldHclNameChanged) IsBreaking() bool {
+	// Renaming a field in the Terraform Configuration requires existing Terraform Configurations to be updated.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformSchemaFieldHclNameChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:     c.ServiceName,
+		ResourceLabel:   c.ResourceLabel,
+		FieldName:       c.FieldName,
+		SchemaModelName: c.SchemaModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformSchemaFieldHclNameChanged) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformSchemaFieldObjectDefinitionChanged{}
+
+// TerraformSchemaFieldObjectDefinitionChanged defines when an existing Schema Field gets an updated
+// ObjectDefinition (e.g. a String becomes a List of Strings).
+type TerraformSchemaFieldObjectDefinitionChanged struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SchemaModelName specifies the name of the Schema Model which contains this Schema Field.
+	SchemaModelName string `json:"schemaModelName"`
+
+	// FieldName specifies the name of the Schema Field which has an updated Object Definition.
+	FieldName string `json:"fieldName"`
+
+	// OldValue specifies the old/existing ObjectDefinition for this Schema Field.
+	OldValue string `json:"oldValue"`
+
+	// NewValue specifies the new/updated ObjectDefinition for this Schema Field.
+	NewValue string `json:"newValue"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformSchemaFieldObjectDefinitionChanged) IsBreaking() bool {
+	// Changing the type of a field requires existing Terraform Configurations to be updated.
+	return true
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformSchemaFieldObjectDefinitionChanged) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:     c.ServiceName,
+		ResourceLabel:   c.ResourceLabel,
+		FieldName:       c.FieldName,
+		SchemaModelName: c.SchemaModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformSchemaFieldObjectDefinitionChanged) Category() Category {
+	return TerraformCategory
+}
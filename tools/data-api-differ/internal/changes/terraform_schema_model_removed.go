@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformSchemaModelRemoved{}
+
+// TerraformSchemaModelRemoved defines information about a Schema Model which has been removed from an
+// existing Terraform Resource.
+type TerraformSchemaModelRemoved struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// SchemaModelName specifies the name of the Schema Model which has been removed.
+	SchemaModelName string `json:"schemaModelName"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformSchemaModelRemoved) IsBreaking() bool {
+	// Any Schema Fields referencing this Schema Model are raised as a separate (Breaking) Change, so
+	// removing the Schema Model itself isn't considered a Breaking Change.
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformSchemaModelRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:     c.ServiceName,
+		ResourceLabel:   c.ResourceLabel,
+		SchemaModelName: c.SchemaModelName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformSchemaModelRemoved) Category() Category {
+	return TerraformCategory
+}
@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package changes
+
+var _ Change = TerraformTestRemoved{}
+
+// TerraformTestRemoved defines when a Test has been removed from an existing Terraform Resource.
+type TerraformTestRemoved struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// TestName specifies the name of this Test (e.g. `BasicConfiguration` or `OtherTests/someTest`).
+	TestName string `json:"testName"`
+}
+
+// IsBreaking returns whether this Change is considered a Breaking Change.
+func (TerraformTestRemoved) IsBreaking() bool {
+	return false
+}
+
+// Identifiers returns the names identifying the item changed by this Change, and where it's located.
+func (c TerraformTestRemoved) Identifiers() Identifiers {
+	return Identifiers{
+		ServiceName:   c.ServiceName,
+		ResourceLabel: c.ResourceLabel,
+		TestName:      c.TestName,
+	}
+}
+
+// Category returns the Category of this Change.
+func (TerraformTestRemoved) Category() Category {
+	return TerraformCategory
+}
@@ -6,74 +6,112 @@ package commands
 import (
 	"flag"
 	"fmt"
-	"os"
 	"path/filepath"
 
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/dataapi"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
 )
 
 type arguments struct {
 	// binaryName specifies the name of the binary
 	binaryName string
 
-	// dataApiBinaryPath specifies the path to the Data API (v2) binary.
-	dataApiBinaryPath string
+	// dataApiOptions specifies how the API Definitions should be loaded, either in-process or via the Data API (v2).
+	dataApiOptions dataapi.Options
 
-	// initialApiDefinitionsPath specifies the path to the initial set of API Definitions which should be compared against those within updatedPath.
-	initialApiDefinitionsPath string
+	// initialDataSource specifies the initial set of API Definitions which should be compared against those within updatedDataSource.
+	initialDataSource datasource.DataSource
 
 	// outputFilePath specifies the path to the output file where the Result should be rendered.
 	outputFilePath *string
 
-	// updatedApiDefinitionsPath specifies the path to the updated set of API Definitions which should be compared against those within initialPath.
-	updatedApiDefinitionsPath string
+	// outputFormat specifies the format which the Result should be rendered in.
+	outputFormat outputFormat
+
+	// suppressionsFilePath optionally specifies the path to the Suppressions File, which acknowledges intentional Changes.
+	suppressionsFilePath *string
+
+	// updatedDataSource specifies the updated set of API Definitions which should be compared against those within initialDataSource.
+	updatedDataSource datasource.DataSource
 }
 
 func (a *arguments) parse(input []string) error {
 	f := flag.NewFlagSet(a.binaryName, flag.ExitOnError)
 
-	// this default allows for the binary to be on the path, helpful for automation purposes where the GOBIN is on the PATH
-	f.StringVar(&a.dataApiBinaryPath, "data-api-binary-path", "", "--data-api-binary-path=/path/to/the/data-api-binary")
-	f.StringVar(&a.initialApiDefinitionsPath, "initial-path", "", "--initial-path=/path/to/the/initial-api-definitions")
-	f.StringVar(&a.updatedApiDefinitionsPath, "updated-path", "", "--updated-path=/path/to/the/updated-api-definitions")
+	var dataApiMode, dataApiBinaryPath string
+	f.StringVar(&dataApiMode, "data-api-mode", string(dataapi.InProcessMode), "--data-api-mode=in-process|http")
+	f.StringVar(&dataApiBinaryPath, "data-api-binary-path", "", "--data-api-binary-path=/path/to/the/data-api-binary")
+	var initialPath, initialDataSource, updatedPath, updatedDataSource string
+	f.StringVar(&initialPath, "initial-path", "", "--initial-path=/path/to/the/initial-api-definitions")
+	f.StringVar(&initialDataSource, "initial-data-source", "", "--initial-data-source=git:main:api-definitions")
+	f.StringVar(&updatedPath, "updated-path", "", "--updated-path=/path/to/the/updated-api-definitions")
+	f.StringVar(&updatedDataSource, "updated-data-source", "", "--updated-data-source=/path/to/the/updated-api-definitions.tar.gz")
 	var outputFilePath string
 	f.StringVar(&outputFilePath, "output-file-path", "", "--output-file=/path/to/the/output/file")
+	var outputFormatRaw string
+	f.StringVar(&outputFormatRaw, "output-format", string(markdownOutputFormat), "--output-format=markdown|json")
+	var suppressionsFilePath string
+	f.StringVar(&suppressionsFilePath, "suppressions-file-path", "", "--suppressions-file-path=/path/to/the/suppressions.hcl")
 	if err := f.Parse(input); err != nil {
 		return err
 	}
 
+	a.outputFormat = outputFormat(outputFormatRaw)
+	if a.outputFormat != markdownOutputFormat && a.outputFormat != jsonOutputFormat {
+		return fmt.Errorf("unsupported `--output-format` %q - supported values are `markdown` and `json`", outputFormatRaw)
+	}
+
 	if outputFilePath != "" {
 		a.outputFilePath = &outputFilePath
 	}
 
-	var err error
-	if a.dataApiBinaryPath != "" {
-		log.Logger.Debug(fmt.Sprintf("Determining the absolute path to %q", a.dataApiBinaryPath))
-		a.dataApiBinaryPath, err = filepath.Abs(a.dataApiBinaryPath)
-		if err != nil {
-			return fmt.Errorf("determining absolute path to %q: %+v", a.dataApiBinaryPath, err)
-		}
-	} else {
-		log.Logger.Debug("A path to the Data API Binary was not specified - assuming this is installed onto the PATH")
-		a.dataApiBinaryPath = "data-api"
+	a.dataApiOptions = dataapi.Options{
+		Mode: dataapi.Mode(dataApiMode),
 	}
+	switch a.dataApiOptions.Mode {
+	case dataapi.InProcessMode:
+		if dataApiBinaryPath != "" {
+			return fmt.Errorf("`--data-api-binary-path` can only be specified when `--data-api-mode` is `%s`", string(dataapi.HttpMode))
+		}
+
+	case dataapi.HttpMode:
+		if dataApiBinaryPath != "" {
+			log.Logger.Debug(fmt.Sprintf("Determining the absolute path to %q", dataApiBinaryPath))
+			path, err := filepath.Abs(dataApiBinaryPath)
+			if err != nil {
+				return fmt.Errorf("determining absolute path to %q: %+v", dataApiBinaryPath, err)
+			}
+			a.dataApiOptions.BinaryPath = path
+		} else {
+			// this default allows for the binary to be on the path, helpful for automation purposes where the GOBIN is on the PATH
+			log.Logger.Debug("A path to the Data API Binary was not specified - assuming this is installed onto the PATH")
+			a.dataApiOptions.BinaryPath = "data-api"
+		}
 
-	if a.initialApiDefinitionsPath == "" {
-		return fmt.Errorf("`--initial-path` was not specified")
+	default:
+		return fmt.Errorf("unsupported `--data-api-mode` %q - supported values are `%s` and `%s`", dataApiMode, string(dataapi.InProcessMode), string(dataapi.HttpMode))
 	}
-	log.Logger.Debug(fmt.Sprintf("Determining the absolute path to %q", a.initialApiDefinitionsPath))
-	a.initialApiDefinitionsPath, err = filepath.Abs(a.initialApiDefinitionsPath)
+
+	dataSource, err := parseDataSource("initial", initialPath, initialDataSource)
 	if err != nil {
-		return fmt.Errorf("determining the absolute path to %q: %+v", a.initialApiDefinitionsPath, err)
+		return err
 	}
+	a.initialDataSource = *dataSource
 
-	if a.updatedApiDefinitionsPath == "" {
-		return fmt.Errorf("`--updated-path` was not specified")
-	}
-	log.Logger.Debug(fmt.Sprintf("Determining the absolute path to %q", a.updatedApiDefinitionsPath))
-	a.updatedApiDefinitionsPath, err = filepath.Abs(a.updatedApiDefinitionsPath)
+	dataSource, err = parseDataSource("updated", updatedPath, updatedDataSource)
 	if err != nil {
-		return fmt.Errorf("determining the absolute path to %q: %+v", a.updatedApiDefinitionsPath, err)
+		return err
+	}
+	a.updatedDataSource = *dataSource
+
+	if suppressionsFilePath != "" {
+		log.Logger.Debug(fmt.Sprintf("Determining the absolute path to %q", suppressionsFilePath))
+		path, err := filepath.Abs(suppressionsFilePath)
+		if err != nil {
+			return fmt.Errorf("determining the absolute path to %q: %+v", suppressionsFilePath, err)
+		}
+		a.suppressionsFilePath = &path
 	}
 
 	if a.outputFilePath != nil {
@@ -88,24 +126,54 @@ func (a *arguments) parse(input []string) error {
 	return nil
 }
 
-// validate asserts that the arguments are valid
-func (a *arguments) validate() error {
-	log.Logger.Trace("Validating the Initial API Definitions Path exists..")
-	if _, err := os.Stat(a.initialApiDefinitionsPath); err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("validating `initial-path`: %q does not exist", a.initialApiDefinitionsPath)
+// parseDataSource parses the Data Source specified using either `--{name}-path` or `--{name}-data-source`
+func parseDataSource(name, pathRaw, dataSourceRaw string) (*datasource.DataSource, error) {
+	if pathRaw != "" && dataSourceRaw != "" {
+		return nil, fmt.Errorf("only one of `--%[1]s-path` and `--%[1]s-data-source` can be specified", name)
+	}
+
+	var dataSource *datasource.DataSource
+	switch {
+	case pathRaw != "":
+		dataSource = &datasource.DataSource{
+			Type: datasource.DirectoryType,
+			Path: pathRaw,
+		}
+
+	case dataSourceRaw != "":
+		var err error
+		dataSource, err = datasource.Parse(dataSourceRaw)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `--%s-data-source`: %+v", name, err)
 		}
 
-		return fmt.Errorf("validating `initial-path`: %+v", err)
+	default:
+		return nil, fmt.Errorf("either `--%[1]s-path` or `--%[1]s-data-source` must be specified", name)
 	}
 
-	log.Logger.Trace("Validating the Updated API Definitions Path exists..")
-	if _, err := os.Stat(a.updatedApiDefinitionsPath); err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("validating `updated-path`: %q does not exist", a.updatedApiDefinitionsPath)
+	// the Path for a Git Data Source is the repository, which is the current working directory
+	if dataSource.Type != datasource.GitType {
+		log.Logger.Debug(fmt.Sprintf("Determining the absolute path to %q", dataSource.Path))
+		path, err := filepath.Abs(dataSource.Path)
+		if err != nil {
+			return nil, fmt.Errorf("determining the absolute path to %q: %+v", dataSource.Path, err)
 		}
+		dataSource.Path = path
+	}
+
+	return dataSource, nil
+}
+
+// validate asserts that the arguments are valid
+func (a *arguments) validate() error {
+	log.Logger.Trace("Validating the Initial API Definitions exist..")
+	if err := a.initialDataSource.Validate(); err != nil {
+		return fmt.Errorf("validating the initial API Definitions: %+v", err)
+	}
 
-		return fmt.Errorf("validating `updated-path`: %+v", err)
+	log.Logger.Trace("Validating the Updated API Definitions exist..")
+	if err := a.updatedDataSource.Validate(); err != nil {
+		return fmt.Errorf("validating the updated API Definitions: %+v", err)
 	}
 
 	return nil
@@ -6,11 +6,11 @@ package commands
 import (
 	"context"
 	"fmt"
-	"log"
 	"os"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/dataapi"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/differ"
 	internalLog "github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/views"
@@ -66,9 +66,11 @@ func (c DetectBreakingChangesCommand) Run(args []string) int {
 		return 1
 	}
 
-	c.logger.Info(fmt.Sprintf("Data API Binary located at %q", a.dataApiBinaryPath))
-	c.logger.Info(fmt.Sprintf("Initial API Definitions located at: %q", a.initialApiDefinitionsPath))
-	c.logger.Info(fmt.Sprintf("Updated API Definitions located at: %q", a.updatedApiDefinitionsPath))
+	if a.dataApiOptions.Mode == dataapi.HttpMode {
+		c.logger.Info(fmt.Sprintf("Data API Binary located at %q", a.dataApiOptions.BinaryPath))
+	}
+	c.logger.Info(fmt.Sprintf("Initial API Definitions located at: %q", a.initialDataSource.String()))
+	c.logger.Info(fmt.Sprintf("Updated API Definitions located at: %q", a.updatedDataSource.String()))
 
 	if a.outputFilePath != nil {
 		c.logger.Info(fmt.Sprintf("Output will be rendered to the file located at: %q", *a.outputFilePath))
@@ -78,7 +80,7 @@ func (c DetectBreakingChangesCommand) Run(args []string) int {
 
 	c.logger.Debug("Performing diff of the two data sources..")
 	includeNestedChangesWhenNew := false // not necessary since this is only tracking breaking changes
-	result, err := differ.Diff(ctx, a.dataApiBinaryPath, a.initialApiDefinitionsPath, a.updatedApiDefinitionsPath, c.sourceDataType, includeNestedChangesWhenNew)
+	result, err := differ.Diff(ctx, a.dataApiOptions, a.initialDataSource, a.updatedDataSource, c.sourceDataType, includeNestedChangesWhenNew)
 	if err != nil {
 		c.logger.Error(fmt.Sprintf("performing diff: %+v", err))
 		return 1
@@ -87,9 +89,17 @@ func (c DetectBreakingChangesCommand) Run(args []string) int {
 	// then render the output
 	c.logger.Debug("Rendering the Breaking Changes..")
 	view := views.NewBreakingChangesView(result.Changes)
-	rendered, err := view.RenderMarkdown()
+	if a.suppressionsFilePath != nil {
+		suppressionsResult, err := applySuppressionsFile(*a.suppressionsFilePath, result.Changes)
+		if err != nil {
+			c.logger.Error(fmt.Sprintf("applying the Suppressions File: %+v", err))
+			return 1
+		}
+		view = views.NewBreakingChangesView(suppressionsResult.Changes).WithSuppressions(*suppressionsResult)
+	}
+	rendered, err := renderView(view, a.outputFormat)
 	if err != nil {
-		c.logger.Error(fmt.Sprintf("rendering markdown: %+v", err))
+		c.logger.Error(fmt.Sprintf("rendering %s: %+v", string(a.outputFormat), err))
 		return 1
 	}
 
@@ -101,7 +111,7 @@ func (c DetectBreakingChangesCommand) Run(args []string) int {
 		}
 	} else {
 		c.logger.Trace("Rendering output to Terminal since no output file was specified..")
-		log.Print(*rendered)
+		printOutput(*rendered, a.outputFormat)
 	}
 
 	return 0
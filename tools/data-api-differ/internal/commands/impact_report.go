@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/dataapi"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/differ"
+	internalLog "github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/views"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = &ImpactReportCommand{}
+
+type ImpactReportCommand struct {
+	logger         hclog.Logger
+	sourceDataType models.SourceDataType
+}
+
+func NewImpactReportCommand(sourceDataType models.SourceDataType) func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return &ImpactReportCommand{
+			logger:         internalLog.Logger,
+			sourceDataType: sourceDataType,
+		}, nil
+	}
+}
+
+func (ImpactReportCommand) Help() string {
+	sourceDataTypes := make([]string, 0)
+	for _, item := range v1.AvailableSourceDataTypes() {
+		sourceDataTypes = append(sourceDataTypes, fmt.Sprintf("* %s", string(item)))
+	}
+	return fmt.Sprintf(`data-api-differ {source-data-type} impact-report
+
+Where '{source-data-type}' is one of:
+
+%s
+
+This command detects any changes that exist between the existing and an updated set of API Definitions and outputs
+which generated artifacts are affected by these - that is, which Go Packages (and files within them) output by
+'generator-go-sdk' will be added, regenerated or deleted, and which Terraform Resources (and Documentation pages)
+output by 'generator-terraform' are affected.
+
+Changes to the Common Types are not included, since these are not generated by 'generator-go-sdk'.
+`, strings.Join(sourceDataTypes, "\n"))
+}
+
+func (c ImpactReportCommand) Run(args []string) int {
+	c.logger.Info("Running `impact-report` command..")
+	ctx := context.Background()
+
+	a := arguments{}
+	c.logger.Debug("Parsing arguments..")
+	if err := a.parse(args); err != nil {
+		c.logger.Error(fmt.Sprintf("parsing arguments: %+v", err))
+		return 1
+	}
+
+	if err := a.validate(); err != nil {
+		c.logger.Error(fmt.Sprintf("validating arguments: %+v", err))
+		return 1
+	}
+
+	if a.dataApiOptions.Mode == dataapi.HttpMode {
+		c.logger.Info(fmt.Sprintf("Data API Binary located at %q", a.dataApiOptions.BinaryPath))
+	}
+	c.logger.Info(fmt.Sprintf("Initial API Definitions located at: %q", a.initialDataSource.String()))
+	c.logger.Info(fmt.Sprintf("Updated API Definitions located at: %q", a.updatedDataSource.String()))
+
+	if a.outputFilePath != nil {
+		c.logger.Info(fmt.Sprintf("Output will be rendered to the file located at: %q", *a.outputFilePath))
+	} else {
+		c.logger.Info("Output will be rendered to the console since no output file was specified")
+	}
+
+	c.logger.Debug("Performing diff of the two data sources..")
+	// the files within new/removed Go Packages are determined from the API Definitions, so nested Changes aren't needed
+	includeNestedChangesWhenNew := false
+	result, err := differ.Diff(ctx, a.dataApiOptions, a.initialDataSource, a.updatedDataSource, c.sourceDataType, includeNestedChangesWhenNew)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("performing diff: %+v", err))
+		return 1
+	}
+
+	// then render the output
+	c.logger.Debug("Rendering the Impact Report..")
+	view := views.NewImpactReportView(result.Changes, result.Initial, result.Updated, c.sourceDataType)
+	rendered, err := renderView(view, a.outputFormat)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("rendering %s: %+v", string(a.outputFormat), err))
+		return 1
+	}
+
+	// Finally determine how to output that
+	if a.outputFilePath != nil {
+		c.logger.Trace(fmt.Sprintf("Writing output to %q..", *a.outputFilePath))
+		if err := os.WriteFile(*a.outputFilePath, []byte(*rendered), 0644); err != nil {
+			c.logger.Error(fmt.Sprintf("writing output to %q: %+v", *a.outputFilePath, err))
+		}
+	} else {
+		c.logger.Trace("Rendering output to Terminal since no output file was specified..")
+		printOutput(*rendered, a.outputFormat)
+	}
+
+	return 0
+}
+
+func (ImpactReportCommand) Synopsis() string {
+	return "Outputs the generated Go Packages, Terraform Resources and Documentation pages affected by the changes between the existing and updated set of API Definitions"
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/views"
+)
+
+type outputFormat string
+
+const (
+	// jsonOutputFormat renders the output as JSON, the schema for which is documented in `internal/views/json.md`.
+	jsonOutputFormat outputFormat = "json"
+
+	// markdownOutputFormat renders the output as Markdown, intended for both display in a Terminal and to be
+	// output as a GitHub Comment.
+	markdownOutputFormat outputFormat = "markdown"
+)
+
+// renderView renders the View using the specified outputFormat.
+func renderView(view views.View, format outputFormat) (*string, error) {
+	switch format {
+	case jsonOutputFormat:
+		return view.RenderJSON()
+
+	case markdownOutputFormat:
+		return view.RenderMarkdown()
+	}
+
+	return nil, fmt.Errorf("unsupported output format %q", string(format))
+}
+
+// printOutput outputs rendered to the Terminal - JSON is output to stdout without a timestamp prefix, so that it
+// can be parsed by other tools.
+func printOutput(rendered string, format outputFormat) {
+	if format == jsonOutputFormat {
+		fmt.Println(rendered)
+		return
+	}
+
+	log.Print(rendered)
+}
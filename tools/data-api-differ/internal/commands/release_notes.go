@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/dataapi"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/differ"
+	internalLog "github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/views"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = &ReleaseNotesCommand{}
+
+type ReleaseNotesCommand struct {
+	logger         hclog.Logger
+	sourceDataType models.SourceDataType
+}
+
+func NewReleaseNotesCommand(sourceDataType models.SourceDataType) func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return &ReleaseNotesCommand{
+			logger:         internalLog.Logger,
+			sourceDataType: sourceDataType,
+		}, nil
+	}
+}
+
+func (ReleaseNotesCommand) Help() string {
+	sourceDataTypes := make([]string, 0)
+	for _, item := range v1.AvailableSourceDataTypes() {
+		sourceDataTypes = append(sourceDataTypes, fmt.Sprintf("* %s", string(item)))
+	}
+	return fmt.Sprintf(`data-api-differ {source-data-type} release-notes
+
+Where '{source-data-type}' is one of:
+
+%s
+
+This command detects any changes that exist between the existing and an updated set of API Definitions and outputs
+these as Release Notes for the Go SDK - grouped by the generated Go Package, along with a recommendation for the
+Semantic Version component (major, minor or patch) which should be incremented.
+
+Changes to the Terraform Definitions are not included, since these don't affect the Go SDK.
+`, strings.Join(sourceDataTypes, "\n"))
+}
+
+func (c ReleaseNotesCommand) Run(args []string) int {
+	c.logger.Info("Running `release-notes` command..")
+	ctx := context.Background()
+
+	a := arguments{}
+	c.logger.Debug("Parsing arguments..")
+	if err := a.parse(args); err != nil {
+		c.logger.Error(fmt.Sprintf("parsing arguments: %+v", err))
+		return 1
+	}
+
+	if err := a.validate(); err != nil {
+		c.logger.Error(fmt.Sprintf("validating arguments: %+v", err))
+		return 1
+	}
+
+	if a.dataApiOptions.Mode == dataapi.HttpMode {
+		c.logger.Info(fmt.Sprintf("Data API Binary located at %q", a.dataApiOptions.BinaryPath))
+	}
+	c.logger.Info(fmt.Sprintf("Initial API Definitions located at: %q", a.initialDataSource.String()))
+	c.logger.Info(fmt.Sprintf("Updated API Definitions located at: %q", a.updatedDataSource.String()))
+
+	if a.outputFilePath != nil {
+		c.logger.Info(fmt.Sprintf("Output will be rendered to the file located at: %q", *a.outputFilePath))
+	} else {
+		c.logger.Info("Output will be rendered to the console since no output file was specified")
+	}
+
+	c.logger.Debug("Performing diff of the two data sources..")
+	includeNestedChangesWhenNew := false // TODO: expose this as a `--full` flag
+	result, err := differ.Diff(ctx, a.dataApiOptions, a.initialDataSource, a.updatedDataSource, c.sourceDataType, includeNestedChangesWhenNew)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("performing diff: %+v", err))
+		return 1
+	}
+
+	// then render the output
+	c.logger.Debug("Rendering the Release Notes..")
+	view := views.NewReleaseNotesView(result.Changes, c.sourceDataType)
+	rendered, err := renderView(view, a.outputFormat)
+	if err != nil {
+		c.logger.Error(fmt.Sprintf("rendering %s: %+v", string(a.outputFormat), err))
+		return 1
+	}
+
+	// Finally determine how to output that
+	if a.outputFilePath != nil {
+		c.logger.Trace(fmt.Sprintf("Writing output to %q..", *a.outputFilePath))
+		if err := os.WriteFile(*a.outputFilePath, []byte(*rendered), 0644); err != nil {
+			c.logger.Error(fmt.Sprintf("writing output to %q: %+v", *a.outputFilePath, err))
+		}
+	} else {
+		c.logger.Trace("Rendering output to Terminal since no output file was specified..")
+		printOutput(*rendered, a.outputFormat)
+	}
+
+	return 0
+}
+
+func (ReleaseNotesCommand) Synopsis() string {
+	return "Outputs the Release Notes and recommended version bump for the Go SDK based on the changes between the existing and updated set of API Definitions"
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/suppressions"
+)
+
+// applySuppressionsFile applies the Suppressions File at filePath to the Changes within input.
+func applySuppressionsFile(filePath string, input []changes.Change) (*suppressions.Result, error) {
+	log.Logger.Debug(fmt.Sprintf("Loading the Suppressions File from %q..", filePath))
+	config, err := suppressions.LoadFromFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("loading the Suppressions File from %q: %+v", filePath, err)
+	}
+
+	log.Logger.Debug(fmt.Sprintf("Applying %d Suppressions..", len(config.Suppressions)))
+	result, err := suppressions.Apply(input, *config, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("applying the Suppressions File: %+v", err)
+	}
+
+	log.Logger.Info(fmt.Sprintf("%d Changes were acknowledged, %d Suppressions have expired and %d Suppressions didn't match any Changes", len(result.Acknowledged), len(result.Expired), len(result.Unmatched)))
+	return result, nil
+}
@@ -10,14 +10,72 @@ import (
 
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
 	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/inprocess"
 )
 
-// ParseDataFromPath launches the Data API using inputPath as the API Definitions directory.
-func ParseDataFromPath(ctx context.Context, dataApiBinary, inputPath string, sourceDataType models.SourceDataType) (*v1.LoadAllDataResult, error) {
+// Mode specifies how the API Definitions are loaded.
+type Mode string
+
+const (
+	// InProcessMode serves the Data API in-process (using the Data API's Repositories and Endpoints) to load the API
+	// Definitions from the Data Source, rather than launching the Data API binary.
+	InProcessMode Mode = "in-process"
+
+	// HttpMode launches the Data API (V2) binary for each Data Source and retrieves the API Definitions over HTTP.
+	HttpMode Mode = "http"
+)
+
+// Options specifies how the API Definitions should be loaded.
+type Options struct {
+	// Mode specifies how the API Definitions are loaded.
+	Mode Mode
+
+	// BinaryPath specifies the path to the Data API (V2) binary, which is only used when Mode is HttpMode.
+	BinaryPath string
+}
+
+// ParseDataFromDataSource loads the API Definitions from dataSource, using the Mode specified in options.
+func ParseDataFromDataSource(ctx context.Context, options Options, dataSource datasource.DataSource, sourceDataType models.SourceDataType) (*v1.LoadAllDataResult, error) {
+	switch options.Mode {
+	case HttpMode:
+		return parseDataUsingDataApi(ctx, options.BinaryPath, dataSource, sourceDataType)
+
+	case InProcessMode:
+		return parseDataInProcess(ctx, dataSource, sourceDataType)
+	}
+
+	return nil, fmt.Errorf("unsupported mode %q", string(options.Mode))
+}
+
+// parseDataInProcess loads the API Definitions from dataSource using the Data API in-process, rather than launching it.
+func parseDataInProcess(ctx context.Context, dataSource datasource.DataSource, sourceDataType models.SourceDataType) (*v1.LoadAllDataResult, error) {
+	log.Logger.Info("Loading the API Definitions..")
+	fileSystem, err := dataSource.Open()
+	if err != nil {
+		return nil, fmt.Errorf("opening the Data Source: %+v", err)
+	}
+	defer fileSystem.Close()
+
+	client, err := inprocess.NewClientForFileSystem(fileSystem, sourceDataType)
+	if err != nil {
+		return nil, fmt.Errorf("building the client: %+v", err)
+	}
+
+	data, err := client.LoadAllData(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("loading data: %+v", err)
+	}
+
+	return data, nil
+}
+
+// parseDataUsingDataApi launches the Data API using dataSource as the source of the API Definitions.
+func parseDataUsingDataApi(ctx context.Context, dataApiBinary string, dataSource datasource.DataSource, sourceDataType models.SourceDataType) (*v1.LoadAllDataResult, error) {
 	port := randomPortNumber()
 	log.Logger.Info("Launching Data API..")
-	dataApi := newDataApiCmd(dataApiBinary, port, inputPath)
+	dataApi := newDataApiCmd(dataApiBinary, port, dataSource)
 
 	client := v1.NewClient(dataApi.endpoint, sourceDataType)
 	if err := dataApi.launchAndWait(ctx, client); err != nil {
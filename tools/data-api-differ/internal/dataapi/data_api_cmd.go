@@ -4,9 +4,9 @@
 package dataapi
 
 import (
+	"bytes"
 	"context"
 	"fmt"
-	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
 	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
 )
 
 // dataApiCmd is a wrapper for managing Data API V2 which picks a unique port and serves the API.
@@ -21,27 +22,37 @@ type dataApiCmd struct {
 	cmd      *exec.Cmd
 	endpoint string
 	port     int
+
+	// exited receives the result of waiting for the Data API process once it's exited.
+	exited chan error
+
+	// output contains the combined stdout/stderr of the Data API, which is included in any errors.
+	// This must only be read once the process has exited.
+	output *bytes.Buffer
 }
 
 // newDataApiCmd prepares the Data API (V2) to be launched.
-func newDataApiCmd(binary string, port int, workingDirectory string) *dataApiCmd {
+func newDataApiCmd(binary string, port int, dataSource datasource.DataSource) *dataApiCmd {
 	args := []string{
 		"serve",
-		fmt.Sprintf("--data-directory=%s", workingDirectory),
+		fmt.Sprintf("--data-source=%s", dataSource.String()),
 	}
 	log.Logger.Debug(fmt.Sprintf("Launching %q with args %q..", binary, strings.Join(args, " ")))
 	cmd := exec.Command(binary, args...)
 	cmd.Env = os.Environ()
 	cmd.Env = append(cmd.Env, fmt.Sprintf("PANDORA_API_PORT=%d", port))
 
-	// we could pipe this elsewhere, but this is probably sufficient to ignore for now
-	cmd.Stderr = io.Discard
-	cmd.Stdout = io.Discard
+	// the output is captured so that it can be surfaced if the Data API fails to launch
+	output := &bytes.Buffer{}
+	cmd.Stderr = output
+	cmd.Stdout = output
 
 	return &dataApiCmd{
 		cmd:      cmd,
 		endpoint: fmt.Sprintf("http://localhost:%d", port),
 		port:     port,
+		exited:   make(chan error, 1),
+		output:   output,
 	}
 }
 
@@ -51,15 +62,24 @@ func (p *dataApiCmd) launchAndWait(ctx context.Context, client *v1.Client) error
 	if err := p.cmd.Start(); err != nil {
 		return fmt.Errorf("launching Data API: %+v", err)
 	}
+	go func() {
+		p.exited <- p.cmd.Wait()
+	}()
 	log.Logger.Trace(fmt.Sprintf("Data API is launched at %q.", p.endpoint))
 
 	// then ensure it's accepting requests prior to hitting it (e.g. firewalls)
 	for attempts := 0; attempts < 30; attempts++ {
 		log.Logger.Trace(fmt.Sprintf("Checking the health of the Data API - attempt %d/30", attempts+1))
 
+		select {
+		case err := <-p.exited:
+			return fmt.Errorf("the Data API exited unexpectedly (%+v). Output:\n\n%s", err, p.output.String())
+		default:
+		}
+
 		result, err := client.Health(ctx)
 		if err != nil {
-			return fmt.Errorf("unexpected status code %d", result.HttpResponse.StatusCode)
+			return fmt.Errorf("checking the health of the Data API: %+v. Output:\n\n%s", err, p.shutdownAndReturnOutput())
 		}
 
 		if result.Available {
@@ -67,18 +87,37 @@ func (p *dataApiCmd) launchAndWait(ctx context.Context, client *v1.Client) error
 			return nil
 		}
 
-		log.Logger.Trace(fmt.Sprintf("API not ready - waiting 1s to try again (%+v)", err))
+		log.Logger.Trace("API not ready - waiting 1s to try again")
 		time.Sleep(1 * time.Second)
 	}
 
-	return fmt.Errorf("the Data API didn't return a 200 OK within 30 seconds")
+	return fmt.Errorf("the Data API didn't return a 200 OK within 30 seconds. Output:\n\n%s", p.shutdownAndReturnOutput())
 }
 
-// shutdown will terminate the Data API process if launched.
+// shutdown will interrupt the Data API process if launched (allowing it to remove any temporary files used to read
+// the Data Source) and wait for it to exit - killing the process if it hasn't exited within 10 seconds.
 func (p *dataApiCmd) shutdown() error {
-	if p.cmd.Process != nil {
+	if p.cmd.Process == nil {
+		return nil
+	}
+
+	if err := p.cmd.Process.Signal(os.Interrupt); err != nil {
+		// interrupting a process isn't supported on Windows
+		p.cmd.Process.Kill()
+	}
+	select {
+	case <-p.exited:
+	case <-time.After(10 * time.Second):
+		log.Logger.Debug("The Data API didn't exit within 10 seconds - killing the process")
 		p.cmd.Process.Kill()
+		<-p.exited
 	}
 
 	return nil
 }
+
+// shutdownAndReturnOutput terminates the Data API process and then returns the output from it.
+func (p *dataApiCmd) shutdownAndReturnOutput() string {
+	p.shutdown()
+	return p.output.String()
+}
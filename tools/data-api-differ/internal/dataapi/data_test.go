@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dataapi
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func init() {
+	log.Logger = hclog.Default()
+}
+
+func TestParseDataFromDataSource_InProcess(t *testing.T) {
+	directory := t.TempDir()
+	files := map[string]string{
+		"resource-manager/metadata.json":                                  `{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`,
+		"resource-manager/Example/ServiceDefinition.json":                 `{"name": "Example", "resourceProvider": "Microsoft.Example", "generate": true}`,
+		"resource-manager/Example/2020-01-01/ApiVersionDefinition.json":   `{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`,
+		"resource-manager/Example/2020-01-01/Things/Constant-Colour.json": `{"name": "Colour", "type": "String", "values": [{"key": "Red", "value": "red"}]}`,
+	}
+	for filePath, contents := range files {
+		fullPath := filepath.Join(directory, filePath)
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", filePath, err)
+		}
+		if err := os.WriteFile(fullPath, []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", filePath, err)
+		}
+	}
+
+	options := Options{
+		Mode: InProcessMode,
+	}
+	dataSource := datasource.DataSource{
+		Type: datasource.DirectoryType,
+		Path: directory,
+	}
+	result, err := ParseDataFromDataSource(context.TODO(), options, dataSource, models.ResourceManagerSourceDataType)
+	if err != nil {
+		t.Fatalf("parsing data: %+v", err)
+	}
+
+	service, ok := result.Services["Example"]
+	if !ok {
+		t.Fatalf("expected the Service `Example` to be loaded but got %+v", result.Services)
+	}
+	constant, ok := service.APIVersions["2020-01-01"].Resources["Things"].Constants["Colour"]
+	if !ok {
+		t.Fatalf("expected the Constant `Colour` to be loaded")
+	}
+	if constant.Values["Red"] != "red" {
+		t.Fatalf("expected the Constant `Colour` to have the value `red` for `Red` but got %+v", constant.Values)
+	}
+}
+
+func TestParseDataFromDataSource_UnsupportedMode(t *testing.T) {
+	options := Options{
+		Mode: Mode("invalid"),
+	}
+	if _, err := ParseDataFromDataSource(context.TODO(), options, datasource.DataSource{}, models.ResourceManagerSourceDataType); err == nil {
+		t.Fatalf("expected an error for an unsupported mode")
+	}
+}
@@ -26,7 +26,24 @@ func performDiff(initial, updated v1.LoadAllDataResult, includeNestedChangesWhen
 	}
 	output = append(output, *resourceManagerChanges...)
 
+	log.Logger.Trace("Detecting changes to the Common Types..")
+	initialCommonTypes := commonTypesData{
+		commonTypes: initial.CommonTypes,
+		services:    initial.Services,
+	}
+	updatedCommonTypes := commonTypesData{
+		commonTypes: updated.CommonTypes,
+		services:    updated.Services,
+	}
+	commonTypesChanges, err := diff.changesForCommonTypes(initialCommonTypes, updatedCommonTypes)
+	if err != nil {
+		return nil, fmt.Errorf("determining changes for the Common Types: %+v", err)
+	}
+	output = append(output, *commonTypesChanges...)
+
 	return &Result{
 		Changes: output,
+		Initial: initial,
+		Updated: updated,
 	}, nil
 }
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// commonTypesData contains the Common Types and the Services which can reference them.
+type commonTypesData struct {
+	commonTypes models.CommonTypes
+	services    map[string]models.Service
+}
+
+// changesForCommonTypes determines the changes between the initial and updated set of Common Types.
+//
+// These use the same Change types as the Constants and Models within an API Resource, however each Change is
+// attributed to changes.CommonTypesServiceName and lists the Services which reference the changed Common Type.
+func (d differ) changesForCommonTypes(initial, updated commonTypesData) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+
+	log.Logger.Trace("Detecting changes to the Common Types Constants..")
+	for _, constantName := range d.uniqueConstantNames(initial.commonTypes.Constants, updated.commonTypes.Constants) {
+		changesForConstant := d.changesForConstant(changes.CommonTypesServiceName, "", "", constantName, initial.commonTypes.Constants, updated.commonTypes.Constants)
+		if len(changesForConstant) == 0 {
+			continue
+		}
+
+		referencedByServices, err := d.servicesReferencingCommonType(constantName, initial, updated)
+		if err != nil {
+			return nil, fmt.Errorf("determining the Services referencing the Common Type Constant %q: %+v", constantName, err)
+		}
+		for _, change := range changesForConstant {
+			output = append(output, withReferencedByServices(change, referencedByServices))
+		}
+	}
+
+	log.Logger.Trace("Detecting changes to the Common Types Models..")
+	for _, modelName := range d.uniqueModelNames(initial.commonTypes.Models, updated.commonTypes.Models) {
+		changesForModel, err := d.changesForModel(changes.CommonTypesServiceName, "", "", modelName, initial.commonTypes.Models, updated.commonTypes.Models)
+		if err != nil {
+			return nil, fmt.Errorf("detecting changes to the Common Type Model %q: %+v", modelName, err)
+		}
+		if len(*changesForModel) == 0 {
+			continue
+		}
+
+		referencedByServices, err := d.servicesReferencingCommonType(modelName, initial, updated)
+		if err != nil {
+			return nil, fmt.Errorf("determining the Services referencing the Common Type Model %q: %+v", modelName, err)
+		}
+		for _, change := range *changesForModel {
+			output = append(output, withReferencedByServices(change, referencedByServices))
+		}
+	}
+
+	return &output, nil
+}
+
+// servicesReferencingCommonType returns a unique, sorted list of the Services which reference the Common Type named name
+// (either directly or transitively) in either the initial or updated set of data.
+func (d differ) servicesReferencingCommonType(name string, initial, updated commonTypesData) ([]string, error) {
+	uniqueNames := make(map[string]struct{})
+	for _, data := range []commonTypesData{initial, updated} {
+		_, isConstant := data.commonTypes.Constants[name]
+		_, isModel := data.commonTypes.Models[name]
+		if !isConstant && !isModel {
+			// e.g. the Common Type has been added/removed
+			continue
+		}
+
+		references, err := helpers.FindReferencesToCommonType(data.commonTypes, data.services, name)
+		if err != nil {
+			return nil, fmt.Errorf("finding the references to %q: %+v", name, err)
+		}
+		for _, reference := range *references {
+			if reference.ServiceName != nil {
+				uniqueNames[*reference.ServiceName] = struct{}{}
+			}
+		}
+	}
+
+	output := make([]string, 0)
+	for k := range uniqueNames {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output, nil
+}
+
+// withReferencedByServices returns input with ReferencedByServices set to referencedByServices - this is only
+// supported for the Change types which can be raised for a Common Type (see changes.CommonTypeChange).
+func withReferencedByServices(input changes.Change, referencedByServices []string) changes.Change {
+	if v, ok := input.(changes.CommonTypeChange); ok {
+		return v.WithReferencedByServices(referencedByServices)
+	}
+
+	return input
+}
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestDiff_CommonTypesNoChanges(t *testing.T) {
+	initial := commonTypesData{
+		commonTypes: models.CommonTypes{
+			Constants: map[string]models.SDKConstant{
+				"SkuName": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Basic": "Basic",
+					},
+				},
+			},
+			Models: map[string]models.SDKModel{},
+		},
+		services: servicesReferencingCommonType("Compute", "SkuName"),
+	}
+	updated := commonTypesData{
+		commonTypes: models.CommonTypes{
+			Constants: map[string]models.SDKConstant{
+				"SkuName": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Basic": "Basic",
+					},
+				},
+			},
+			Models: map[string]models.SDKModel{},
+		},
+		services: servicesReferencingCommonType("Compute", "SkuName"),
+	}
+	actual, err := differ{}.changesForCommonTypes(initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := make([]changes.Change, 0)
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_CommonTypesConstantChanged(t *testing.T) {
+	initial := commonTypesData{
+		commonTypes: models.CommonTypes{
+			Constants: map[string]models.SDKConstant{
+				"SkuName": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Basic":    "Basic",
+						"Standard": "Standard",
+					},
+				},
+			},
+		},
+		services: servicesReferencingCommonType("Compute", "SkuName"),
+	}
+	updated := commonTypesData{
+		commonTypes: models.CommonTypes{
+			Constants: map[string]models.SDKConstant{
+				"SkuName": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Basic": "Basic",
+					},
+				},
+			},
+		},
+		services: servicesReferencingCommonType("Network", "SkuName"),
+	}
+	actual, err := differ{}.changesForCommonTypes(initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.ConstantKeyValueRemoved{
+			ServiceName:          changes.CommonTypesServiceName,
+			ConstantName:         "SkuName",
+			ConstantKey:          "Standard",
+			ConstantValue:        "Standard",
+			ReferencedByServices: []string{"Compute", "Network"},
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_CommonTypesModelFieldChanged(t *testing.T) {
+	initial := commonTypesData{
+		commonTypes: models.CommonTypes{
+			Models: map[string]models.SDKModel{
+				"Sku": {
+					Fields: map[string]models.SDKField{
+						"Name": {
+							JsonName: "name",
+							ObjectDefinition: models.SDKObjectDefinition{
+								Type: models.StringSDKObjectDefinitionType,
+							},
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		services: servicesReferencingCommonType("Compute", "Sku"),
+	}
+	updated := commonTypesData{
+		commonTypes: models.CommonTypes{
+			Models: map[string]models.SDKModel{
+				"Sku": {
+					Fields: map[string]models.SDKField{
+						"Name": {
+							JsonName: "name",
+							ObjectDefinition: models.SDKObjectDefinition{
+								Type: models.StringSDKObjectDefinitionType,
+							},
+							Required: true,
+						},
+					},
+				},
+				"Unused": {
+					Fields: map[string]models.SDKField{},
+				},
+			},
+		},
+		services: servicesReferencingCommonType("Compute", "Sku"),
+	}
+	actual, err := differ{}.changesForCommonTypes(initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldIsNowRequired{
+			ServiceName:          changes.CommonTypesServiceName,
+			ModelName:            "Sku",
+			FieldName:            "Name",
+			ReferencedByServices: []string{"Compute"},
+		},
+		changes.ModelAdded{
+			ServiceName:          changes.CommonTypesServiceName,
+			ModelName:            "Unused",
+			ReferencedByServices: []string{},
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+// servicesReferencingCommonType returns a set of Services containing a single Service which references the Common Type
+// named typeName from a Field within a Model.
+func servicesReferencingCommonType(serviceName, typeName string) map[string]models.Service {
+	return map[string]models.Service{
+		serviceName: {
+			APIVersions: map[string]models.APIVersion{
+				"2020-01-01": {
+					Resources: map[string]models.APIResource{
+						"Example": {
+							Constants: map[string]models.SDKConstant{},
+							Models: map[string]models.SDKModel{
+								"Example": {
+									Fields: map[string]models.SDKField{
+										"Value": {
+											JsonName: "value",
+											ObjectDefinition: models.SDKObjectDefinition{
+												Type:          models.ReferenceSDKObjectDefinitionType,
+												ReferenceName: pointer.To(typeName),
+											},
+										},
+									},
+								},
+							},
+							Operations:  map[string]models.SDKOperation{},
+							ResourceIDs: map[string]models.ResourceID{},
+						},
+					},
+				},
+			},
+		},
+	}
+}
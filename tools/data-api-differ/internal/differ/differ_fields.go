@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
@@ -96,11 +97,87 @@ func (d differ) changesForField(serviceName, apiVersion, apiResource, modelName,
 		})
 	}
 
-	// TODO: DateFormat in the future?
+	if pointer.From(oldData.DateFormat) != pointer.From(updatedData.DateFormat) {
+		log.Logger.Trace(fmt.Sprintf("Field %q has a new DateFormat - old %q / new %q", fieldName, string(pointer.From(oldData.DateFormat)), string(pointer.From(updatedData.DateFormat))))
+		output = append(output, changes.FieldDateFormatChanged{
+			ServiceName:  serviceName,
+			ApiVersion:   apiVersion,
+			ResourceName: apiResource,
+			ModelName:    modelName,
+			FieldName:    fieldName,
+			OldValue:     string(pointer.From(oldData.DateFormat)),
+			NewValue:     string(pointer.From(updatedData.DateFormat)),
+		})
+	}
+
+	output = append(output, d.metadataChangesForField(serviceName, apiVersion, apiResource, modelName, fieldName, oldData, updatedData)...)
 
 	return &output, nil
 }
 
+// metadataChangesForField determines the changes to the metadata (that is, whether the Field is ReadOnly or Sensitive,
+// and the Description) between the initial and updated version of this Field.
+func (d differ) metadataChangesForField(serviceName, apiVersion, apiResource, modelName, fieldName string, initial, updated models.SDKField) []changes.Change {
+	output := make([]changes.Change, 0)
+
+	if !initial.ReadOnly && updated.ReadOnly {
+		log.Logger.Trace(fmt.Sprintf("Field %q is now ReadOnly", fieldName))
+		output = append(output, changes.FieldIsNowReadOnly{
+			ServiceName:  serviceName,
+			ApiVersion:   apiVersion,
+			ResourceName: apiResource,
+			ModelName:    modelName,
+			FieldName:    fieldName,
+		})
+	}
+	if initial.ReadOnly && !updated.ReadOnly {
+		log.Logger.Trace(fmt.Sprintf("Field %q is no longer ReadOnly", fieldName))
+		output = append(output, changes.FieldIsNoLongerReadOnly{
+			ServiceName:  serviceName,
+			ApiVersion:   apiVersion,
+			ResourceName: apiResource,
+			ModelName:    modelName,
+			FieldName:    fieldName,
+		})
+	}
+
+	if !initial.Sensitive && updated.Sensitive {
+		log.Logger.Trace(fmt.Sprintf("Field %q is now Sensitive", fieldName))
+		output = append(output, changes.FieldIsNowSensitive{
+			ServiceName:  serviceName,
+			ApiVersion:   apiVersion,
+			ResourceName: apiResource,
+			ModelName:    modelName,
+			FieldName:    fieldName,
+		})
+	}
+	if initial.Sensitive && !updated.Sensitive {
+		log.Logger.Trace(fmt.Sprintf("Field %q is no longer Sensitive", fieldName))
+		output = append(output, changes.FieldIsNoLongerSensitive{
+			ServiceName:  serviceName,
+			ApiVersion:   apiVersion,
+			ResourceName: apiResource,
+			ModelName:    modelName,
+			FieldName:    fieldName,
+		})
+	}
+
+	if initial.Description != updated.Description {
+		log.Logger.Trace(fmt.Sprintf("Field %q has a new Description", fieldName))
+		output = append(output, changes.FieldDescriptionChanged{
+			ServiceName:  serviceName,
+			ApiVersion:   apiVersion,
+			ResourceName: apiResource,
+			ModelName:    modelName,
+			FieldName:    fieldName,
+			OldValue:     initial.Description,
+			NewValue:     updated.Description,
+		})
+	}
+
+	return output
+}
+
 // changesForFields determines the changes between the initial and updated Fields within the specified Model.
 func (d differ) changesForFields(serviceName, apiVersion, apiResource, modelName string, initial, updated map[string]models.SDKField) (*[]changes.Change, error) {
 	output := make([]changes.Change, 0)
@@ -247,3 +247,211 @@ func TestDiff_FieldObjectDefinitionChanged(t *testing.T) {
 	assertChanges(t, expected, *actual)
 	assertContainsBreakingChanges(t, *actual)
 }
+
+func TestDiff_FieldIsNowReadOnly(t *testing.T) {
+	initial := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			ReadOnly: false,
+		},
+	}
+	updated := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			ReadOnly: true,
+		},
+	}
+	actual, err := differ{}.changesForFields("Computer", "2020-01-01", "Example", "SomeModel", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldIsNowReadOnly{
+			ServiceName:  "Computer",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Example",
+			ModelName:    "SomeModel",
+			FieldName:    "First",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_FieldIsNoLongerReadOnly(t *testing.T) {
+	initial := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			ReadOnly: true,
+		},
+	}
+	updated := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			ReadOnly: false,
+		},
+	}
+	actual, err := differ{}.changesForFields("Computer", "2020-01-01", "Example", "SomeModel", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldIsNoLongerReadOnly{
+			ServiceName:  "Computer",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Example",
+			ModelName:    "SomeModel",
+			FieldName:    "First",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_FieldIsNowSensitive(t *testing.T) {
+	initial := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			Sensitive: false,
+		},
+	}
+	updated := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			Sensitive: true,
+		},
+	}
+	actual, err := differ{}.changesForFields("Computer", "2020-01-01", "Example", "SomeModel", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldIsNowSensitive{
+			ServiceName:  "Computer",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Example",
+			ModelName:    "SomeModel",
+			FieldName:    "First",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_FieldIsNoLongerSensitive(t *testing.T) {
+	initial := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			Sensitive: true,
+		},
+	}
+	updated := map[string]models.SDKField{
+		"First": {
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+			Sensitive: false,
+		},
+	}
+	actual, err := differ{}.changesForFields("Computer", "2020-01-01", "Example", "SomeModel", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldIsNoLongerSensitive{
+			ServiceName:  "Computer",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Example",
+			ModelName:    "SomeModel",
+			FieldName:    "First",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_FieldDateFormatChanged(t *testing.T) {
+	initial := map[string]models.SDKField{
+		"First": {
+			DateFormat: pointer.To(models.RFC3339SDKDateFormat),
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.DateTimeSDKObjectDefinitionType,
+			},
+		},
+	}
+	updated := map[string]models.SDKField{
+		"First": {
+			DateFormat: pointer.To(models.RFC3339NanoSDKDateFormat),
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.DateTimeSDKObjectDefinitionType,
+			},
+		},
+	}
+	actual, err := differ{}.changesForFields("Computer", "2020-01-01", "Example", "SomeModel", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldDateFormatChanged{
+			ServiceName:  "Computer",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Example",
+			ModelName:    "SomeModel",
+			FieldName:    "First",
+			OldValue:     string(models.RFC3339SDKDateFormat),
+			NewValue:     string(models.RFC3339NanoSDKDateFormat),
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_FieldDescriptionChanged(t *testing.T) {
+	initial := map[string]models.SDKField{
+		"First": {
+			Description: "The old description.",
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+		},
+	}
+	updated := map[string]models.SDKField{
+		"First": {
+			Description: "The new description.",
+			ObjectDefinition: models.SDKObjectDefinition{
+				Type: models.StringSDKObjectDefinitionType,
+			},
+		},
+	}
+	actual, err := differ{}.changesForFields("Computer", "2020-01-01", "Example", "SomeModel", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldDescriptionChanged{
+			ServiceName:  "Computer",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Example",
+			ModelName:    "SomeModel",
+			FieldName:    "First",
+			OldValue:     "The old description.",
+			NewValue:     "The new description.",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
@@ -4,6 +4,7 @@
 package differ
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
@@ -20,6 +21,30 @@ func (d differ) stringifySDKOperationOptionObjectDefinition(input models.SDKOper
 	return helpers.GolangTypeForSDKOperationOptionObjectDefinition(input)
 }
 
+// stringifyTerraformSchemaObjectDefinition returns a human readable, string version of this TerraformSchemaObjectDefinition
+// (e.g. `List[String]` or `Reference[SomeModel]`).
+func (d differ) stringifyTerraformSchemaObjectDefinition(input models.TerraformSchemaObjectDefinition) (*string, error) {
+	if input.NestedObject != nil {
+		nested, err := d.stringifyTerraformSchemaObjectDefinition(*input.NestedObject)
+		if err != nil {
+			return nil, fmt.Errorf("stringifying the Nested Object: %+v", err)
+		}
+		output := fmt.Sprintf("%s[%s]", input.Type, *nested)
+		return &output, nil
+	}
+
+	if input.Type == models.ReferenceTerraformSchemaObjectDefinitionType {
+		if input.ReferenceName == nil {
+			return nil, fmt.Errorf("a Reference type must have a ReferenceName")
+		}
+		output := fmt.Sprintf("%s[%s]", input.Type, *input.ReferenceName)
+		return &output, nil
+	}
+
+	output := string(input.Type)
+	return &output, nil
+}
+
 // uniqueConstantNames returns a unique, sorted list of Keys from initial and updated.
 func (d differ) uniqueKeys(initial, updated map[string]string) []string {
 	uniqueNames := make(map[string]struct{})
@@ -214,6 +214,8 @@ func (d differ) changesForOperationOptionsObject(serviceName, apiVersion, apiRes
 		})
 	}
 	if len(initial.Options) > 0 && len(updated.Options) > 0 {
+		output = append(output, d.changesForOperationOptionNames(serviceName, apiVersion, apiResource, operationName, initial.Options, updated.Options)...)
+
 		matches, err := d.optionsMatch(initial.Options, updated.Options)
 		if err != nil {
 			return nil, fmt.Errorf("determining whether the initial and updated Options Objects match: %+v", err)
@@ -242,6 +244,51 @@ func (d differ) changesForOperationOptionsObject(serviceName, apiVersion, apiRes
 	return &output, nil
 }
 
+// changesForOperationOptionNames determines any changes to the Header/QueryString names used for the Options which
+// exist in both the initial and updated version of this Operation.
+func (d differ) changesForOperationOptionNames(serviceName, apiVersion, apiResource, operationName string, initial, updated map[string]models.SDKOperationOption) []changes.Change {
+	output := make([]changes.Change, 0)
+
+	optionNames := make([]string, 0)
+	for optionName := range initial {
+		if _, ok := updated[optionName]; ok {
+			optionNames = append(optionNames, optionName)
+		}
+	}
+	sort.Strings(optionNames)
+
+	for _, optionName := range optionNames {
+		oldData := initial[optionName]
+		updatedData := updated[optionName]
+		if pointer.From(oldData.HeaderName) != pointer.From(updatedData.HeaderName) {
+			log.Logger.Trace(fmt.Sprintf("Option %q has a new Header Name - old %q / new %q", optionName, pointer.From(oldData.HeaderName), pointer.From(updatedData.HeaderName)))
+			output = append(output, changes.OperationOptionHeaderNameChanged{
+				ServiceName:   serviceName,
+				ApiVersion:    apiVersion,
+				ResourceName:  apiResource,
+				OperationName: operationName,
+				OptionName:    optionName,
+				OldValue:      pointer.From(oldData.HeaderName),
+				NewValue:      pointer.From(updatedData.HeaderName),
+			})
+		}
+		if pointer.From(oldData.QueryStringName) != pointer.From(updatedData.QueryStringName) {
+			log.Logger.Trace(fmt.Sprintf("Option %q has a new QueryString Name - old %q / new %q", optionName, pointer.From(oldData.QueryStringName), pointer.From(updatedData.QueryStringName)))
+			output = append(output, changes.OperationOptionQueryStringNameChanged{
+				ServiceName:   serviceName,
+				ApiVersion:    apiVersion,
+				ResourceName:  apiResource,
+				OperationName: operationName,
+				OptionName:    optionName,
+				OldValue:      pointer.From(oldData.QueryStringName),
+				NewValue:      pointer.From(updatedData.QueryStringName),
+			})
+		}
+	}
+
+	return output
+}
+
 // changesForOperationRequestObject determines any changes to the Request Object in both the initial and updated versions of this Operation.
 func (d differ) changesForOperationRequestObject(serviceName, apiVersion, apiResource, operationName string, initial, updated models.SDKOperation) (*[]changes.Change, error) {
 	output := make([]changes.Change, 0)
@@ -475,14 +522,15 @@ func (d differ) expectedStatusCodesMatch(initial, updated []int) bool {
 	return reflect.DeepEqual(initial, updated)
 }
 
-// optionsMatch determines whether the two sets of Options are the same.
+// optionsMatch determines whether the two sets of Options are the same - ignoring the Header/QueryString names
+// used for each Option, since changes to these are detected in changesForOperationOptionNames.
 func (d differ) optionsMatch(initial, updated map[string]models.SDKOperationOption) (*bool, error) {
 	// since we're stringifying the options for output, we can reuse this here
-	initialStringified, err := d.stringifyOperationOptions(initial)
+	initialStringified, err := d.stringifyOperationOptions(d.operationOptionsWithoutNames(initial))
 	if err != nil {
 		return nil, fmt.Errorf("stringifying the Initial Operation Options: %+v", err)
 	}
-	updatedStringified, err := d.stringifyOperationOptions(updated)
+	updatedStringified, err := d.stringifyOperationOptions(d.operationOptionsWithoutNames(updated))
 	if err != nil {
 		return nil, fmt.Errorf("stringifying the Updated Operation Options: %+v", err)
 	}
@@ -506,6 +554,17 @@ func (d differ) optionsMatch(initial, updated map[string]models.SDKOperationOpti
 	return pointer.To(true), nil
 }
 
+// operationOptionsWithoutNames returns a copy of input where the Header/QueryString names for each Option are removed.
+func (d differ) operationOptionsWithoutNames(input map[string]models.SDKOperationOption) map[string]models.SDKOperationOption {
+	output := make(map[string]models.SDKOperationOption, len(input))
+	for key, value := range input {
+		value.HeaderName = nil
+		value.QueryStringName = nil
+		output[key] = value
+	}
+	return output
+}
+
 // stringifyOperationOptions returns a stringified version of the Options object
 // which is used to provide a human-readable output.
 func (d differ) stringifyOperationOptions(input map[string]models.SDKOperationOption) (*map[string]string, error) {
@@ -454,6 +454,102 @@ func TestDiff_OperationOptionsChangedBreakingChange(t *testing.T) {
 	assertContainsBreakingChanges(t, *actual)
 }
 
+func TestDiff_OperationOptionHeaderNameChanged(t *testing.T) {
+	// Changing only the Header Name for an existing Option shouldn't be reported as a change to the Options
+	initial := map[string]models.SDKOperation{
+		"First": {
+			Options: map[string]models.SDKOperationOption{
+				"IfMatch": {
+					HeaderName: pointer.To("If-Match"),
+					Required:   false,
+					ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+						Type: models.StringSDKOperationOptionObjectDefinitionType,
+					},
+				},
+			},
+		},
+	}
+	updated := map[string]models.SDKOperation{
+		"First": {
+			Options: map[string]models.SDKOperationOption{
+				"IfMatch": {
+					HeaderName: pointer.To("x-ms-if-match"),
+					Required:   false,
+					ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+						Type: models.StringSDKOperationOptionObjectDefinitionType,
+					},
+				},
+			},
+		},
+	}
+	ids := make(map[string]models.ResourceID)
+	actual, err := differ{}.changesForOperations("Computer", "2020-01-01", "Example", initial, updated, ids, ids)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.OperationOptionHeaderNameChanged{
+			ServiceName:   "Computer",
+			ApiVersion:    "2020-01-01",
+			ResourceName:  "Example",
+			OperationName: "First",
+			OptionName:    "IfMatch",
+			OldValue:      "If-Match",
+			NewValue:      "x-ms-if-match",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_OperationOptionQueryStringNameChanged(t *testing.T) {
+	// Changing only the QueryString Name for an existing Option shouldn't be reported as a change to the Options
+	initial := map[string]models.SDKOperation{
+		"First": {
+			Options: map[string]models.SDKOperationOption{
+				"Expand": {
+					QueryStringName: pointer.To("expand"),
+					Required:        false,
+					ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+						Type: models.StringSDKOperationOptionObjectDefinitionType,
+					},
+				},
+			},
+		},
+	}
+	updated := map[string]models.SDKOperation{
+		"First": {
+			Options: map[string]models.SDKOperationOption{
+				"Expand": {
+					QueryStringName: pointer.To("$expand"),
+					Required:        false,
+					ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+						Type: models.StringSDKOperationOptionObjectDefinitionType,
+					},
+				},
+			},
+		},
+	}
+	ids := make(map[string]models.ResourceID)
+	actual, err := differ{}.changesForOperations("Computer", "2020-01-01", "Example", initial, updated, ids, ids)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.OperationOptionQueryStringNameChanged{
+			ServiceName:   "Computer",
+			ApiVersion:    "2020-01-01",
+			ResourceName:  "Example",
+			OperationName: "First",
+			OptionName:    "Expand",
+			OldValue:      "expand",
+			NewValue:      "$expand",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
 func TestDiff_OperationOptionsRemoved(t *testing.T) {
 	initial := map[string]models.SDKOperation{
 		"First": {
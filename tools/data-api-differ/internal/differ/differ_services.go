@@ -54,7 +54,7 @@ func (d differ) changesForService(serviceName string, initial, updated map[strin
 		// intentionally not returning here
 	}
 
-	// TODO: support raising if `Generate`, `ResourceProvider` or `TerraformPackageName` changes if required
+	// TODO: support raising if `Generate` or `ResourceProvider` changes if required
 
 	// the old set may not necessarily exist
 	var oldApiVersions map[string]models.APIVersion
@@ -67,6 +67,17 @@ func (d differ) changesForService(serviceName string, initial, updated map[strin
 	}
 	output = append(output, *changesForApiVersions...)
 
+	// the Terraform Definition is optional in both the old and updated set
+	var oldTerraformDefinition *models.TerraformDefinition
+	if inOldData {
+		oldTerraformDefinition = oldData.TerraformDefinition
+	}
+	changesForTerraformDefinition, err := d.changesForTerraformDefinition(serviceName, oldTerraformDefinition, updatedData.TerraformDefinition, includeNestedChangesWhenNew)
+	if err != nil {
+		return nil, fmt.Errorf("detecting changes to the Terraform Definition: %+v", err)
+	}
+	output = append(output, *changesForTerraformDefinition...)
+
 	return &output, nil
 }
 
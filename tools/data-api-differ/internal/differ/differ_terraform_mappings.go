@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+const (
+	terraformFieldMappingType        = "Field"
+	terraformModelToModelMappingType = "ModelToModel"
+	terraformResourceIdMappingType   = "ResourceId"
+)
+
+// changesForTerraformMappings determines the changes between the initial and updated Mappings within the specified Terraform Resource.
+//
+// Since Mappings don't have a unique identifier, each Mapping is normalised to a human-readable string - meaning that
+// a Mapping which has been changed is output as a Mapping being removed and another being added.
+func (d differ) changesForTerraformMappings(serviceName, resourceLabel string, initial, updated models.TerraformMappingDefinition) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+
+	initialMappings, err := d.stringifyTerraformMappings(initial)
+	if err != nil {
+		return nil, fmt.Errorf("stringifying the Initial Mappings: %+v", err)
+	}
+	updatedMappings, err := d.stringifyTerraformMappings(updated)
+	if err != nil {
+		return nil, fmt.Errorf("stringifying the Updated Mappings: %+v", err)
+	}
+
+	for _, mappingType := range []string{terraformFieldMappingType, terraformModelToModelMappingType, terraformResourceIdMappingType} {
+		oldValues := initialMappings[mappingType]
+		newValues := updatedMappings[mappingType]
+		for _, mapping := range d.uniqueKeys(oldValues, newValues) {
+			_, isInOld := oldValues[mapping]
+			_, isInUpdated := newValues[mapping]
+			if isInOld && !isInUpdated {
+				log.Logger.Trace(fmt.Sprintf("%s Mapping %q has been removed", mappingType, mapping))
+				output = append(output, changes.TerraformMappingRemoved{
+					ServiceName:   serviceName,
+					ResourceLabel: resourceLabel,
+					MappingType:   mappingType,
+					Mapping:       mapping,
+				})
+			}
+			if !isInOld && isInUpdated {
+				log.Logger.Trace(fmt.Sprintf("%s Mapping %q is new", mappingType, mapping))
+				output = append(output, changes.TerraformMappingAdded{
+					ServiceName:   serviceName,
+					ResourceLabel: resourceLabel,
+					MappingType:   mappingType,
+					Mapping:       mapping,
+				})
+			}
+		}
+	}
+
+	return &output, nil
+}
+
+// stringifyTerraformMappings returns a human-readable version of each Mapping within input, keyed by the type of Mapping.
+func (d differ) stringifyTerraformMappings(input models.TerraformMappingDefinition) (map[string]map[string]string, error) {
+	output := map[string]map[string]string{
+		terraformFieldMappingType:        {},
+		terraformModelToModelMappingType: {},
+		terraformResourceIdMappingType:   {},
+	}
+
+	for i, item := range input.Fields {
+		var value string
+		switch v := item.(type) {
+		case models.TerraformDirectAssignmentFieldMappingDefinition:
+			value = fmt.Sprintf("%s.%s -> %s.%s (DirectAssignment)", v.DirectAssignment.TerraformSchemaModelName, v.DirectAssignment.TerraformSchemaFieldName, v.DirectAssignment.SDKModelName, v.DirectAssignment.SDKFieldName)
+
+		case models.TerraformModelToModelFieldMappingDefinition:
+			value = fmt.Sprintf("%s -> %s.%s (ModelToModel)", v.ModelToModel.TerraformSchemaModelName, v.ModelToModel.SDKModelName, v.ModelToModel.SDKFieldName)
+
+		default:
+			return nil, fmt.Errorf("internal-error: unimplemented Field Mapping type %T at index %d", item, i)
+		}
+		output[terraformFieldMappingType][value] = value
+	}
+
+	for _, item := range input.ModelToModels {
+		value := fmt.Sprintf("%s -> %s", item.TerraformSchemaModelName, item.SDKModelName)
+		output[terraformModelToModelMappingType][value] = value
+	}
+
+	for _, item := range input.ResourceID {
+		value := fmt.Sprintf("%s -> %s", item.SegmentName, item.TerraformSchemaFieldName)
+		if item.ParsedFromParentID {
+			value += " (Parsed From Parent ID)"
+		}
+		output[terraformResourceIdMappingType][value] = value
+	}
+
+	return output, nil
+}
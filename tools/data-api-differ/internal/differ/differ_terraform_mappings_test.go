@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestDiff_TerraformMappingsNoChanges(t *testing.T) {
+	initial := models.TerraformMappingDefinition{
+		Fields: []models.TerraformFieldMappingDefinition{
+			models.TerraformDirectAssignmentFieldMappingDefinition{
+				DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "ExampleResourceSchema",
+					TerraformSchemaFieldName: "Name",
+					SDKModelName:             "Example",
+					SDKFieldName:             "Name",
+				},
+			},
+		},
+		ResourceID: []models.TerraformResourceIDMappingDefinition{
+			{
+				SegmentName:              "exampleName",
+				TerraformSchemaFieldName: "Name",
+			},
+		},
+	}
+	updated := models.TerraformMappingDefinition{
+		Fields: []models.TerraformFieldMappingDefinition{
+			models.TerraformDirectAssignmentFieldMappingDefinition{
+				DirectAssignment: models.TerraformDirectAssignmentFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "ExampleResourceSchema",
+					TerraformSchemaFieldName: "Name",
+					SDKModelName:             "Example",
+					SDKFieldName:             "Name",
+				},
+			},
+		},
+		ResourceID: []models.TerraformResourceIDMappingDefinition{
+			{
+				SegmentName:              "exampleName",
+				TerraformSchemaFieldName: "Name",
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformMappings("Computer", "example_resource", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := make([]changes.Change, 0)
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformMappingsAdded(t *testing.T) {
+	initial := models.TerraformMappingDefinition{}
+	updated := models.TerraformMappingDefinition{
+		Fields: []models.TerraformFieldMappingDefinition{
+			models.TerraformModelToModelFieldMappingDefinition{
+				ModelToModel: models.TerraformModelToModelFieldMappingDefinitionImpl{
+					TerraformSchemaModelName: "SettingsSchema",
+					SDKModelName:             "ExampleProperties",
+					SDKFieldName:             "Settings",
+				},
+			},
+		},
+		ModelToModels: []models.TerraformModelToModelMappingDefinition{
+			{
+				TerraformSchemaModelName: "ExampleResourceSchema",
+				SDKModelName:             "Example",
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformMappings("Computer", "example_resource", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformMappingAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			MappingType:   "Field",
+			Mapping:       "SettingsSchema -> ExampleProperties.Settings (ModelToModel)",
+		},
+		changes.TerraformMappingAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			MappingType:   "ModelToModel",
+			Mapping:       "ExampleResourceSchema -> Example",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformMappingsChanged(t *testing.T) {
+	initial := models.TerraformMappingDefinition{
+		ResourceID: []models.TerraformResourceIDMappingDefinition{
+			{
+				SegmentName:              "exampleName",
+				TerraformSchemaFieldName: "Name",
+			},
+		},
+	}
+	updated := models.TerraformMappingDefinition{
+		ResourceID: []models.TerraformResourceIDMappingDefinition{
+			{
+				SegmentName:              "exampleName",
+				TerraformSchemaFieldName: "DisplayName",
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformMappings("Computer", "example_resource", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformMappingAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			MappingType:   "ResourceId",
+			Mapping:       "exampleName -> DisplayName",
+		},
+		changes.TerraformMappingRemoved{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			MappingType:   "ResourceId",
+			Mapping:       "exampleName -> Name",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// changesForTerraformDefinition determines the changes between the initial and updated Terraform Definition for the specified Service.
+func (d differ) changesForTerraformDefinition(serviceName string, initial, updated *models.TerraformDefinition, includeNestedChangesWhenNew bool) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+
+	// either may not exist, when the Service doesn't contain any Terraform Resources
+	initialResources := make(map[string]models.TerraformResourceDefinition)
+	updatedResources := make(map[string]models.TerraformResourceDefinition)
+	if initial != nil {
+		initialResources = initial.Resources
+	}
+	if updated != nil {
+		updatedResources = updated.Resources
+	}
+
+	if initial != nil && updated != nil && initial.TerraformPackageName != updated.TerraformPackageName {
+		log.Logger.Trace(fmt.Sprintf("Terraform Package Name changed from %q to %q", initial.TerraformPackageName, updated.TerraformPackageName))
+		output = append(output, changes.ServiceTerraformPackageNameChanged{
+			ServiceName: serviceName,
+			OldValue:    initial.TerraformPackageName,
+			NewValue:    updated.TerraformPackageName,
+		})
+	}
+
+	resourceLabels := d.uniqueTerraformResourceLabels(initialResources, updatedResources)
+	for _, resourceLabel := range resourceLabels {
+		log.Logger.Trace(fmt.Sprintf("Detecting changes in Terraform Resource %q..", resourceLabel))
+		changesForResource, err := d.changesForTerraformResource(serviceName, resourceLabel, initialResources, updatedResources, includeNestedChangesWhenNew)
+		if err != nil {
+			return nil, fmt.Errorf("detecting changes to the Terraform Resource %q: %+v", resourceLabel, err)
+		}
+		output = append(output, *changesForResource...)
+	}
+
+	return &output, nil
+}
+
+// changesForTerraformResource determines the changes between two versions of the specified Terraform Resource.
+func (d differ) changesForTerraformResource(serviceName, resourceLabel string, initial, updated map[string]models.TerraformResourceDefinition, includeNestedChangesWhenNew bool) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+
+	oldData, inOldData := initial[resourceLabel]
+	updatedData, inUpdatedData := updated[resourceLabel]
+	if inOldData && !inUpdatedData {
+		log.Logger.Trace(fmt.Sprintf("Terraform Resource %q in Service %q was removed", resourceLabel, serviceName))
+		output = append(output, changes.TerraformResourceRemoved{
+			ServiceName:   serviceName,
+			ResourceLabel: resourceLabel,
+		})
+		// no point continuing to diff if it's gone
+		return &output, nil
+	}
+	if !inOldData && inUpdatedData {
+		log.Logger.Trace(fmt.Sprintf("Terraform Resource %q in Service %q is new", resourceLabel, serviceName))
+		output = append(output, changes.TerraformResourceAdded{
+			ServiceName:   serviceName,
+			ResourceLabel: resourceLabel,
+		})
+		if !includeNestedChangesWhenNew {
+			return &output, nil
+		}
+		// Otherwise we'll include the full list of nested changes (for example the Schema Models, Mappings
+		// and Tests contained within the [new] Terraform Resource).
+	}
+
+	if inOldData {
+		if oldData.APIVersion != updatedData.APIVersion {
+			output = append(output, changes.TerraformResourceApiVersionChanged{
+				ServiceName:   serviceName,
+				ResourceLabel: resourceLabel,
+				OldValue:      oldData.APIVersion,
+				NewValue:      updatedData.APIVersion,
+			})
+		}
+		if oldData.ResourceIDName != updatedData.ResourceIDName {
+			output = append(output, changes.TerraformResourceResourceIdNameChanged{
+				ServiceName:   serviceName,
+				ResourceLabel: resourceLabel,
+				OldValue:      oldData.ResourceIDName,
+				NewValue:      updatedData.ResourceIDName,
+			})
+		}
+		if oldData.UpdateMethod == nil && updatedData.UpdateMethod != nil {
+			output = append(output, changes.TerraformResourceUpdateMethodAdded{
+				ServiceName:      serviceName,
+				ResourceLabel:    resourceLabel,
+				SDKOperationName: updatedData.UpdateMethod.SDKOperationName,
+			})
+		}
+		if oldData.UpdateMethod != nil && updatedData.UpdateMethod == nil {
+			output = append(output, changes.TerraformResourceUpdateMethodRemoved{
+				ServiceName:      serviceName,
+				ResourceLabel:    resourceLabel,
+				SDKOperationName: oldData.UpdateMethod.SDKOperationName,
+			})
+		}
+	}
+
+	// we then need to diff each of the individual components - however note that the old version may not exist
+	initialSchemaModels := make(map[string]models.TerraformSchemaModel)
+	initialMappings := models.TerraformMappingDefinition{}
+	initialTests := models.TerraformResourceTestsDefinition{}
+	if inOldData {
+		initialSchemaModels = oldData.SchemaModels
+		initialMappings = oldData.Mappings
+		initialTests = oldData.Tests
+	}
+
+	log.Logger.Trace("Detecting changes to the Schema Models..")
+	changesInSchemaModels, err := d.changesForTerraformSchemaModels(serviceName, resourceLabel, initialSchemaModels, updatedData.SchemaModels)
+	if err != nil {
+		return nil, fmt.Errorf("determining the changes to the Schema Models: %+v", err)
+	}
+	output = append(output, *changesInSchemaModels...)
+
+	log.Logger.Trace("Detecting changes to the Mappings..")
+	changesInMappings, err := d.changesForTerraformMappings(serviceName, resourceLabel, initialMappings, updatedData.Mappings)
+	if err != nil {
+		return nil, fmt.Errorf("determining the changes to the Mappings: %+v", err)
+	}
+	output = append(output, *changesInMappings...)
+
+	log.Logger.Trace("Detecting changes to the Tests..")
+	changesInTests := d.changesForTerraformTests(serviceName, resourceLabel, initialTests, updatedData.Tests)
+	output = append(output, changesInTests...)
+
+	return &output, nil
+}
+
+// uniqueTerraformResourceLabels returns a unique, sorted list of Terraform Resource Labels from the keys of initial and updated.
+func (d differ) uniqueTerraformResourceLabels(initial, updated map[string]models.TerraformResourceDefinition) []string {
+	uniqueNames := make(map[string]struct{})
+	for name := range initial {
+		uniqueNames[name] = struct{}{}
+	}
+	for name := range updated {
+		uniqueNames[name] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for k := range uniqueNames {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestDiff_TerraformDefinitionNoChanges(t *testing.T) {
+	initial := &models.TerraformDefinition{
+		Resources: map[string]models.TerraformResourceDefinition{
+			"example_resource": {
+				APIVersion:     "2020-01-01",
+				ResourceIDName: "ExampleId",
+			},
+		},
+		TerraformPackageName: "example",
+	}
+	updated := &models.TerraformDefinition{
+		Resources: map[string]models.TerraformResourceDefinition{
+			"example_resource": {
+				APIVersion:     "2020-01-01",
+				ResourceIDName: "ExampleId",
+			},
+		},
+		TerraformPackageName: "example",
+	}
+	actual, err := differ{}.changesForTerraformDefinition("Computer", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := make([]changes.Change, 0)
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformDefinitionPackageNameChanged(t *testing.T) {
+	initial := &models.TerraformDefinition{
+		TerraformPackageName: "example",
+	}
+	updated := &models.TerraformDefinition{
+		TerraformPackageName: "other",
+	}
+	actual, err := differ{}.changesForTerraformDefinition("Computer", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.ServiceTerraformPackageNameChanged{
+			ServiceName: "Computer",
+			OldValue:    "example",
+			NewValue:    "other",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformDefinitionAdded(t *testing.T) {
+	updated := &models.TerraformDefinition{
+		Resources: map[string]models.TerraformResourceDefinition{
+			"example_resource": {
+				APIVersion: "2020-01-01",
+			},
+		},
+		TerraformPackageName: "example",
+	}
+	actual, err := differ{}.changesForTerraformDefinition("Computer", nil, updated, false)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformDefinitionRemoved(t *testing.T) {
+	initial := &models.TerraformDefinition{
+		Resources: map[string]models.TerraformResourceDefinition{
+			"example_resource": {
+				APIVersion: "2020-01-01",
+			},
+		},
+		TerraformPackageName: "example",
+	}
+	actual, err := differ{}.changesForTerraformDefinition("Computer", initial, nil, false)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceRemoved{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformResourceAdded_WithNestedDetails(t *testing.T) {
+	initial := map[string]models.TerraformResourceDefinition{}
+	updated := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			SchemaModels: map[string]models.TerraformSchemaModel{
+				"ExampleResourceSchema": {},
+			},
+			Tests: models.TerraformResourceTestsDefinition{
+				BasicConfiguration: "resource \"example_resource\" \"test\" {}",
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformResource("Computer", "example_resource", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+		},
+		changes.TerraformSchemaModelAdded{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+		},
+		changes.TerraformTestAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			TestName:      "BasicConfiguration",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformResourceApiVersionChanged(t *testing.T) {
+	initial := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			APIVersion: "2020-01-01",
+		},
+	}
+	updated := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			APIVersion: "2021-01-01",
+		},
+	}
+	actual, err := differ{}.changesForTerraformResource("Computer", "example_resource", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceApiVersionChanged{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			OldValue:      "2020-01-01",
+			NewValue:      "2021-01-01",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformResourceResourceIdNameChanged(t *testing.T) {
+	initial := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			ResourceIDName: "ExampleId",
+		},
+	}
+	updated := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			ResourceIDName: "OtherId",
+		},
+	}
+	actual, err := differ{}.changesForTerraformResource("Computer", "example_resource", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceResourceIdNameChanged{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			OldValue:      "ExampleId",
+			NewValue:      "OtherId",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformResourceUpdateMethodAdded(t *testing.T) {
+	initial := map[string]models.TerraformResourceDefinition{
+		"example_resource": {},
+	}
+	updated := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			UpdateMethod: &models.TerraformMethodDefinition{
+				SDKOperationName: "Update",
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformResource("Computer", "example_resource", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceUpdateMethodAdded{
+			ServiceName:      "Computer",
+			ResourceLabel:    "example_resource",
+			SDKOperationName: "Update",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformResourceUpdateMethodRemoved(t *testing.T) {
+	initial := map[string]models.TerraformResourceDefinition{
+		"example_resource": {
+			UpdateMethod: &models.TerraformMethodDefinition{
+				SDKOperationName: "Update",
+			},
+		},
+	}
+	updated := map[string]models.TerraformResourceDefinition{
+		"example_resource": {},
+	}
+	actual, err := differ{}.changesForTerraformResource("Computer", "example_resource", initial, updated, true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformResourceUpdateMethodRemoved{
+			ServiceName:      "Computer",
+			ResourceLabel:    "example_resource",
+			SDKOperationName: "Update",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
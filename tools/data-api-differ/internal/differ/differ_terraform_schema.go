@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// changesForTerraformSchemaModels determines the changes between the initial and updated Schema Models within the specified Terraform Resource.
+func (d differ) changesForTerraformSchemaModels(serviceName, resourceLabel string, initial, updated map[string]models.TerraformSchemaModel) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+	schemaModelNames := d.uniqueTerraformSchemaModelNames(initial, updated)
+	for _, schemaModelName := range schemaModelNames {
+		log.Logger.Trace(fmt.Sprintf("Detecting changes in Schema Model %q..", schemaModelName))
+		changesForSchemaModel, err := d.changesForTerraformSchemaModel(serviceName, resourceLabel, schemaModelName, initial, updated)
+		if err != nil {
+			return nil, fmt.Errorf("detecting changes in the Schema Model %q: %+v", schemaModelName, err)
+		}
+		output = append(output, *changesForSchemaModel...)
+	}
+	return &output, nil
+}
+
+// changesForTerraformSchemaModel determines the changes between the initial and updated versions of the specified Schema Model.
+func (d differ) changesForTerraformSchemaModel(serviceName, resourceLabel, schemaModelName string, initial, updated map[string]models.TerraformSchemaModel) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+
+	oldData, isInOld := initial[schemaModelName]
+	updatedData, isInUpdated := updated[schemaModelName]
+	if isInOld && !isInUpdated {
+		log.Logger.Trace(fmt.Sprintf("Schema Model %q has been removed", schemaModelName))
+		output = append(output, changes.TerraformSchemaModelRemoved{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+		})
+		return &output, nil
+	}
+	if !isInOld && isInUpdated {
+		log.Logger.Trace(fmt.Sprintf("Schema Model %q is new", schemaModelName))
+		output = append(output, changes.TerraformSchemaModelAdded{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+		})
+		// in the event of a new Schema Model, we can skip the other details
+		return &output, nil
+	}
+
+	fieldNames := d.uniqueTerraformSchemaFieldNames(oldData.Fields, updatedData.Fields)
+	for _, fieldName := range fieldNames {
+		log.Logger.Trace(fmt.Sprintf("Detecting changes in Schema Field %q..", fieldName))
+		changesForField, err := d.changesForTerraformSchemaField(serviceName, resourceLabel, schemaModelName, fieldName, oldData.Fields, updatedData.Fields)
+		if err != nil {
+			return nil, fmt.Errorf("detecting changes in the Schema Field %q: %+v", fieldName, err)
+		}
+		output = append(output, *changesForField...)
+	}
+
+	return &output, nil
+}
+
+// changesForTerraformSchemaField determines the changes between the initial and updated versions of the specified Schema Field.
+func (d differ) changesForTerraformSchemaField(serviceName, resourceLabel, schemaModelName, fieldName string, initial, updated map[string]models.TerraformSchemaField) (*[]changes.Change, error) {
+	output := make([]changes.Change, 0)
+
+	oldData, isInOld := initial[fieldName]
+	updatedData, isInUpdated := updated[fieldName]
+	if isInOld && !isInUpdated {
+		log.Logger.Trace(fmt.Sprintf("Schema Field %q has been removed", fieldName))
+		output = append(output, changes.TerraformSchemaFieldRemoved{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+			HclName:         oldData.HCLName,
+		})
+		return &output, nil
+	}
+	if !isInOld && isInUpdated {
+		log.Logger.Trace(fmt.Sprintf("Schema Field %q is new", fieldName))
+		output = append(output, changes.TerraformSchemaFieldAdded{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+			HclName:         updatedData.HCLName,
+			Required:        updatedData.Required,
+		})
+		// in the event of a new Schema Field, we can skip the other details
+		return &output, nil
+	}
+
+	if oldData.HCLName != updatedData.HCLName {
+		output = append(output, changes.TerraformSchemaFieldHclNameChanged{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+			OldValue:        oldData.HCLName,
+			NewValue:        updatedData.HCLName,
+		})
+	}
+	if !oldData.Optional && updatedData.Optional {
+		output = append(output, changes.TerraformSchemaFieldIsNowOptional{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+		})
+	}
+	if !oldData.Required && updatedData.Required {
+		output = append(output, changes.TerraformSchemaFieldIsNowRequired{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+		})
+	}
+	if !oldData.ForceNew && updatedData.ForceNew {
+		output = append(output, changes.TerraformSchemaFieldForceNewAdded{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+		})
+	}
+	if oldData.ForceNew && !updatedData.ForceNew {
+		output = append(output, changes.TerraformSchemaFieldForceNewRemoved{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+		})
+	}
+	if !oldData.Computed && updatedData.Computed {
+		output = append(output, changes.TerraformSchemaFieldComputedAdded{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+		})
+	}
+	if oldData.Computed && !updatedData.Computed {
+		output = append(output, changes.TerraformSchemaFieldComputedRemoved{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+		})
+	}
+
+	// for the sake of simplicity when reviewing let's normalise this object to a string
+	oldObjectDefinition, err := d.stringifyTerraformSchemaObjectDefinition(oldData.ObjectDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("stringifying the Old Object Definition: %+v", err)
+	}
+	newObjectDefinition, err := d.stringifyTerraformSchemaObjectDefinition(updatedData.ObjectDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("stringifying the Updated Object Definition: %+v", err)
+	}
+	if *oldObjectDefinition != *newObjectDefinition {
+		output = append(output, changes.TerraformSchemaFieldObjectDefinitionChanged{
+			ServiceName:     serviceName,
+			ResourceLabel:   resourceLabel,
+			SchemaModelName: schemaModelName,
+			FieldName:       fieldName,
+			OldValue:        *oldObjectDefinition,
+			NewValue:        *newObjectDefinition,
+		})
+	}
+
+	return &output, nil
+}
+
+// uniqueTerraformSchemaModelNames returns a unique, sorted list of Schema Model Names from the keys of initial and updated.
+func (d differ) uniqueTerraformSchemaModelNames(initial, updated map[string]models.TerraformSchemaModel) []string {
+	uniqueNames := make(map[string]struct{})
+	for name := range initial {
+		uniqueNames[name] = struct{}{}
+	}
+	for name := range updated {
+		uniqueNames[name] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for k := range uniqueNames {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
+
+// uniqueTerraformSchemaFieldNames returns a unique, sorted list of Schema Field Names from the keys of initial and updated.
+func (d differ) uniqueTerraformSchemaFieldNames(initial, updated map[string]models.TerraformSchemaField) []string {
+	uniqueNames := make(map[string]struct{})
+	for name := range initial {
+		uniqueNames[name] = struct{}{}
+	}
+	for name := range updated {
+		uniqueNames[name] = struct{}{}
+	}
+
+	output := make([]string, 0)
+	for k := range uniqueNames {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,425 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestDiff_TerraformSchemaModelNoChanges(t *testing.T) {
+	initial := map[string]models.TerraformSchemaModel{
+		"ExampleResourceSchema": {
+			Fields: map[string]models.TerraformSchemaField{
+				"Name": {
+					HCLName:  "name",
+					Required: true,
+					ForceNew: true,
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+				},
+			},
+		},
+	}
+	updated := map[string]models.TerraformSchemaModel{
+		"ExampleResourceSchema": {
+			Fields: map[string]models.TerraformSchemaField{
+				"Name": {
+					HCLName:  "name",
+					Required: true,
+					ForceNew: true,
+					ObjectDefinition: models.TerraformSchemaObjectDefinition{
+						Type: models.StringTerraformSchemaObjectDefinitionType,
+					},
+				},
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaModels("Computer", "example_resource", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := make([]changes.Change, 0)
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaModelAdded(t *testing.T) {
+	initial := map[string]models.TerraformSchemaModel{}
+	updated := map[string]models.TerraformSchemaModel{
+		"ExampleResourceSchema": {
+			Fields: map[string]models.TerraformSchemaField{
+				"Name": {
+					HCLName:  "name",
+					Required: true,
+				},
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaModels("Computer", "example_resource", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaModelAdded{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaModelRemoved(t *testing.T) {
+	initial := map[string]models.TerraformSchemaModel{
+		"ExampleResourceSchema": {},
+	}
+	updated := map[string]models.TerraformSchemaModel{}
+	actual, err := differ{}.changesForTerraformSchemaModels("Computer", "example_resource", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaModelRemoved{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldAdded_Optional(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldAdded{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+			HclName:         "description",
+			Required:        false,
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldAdded_Required(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Required: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldAdded{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+			HclName:         "description",
+			Required:        true,
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldRemoved(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldRemoved{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+			HclName:         "description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldHclNameChanged(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "display_description",
+			Optional: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldHclNameChanged{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+			OldValue:        "description",
+			NewValue:        "display_description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldIsNowRequired(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Required: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldIsNowRequired{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldIsNowOptional(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Required: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldIsNowOptional{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldForceNewAdded(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldForceNewAdded{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldForceNewRemoved(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+			ForceNew: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldForceNewRemoved{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldComputedAdded(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+			Computed: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldComputedAdded{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsNoBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldComputedRemoved(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+			Computed: true,
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Description": {
+			HCLName:  "description",
+			Optional: true,
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Description", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldComputedRemoved{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Description",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
+
+func TestDiff_TerraformSchemaFieldObjectDefinitionChanged(t *testing.T) {
+	initial := map[string]models.TerraformSchemaField{
+		"Settings": {
+			HCLName:  "settings",
+			Optional: true,
+			ObjectDefinition: models.TerraformSchemaObjectDefinition{
+				Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+				ReferenceName: pointer.To("SettingsSchema"),
+			},
+		},
+	}
+	updated := map[string]models.TerraformSchemaField{
+		"Settings": {
+			HCLName:  "settings",
+			Optional: true,
+			ObjectDefinition: models.TerraformSchemaObjectDefinition{
+				Type: models.ListTerraformSchemaObjectDefinitionType,
+				NestedObject: &models.TerraformSchemaObjectDefinition{
+					Type:          models.ReferenceTerraformSchemaObjectDefinitionType,
+					ReferenceName: pointer.To("SettingsSchema"),
+				},
+			},
+		},
+	}
+	actual, err := differ{}.changesForTerraformSchemaField("Computer", "example_resource", "ExampleResourceSchema", "Settings", initial, updated)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.TerraformSchemaFieldObjectDefinitionChanged{
+			ServiceName:     "Computer",
+			ResourceLabel:   "example_resource",
+			SchemaModelName: "ExampleResourceSchema",
+			FieldName:       "Settings",
+			OldValue:        "Reference[SettingsSchema]",
+			NewValue:        "List[Reference[SettingsSchema]]",
+		},
+	}
+	assertChanges(t, expected, *actual)
+	assertContainsBreakingChanges(t, *actual)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// changesForTerraformTests determines the changes between the initial and updated Tests within the specified Terraform Resource.
+func (d differ) changesForTerraformTests(serviceName, resourceLabel string, initial, updated models.TerraformResourceTestsDefinition) []changes.Change {
+	output := make([]changes.Change, 0)
+
+	initialTests := d.terraformTestsByName(initial)
+	updatedTests := d.terraformTestsByName(updated)
+	for _, testName := range d.uniqueKeys(initialTests, updatedTests) {
+		oldValue, isInOld := initialTests[testName]
+		newValue, isInUpdated := updatedTests[testName]
+		if isInOld && !isInUpdated {
+			log.Logger.Trace(fmt.Sprintf("Test %q has been removed", testName))
+			output = append(output, changes.TerraformTestRemoved{
+				ServiceName:   serviceName,
+				ResourceLabel: resourceLabel,
+				TestName:      testName,
+			})
+			continue
+		}
+		if !isInOld && isInUpdated {
+			log.Logger.Trace(fmt.Sprintf("Test %q is new", testName))
+			output = append(output, changes.TerraformTestAdded{
+				ServiceName:   serviceName,
+				ResourceLabel: resourceLabel,
+				TestName:      testName,
+			})
+			continue
+		}
+		if oldValue != newValue {
+			log.Logger.Trace(fmt.Sprintf("Test %q has changed", testName))
+			output = append(output, changes.TerraformTestChanged{
+				ServiceName:   serviceName,
+				ResourceLabel: resourceLabel,
+				TestName:      testName,
+			})
+		}
+	}
+
+	return output
+}
+
+// terraformTestsByName returns a map of the Test Name to the Terraform Configuration for each Test within input.
+func (d differ) terraformTestsByName(input models.TerraformResourceTestsDefinition) map[string]string {
+	output := make(map[string]string)
+	if input.BasicConfiguration != "" {
+		output["BasicConfiguration"] = input.BasicConfiguration
+	}
+	if input.RequiresImportConfiguration != "" {
+		output["RequiresImportConfiguration"] = input.RequiresImportConfiguration
+	}
+	if input.CompleteConfiguration != nil {
+		output["CompleteConfiguration"] = *input.CompleteConfiguration
+	}
+	if input.TemplateConfiguration != nil {
+		output["TemplateConfiguration"] = *input.TemplateConfiguration
+	}
+	if input.OtherTests != nil {
+		for name, steps := range *input.OtherTests {
+			for i, step := range steps {
+				key := fmt.Sprintf("OtherTests/%s", name)
+				if len(steps) > 1 {
+					key = fmt.Sprintf("OtherTests/%s/Step%d", name, i+1)
+				}
+				output[key] = step
+			}
+		}
+	}
+	return output
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestDiff_TerraformTestsNoChanges(t *testing.T) {
+	initial := models.TerraformResourceTestsDefinition{
+		BasicConfiguration:          "basic",
+		RequiresImportConfiguration: "requires-import",
+	}
+	updated := models.TerraformResourceTestsDefinition{
+		BasicConfiguration:          "basic",
+		RequiresImportConfiguration: "requires-import",
+	}
+	actual := differ{}.changesForTerraformTests("Computer", "example_resource", initial, updated)
+	expected := make([]changes.Change, 0)
+	assertChanges(t, expected, actual)
+	assertContainsNoBreakingChanges(t, actual)
+}
+
+func TestDiff_TerraformTestsChanged(t *testing.T) {
+	initial := models.TerraformResourceTestsDefinition{
+		BasicConfiguration:    "basic",
+		CompleteConfiguration: pointer.To("complete"),
+	}
+	updated := models.TerraformResourceTestsDefinition{
+		BasicConfiguration: "updated",
+		OtherTests: &map[string][]models.TerraformTestDefinition{
+			"update": {
+				"first",
+				"second",
+			},
+		},
+	}
+	actual := differ{}.changesForTerraformTests("Computer", "example_resource", initial, updated)
+	expected := []changes.Change{
+		changes.TerraformTestChanged{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			TestName:      "BasicConfiguration",
+		},
+		changes.TerraformTestRemoved{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			TestName:      "CompleteConfiguration",
+		},
+		changes.TerraformTestAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			TestName:      "OtherTests/update/Step1",
+		},
+		changes.TerraformTestAdded{
+			ServiceName:   "Computer",
+			ResourceLabel: "example_resource",
+			TestName:      "OtherTests/update/Step2",
+		},
+	}
+	assertChanges(t, expected, actual)
+	assertContainsNoBreakingChanges(t, actual)
+}
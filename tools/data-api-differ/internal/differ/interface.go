@@ -7,24 +7,26 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/dataapi"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
 )
 
-// Diff returns information about the changes between `initialPath` and `updatedPath`.
-func Diff(ctx context.Context, dataApiBinaryPath, initialPath, updatedPath string, sourceDataType models.SourceDataType, includeNestedChangesWhenNew bool) (*Result, error) {
-	log.Logger.Trace(fmt.Sprintf("Parsing the Initial Data Set from %q..", initialPath))
-	initialData, err := dataapi.ParseDataFromPath(ctx, dataApiBinaryPath, initialPath, sourceDataType)
+// Diff returns information about the changes between `initial` and `updated`, where dataApiOptions specifies how
+// the API Definitions are loaded.
+func Diff(ctx context.Context, dataApiOptions dataapi.Options, initial, updated datasource.DataSource, sourceDataType models.SourceDataType, includeNestedChangesWhenNew bool) (*Result, error) {
+	log.Logger.Trace(fmt.Sprintf("Parsing the Initial Data Set from %q..", initial.String()))
+	initialData, err := dataapi.ParseDataFromDataSource(ctx, dataApiOptions, initial, sourceDataType)
 	if err != nil {
-		return nil, fmt.Errorf("parsing data from %q: %+v", initialPath, err)
+		return nil, fmt.Errorf("parsing data from %q: %+v", initial.String(), err)
 	}
 
-	log.Logger.Trace(fmt.Sprintf("Parsing the Updated Data Set from %q..", updatedPath))
-	updatedData, err := dataapi.ParseDataFromPath(ctx, dataApiBinaryPath, updatedPath, sourceDataType)
+	log.Logger.Trace(fmt.Sprintf("Parsing the Updated Data Set from %q..", updated.String()))
+	updatedData, err := dataapi.ParseDataFromDataSource(ctx, dataApiOptions, updated, sourceDataType)
 	if err != nil {
-		return nil, fmt.Errorf("parsing data from %q: %+v", updatedPath, err)
+		return nil, fmt.Errorf("parsing data from %q: %+v", updated.String(), err)
 	}
 
 	log.Logger.Trace("Performing the diff..")
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package differ
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/dataapi"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// This test covers the entire `diff` code path end-to-end, loading the API Definitions from disk in-process.
+
+func TestDiff_InProcess(t *testing.T) {
+	apiDefinitions := map[string]string{
+		"resource-manager/metadata.json":                                `{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`,
+		"resource-manager/Example/ServiceDefinition.json":               `{"name": "Example", "resourceProvider": "Microsoft.Example", "generate": true}`,
+		"resource-manager/Example/2020-01-01/ApiVersionDefinition.json": `{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`,
+		"resource-manager/Example/2020-01-01/Things/Model-Thing.json":   `{"name": "Thing", "fields": [{"name": "Name", "jsonName": "name", "objectDefinition": {"type": "String"}, "optional": true}]}`,
+	}
+	initial := writeApiDefinitions(t, apiDefinitions)
+
+	apiDefinitions["resource-manager/Example/2020-01-01/Things/Model-Thing.json"] = `{"name": "Thing", "fields": [{"name": "Name", "jsonName": "name", "objectDefinition": {"type": "String"}, "required": true}]}`
+	updated := writeApiDefinitions(t, apiDefinitions)
+
+	options := dataapi.Options{
+		Mode: dataapi.InProcessMode,
+	}
+	actual, err := Diff(context.TODO(), options, initial, updated, models.ResourceManagerSourceDataType, false)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := []changes.Change{
+		changes.FieldIsNowRequired{
+			ServiceName:  "Example",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "Things",
+			ModelName:    "Thing",
+			FieldName:    "Name",
+		},
+	}
+	assertChanges(t, expected, actual.Changes)
+	assertContainsBreakingChanges(t, actual.Changes)
+}
+
+// writeApiDefinitions writes the API Definitions (a map of file path to file contents) into a temporary directory
+// and returns a Data Source for that directory.
+func writeApiDefinitions(t *testing.T, input map[string]string) datasource.DataSource {
+	directory := t.TempDir()
+	for fileName, contents := range input {
+		filePath := filepath.Join(directory, fileName)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatalf("creating the directory for %q: %+v", filePath, err)
+		}
+		if err := os.WriteFile(filePath, []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", filePath, err)
+		}
+	}
+
+	return datasource.DataSource{
+		Type: datasource.DirectoryType,
+		Path: directory,
+	}
+}
@@ -3,11 +3,19 @@
 
 package differ
 
-import "github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+import (
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+)
 
 type Result struct {
 	// Changes is a slice of the Changes present between the two paths
 	Changes []changes.Change
+
+	// Initial and Updated contain the API Definitions which were compared, which allows the Changes to be
+	// projected onto the generated output (e.g. the Terraform Resources using a changed API Resource).
+	Initial v1.LoadAllDataResult
+	Updated v1.LoadAllDataResult
 }
 
 func (r Result) ContainsBreakingChanges() bool {
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppressions
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+)
+
+// Result is the result of applying the Suppressions File to a set of Changes.
+type Result struct {
+	// Changes is a list of the Changes which weren't matched by a Suppression.
+	Changes []changes.Change
+
+	// Acknowledged is a list of the Changes which were matched by a Suppression.
+	Acknowledged []AcknowledgedChange
+
+	// Expired is a list of the Suppressions which have expired, and as such haven't been applied.
+	Expired []Suppression
+
+	// Unmatched is a list of the (unexpired) Suppressions which didn't match any Changes.
+	Unmatched []Suppression
+}
+
+// AcknowledgedChange is a Change which has been matched by a Suppression.
+type AcknowledgedChange struct {
+	// Change is the Change which was matched.
+	Change changes.Change
+
+	// Suppression is the Suppression which matched this Change.
+	Suppression Suppression
+}
+
+// Apply determines which of the Changes within input are matched by the Suppressions within config, where now
+// is used to determine whether a Suppression has expired.
+func Apply(input []changes.Change, config Config, now time.Time) (*Result, error) {
+	output := Result{
+		Changes:      make([]changes.Change, 0),
+		Acknowledged: make([]AcknowledgedChange, 0),
+		Expired:      make([]Suppression, 0),
+		Unmatched:    make([]Suppression, 0),
+	}
+
+	active := make([]Suppression, 0)
+	for i, item := range config.Suppressions {
+		expiryDate, err := item.expiryDate()
+		if err != nil {
+			return nil, fmt.Errorf("determining the expiry date for Suppression %d (%s): %+v", i, item.String(), err)
+		}
+
+		// a Suppression applies until the end of the day it expires on
+		if expiryDate != nil && !now.UTC().Before(expiryDate.AddDate(0, 0, 1)) {
+			log.Logger.Trace(fmt.Sprintf("Suppression %q expired on %s", item.String(), *item.Expires))
+			output.Expired = append(output.Expired, item)
+			continue
+		}
+		active = append(active, item)
+	}
+
+	matched := make([]bool, len(active))
+	for _, change := range input {
+		suppressed := false
+		for i, item := range active {
+			if !item.matches(change) {
+				continue
+			}
+
+			// every Suppression matching this Change is marked as matched (so that overlapping Suppressions
+			// aren't reported as unmatched), however the Change is acknowledged by the first of these
+			log.Logger.Trace(fmt.Sprintf("Change %q was matched by Suppression %q", reflect.TypeOf(change).Name(), item.String()))
+			matched[i] = true
+			if !suppressed {
+				output.Acknowledged = append(output.Acknowledged, AcknowledgedChange{
+					Change:      change,
+					Suppression: item,
+				})
+				suppressed = true
+			}
+		}
+
+		if !suppressed {
+			output.Changes = append(output.Changes, change)
+		}
+	}
+
+	for i, item := range active {
+		if !matched[i] {
+			output.Unmatched = append(output.Unmatched, item)
+		}
+	}
+
+	return &output, nil
+}
+
+// matches returns whether this Suppression matches the specified Change.
+func (s Suppression) matches(change changes.Change) bool {
+	if reflect.TypeOf(change).Name() != s.Kind {
+		return false
+	}
+	identifiers := change.Identifiers()
+	if identifiers.ServiceName != s.Service {
+		return false
+	}
+	if s.ApiVersion != nil && identifiers.ApiVersion != *s.ApiVersion {
+		return false
+	}
+	if s.Resource != nil {
+		// Changes to a Terraform Resource are identified by the Resource Label, rather than the name of the API Resource
+		resourceName := identifiers.ResourceName
+		if resourceName == "" {
+			resourceName = identifiers.ResourceLabel
+		}
+		if resourceName != *s.Resource {
+			return false
+		}
+	}
+	if s.Name != nil && itemName(change) != *s.Name {
+		return false
+	}
+	return true
+}
+
+// itemName returns the name of the item which has been changed, which is matched against the `name` within a Suppression.
+//
+// This is the name of the Constant, Model, Operation, Resource ID, Schema Model or Test being changed - or for a Field
+// the name of the Model containing the Field and the name of the Field (e.g. `VirtualMachine.Name`), and for an Option
+// the name of the Operation containing the Option and the name of the Option (e.g. `List.Filter`).
+func itemName(change changes.Change) string {
+	identifiers := change.Identifiers()
+	if identifiers.FieldName != "" {
+		modelName := identifiers.ModelName
+		if modelName == "" {
+			modelName = identifiers.SchemaModelName
+		}
+		return fmt.Sprintf("%s.%s", modelName, identifiers.FieldName)
+	}
+	if identifiers.OptionName != "" {
+		return fmt.Sprintf("%s.%s", identifiers.OperationName, identifiers.OptionName)
+	}
+
+	for _, value := range []string{identifiers.ConstantName, identifiers.ModelName, identifiers.OperationName, identifiers.ResourceIdName, identifiers.SchemaModelName, identifiers.TestName, identifiers.Mapping} {
+		if value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppressions
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+)
+
+func init() {
+	log.Logger = hclog.Default()
+}
+
+func TestApply(t *testing.T) {
+	input := []changes.Change{
+		changes.FieldIsNowRequired{
+			ServiceName:  "Compute",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachine",
+			FieldName:    "Name",
+		},
+		changes.FieldIsNowRequired{
+			ServiceName:  "Compute",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachine",
+			FieldName:    "Zone",
+		},
+		changes.TerraformSchemaFieldRemoved{
+			ServiceName:     "Compute",
+			ResourceLabel:   "virtual_machine",
+			SchemaModelName: "VirtualMachineResourceSchema",
+			FieldName:       "Zone",
+			HclName:         "zone",
+		},
+	}
+	config := Config{
+		Suppressions: []Suppression{
+			{
+				Kind:       "FieldIsNowRequired",
+				Service:    "Compute",
+				ApiVersion: pointer.To("2020-01-01"),
+				Name:       pointer.To("VirtualMachine.Name"),
+				Reason:     "The API always required this field",
+				Expires:    pointer.To("2024-06-30"),
+			},
+			{
+				Kind:     "TerraformSchemaFieldRemoved",
+				Service:  "Compute",
+				Resource: pointer.To("virtual_machine"),
+				Reason:   "The field was never supported by the API",
+			},
+			{
+				Kind:    "FieldIsNowRequired",
+				Service: "Compute",
+				Name:    pointer.To("VirtualMachine.Zone"),
+				Reason:  "This was only acknowledged temporarily",
+				Expires: pointer.To("2024-01-01"),
+			},
+			{
+				Kind:    "ServiceRemoved",
+				Service: "Legacy",
+				Reason:  "This Service has been retired",
+			},
+		},
+	}
+
+	// the first Suppression applies until the end of the day it expires on
+	now := time.Date(2024, 6, 30, 23, 59, 0, 0, time.UTC)
+	actual, err := Apply(input, config, now)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	if len(actual.Changes) != 1 || !reflect.DeepEqual(actual.Changes[0], input[1]) {
+		t.Fatalf("expected only the second Change to remain but got %+v", actual.Changes)
+	}
+	if len(actual.Acknowledged) != 2 {
+		t.Fatalf("expected 2 Acknowledged Changes but got %d", len(actual.Acknowledged))
+	}
+	if !reflect.DeepEqual(actual.Acknowledged[0].Change, input[0]) || actual.Acknowledged[0].Suppression.Reason != "The API always required this field" {
+		t.Fatalf("expected the first Change to be acknowledged by the first Suppression but got %+v", actual.Acknowledged[0])
+	}
+	if !reflect.DeepEqual(actual.Acknowledged[1].Change, input[2]) || actual.Acknowledged[1].Suppression.Kind != "TerraformSchemaFieldRemoved" {
+		t.Fatalf("expected the third Change to be acknowledged by the second Suppression but got %+v", actual.Acknowledged[1])
+	}
+	if len(actual.Expired) != 1 || actual.Expired[0].Reason != "This was only acknowledged temporarily" {
+		t.Fatalf("expected the third Suppression to have expired but got %+v", actual.Expired)
+	}
+	if len(actual.Unmatched) != 1 || actual.Unmatched[0].Kind != "ServiceRemoved" {
+		t.Fatalf("expected the fourth Suppression to be unmatched but got %+v", actual.Unmatched)
+	}
+}
+
+func TestApply_ExpiresAtTheEndOfTheDay(t *testing.T) {
+	input := []changes.Change{
+		changes.ServiceRemoved{
+			ServiceName: "Legacy",
+		},
+	}
+	config := Config{
+		Suppressions: []Suppression{
+			{
+				Kind:    "ServiceRemoved",
+				Service: "Legacy",
+				Reason:  "This Service has been retired",
+				Expires: pointer.To("2024-06-30"),
+			},
+		},
+	}
+
+	actual, err := Apply(input, config, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(actual.Changes) != 1 || len(actual.Acknowledged) != 0 || len(actual.Expired) != 1 || len(actual.Unmatched) != 0 {
+		t.Fatalf("expected the Suppression to have expired but got %+v", *actual)
+	}
+}
+
+func TestApply_OverlappingSuppressions(t *testing.T) {
+	input := []changes.Change{
+		changes.FieldIsNowRequired{
+			ServiceName:  "Compute",
+			ApiVersion:   "2020-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachine",
+			FieldName:    "Name",
+		},
+	}
+	config := Config{
+		Suppressions: []Suppression{
+			{
+				Kind:    "FieldIsNowRequired",
+				Service: "Compute",
+				Name:    pointer.To("VirtualMachine.Name"),
+				Reason:  "The API always required this field",
+			},
+			{
+				Kind:     "FieldIsNowRequired",
+				Service:  "Compute",
+				Resource: pointer.To("VirtualMachines"),
+				Reason:   "Every field within this Resource is required",
+			},
+		},
+	}
+
+	actual, err := Apply(input, config, time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(actual.Changes) != 0 {
+		t.Fatalf("expected no Changes to remain but got %+v", actual.Changes)
+	}
+	if len(actual.Acknowledged) != 1 || actual.Acknowledged[0].Suppression.Reason != "The API always required this field" {
+		t.Fatalf("expected the Change to be acknowledged by the first Suppression but got %+v", actual.Acknowledged)
+	}
+	if len(actual.Unmatched) != 0 {
+		t.Fatalf("expected both Suppressions to be matched but got %+v", actual.Unmatched)
+	}
+}
+
+func TestApply_OptionWithinAnOperation(t *testing.T) {
+	input := []changes.Change{
+		changes.OperationOptionQueryStringNameChanged{
+			ServiceName:   "Compute",
+			ApiVersion:    "2020-01-01",
+			ResourceName:  "VirtualMachines",
+			OperationName: "List",
+			OptionName:    "Filter",
+			OldValue:      "$filter",
+			NewValue:      "filter",
+		},
+		changes.OperationOptionQueryStringNameChanged{
+			ServiceName:   "Compute",
+			ApiVersion:    "2020-01-01",
+			ResourceName:  "VirtualMachines",
+			OperationName: "List",
+			OptionName:    "Top",
+			OldValue:      "$top",
+			NewValue:      "top",
+		},
+	}
+	config := Config{
+		Suppressions: []Suppression{
+			{
+				Kind:    "OperationOptionQueryStringNameChanged",
+				Service: "Compute",
+				Name:    pointer.To("List.Filter"),
+				Reason:  "The API accepts both names",
+			},
+		},
+	}
+
+	actual, err := Apply(input, config, time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(actual.Acknowledged) != 1 || !reflect.DeepEqual(actual.Acknowledged[0].Change, input[0]) {
+		t.Fatalf("expected only the Change to the `Filter` Option to be acknowledged but got %+v", actual.Acknowledged)
+	}
+	if len(actual.Changes) != 1 || !reflect.DeepEqual(actual.Changes[0], input[1]) {
+		t.Fatalf("expected the Change to the `Top` Option to remain but got %+v", actual.Changes)
+	}
+}
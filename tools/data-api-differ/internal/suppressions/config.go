@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppressions
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/hcl/v2/hclsimple"
+)
+
+// expiryDateFormat is the format used for the `expires` attribute within a Suppression (e.g. `2024-06-30`).
+const expiryDateFormat = "2006-01-02"
+
+// Config defines the Suppressions File, which is used to acknowledge Changes which are intentional.
+type Config struct {
+	// Suppressions is a list of the Suppressions defined within this file.
+	Suppressions []Suppression `hcl:"suppression,block"`
+}
+
+// Suppression defines a single entry in the Suppressions File, which matches one or more Changes.
+type Suppression struct {
+	// Kind specifies the type of Change which should be matched (e.g. `FieldIsNowRequired`).
+	Kind string `hcl:"kind,label" json:"kind"`
+
+	// Service specifies the name of the Service containing the Change (e.g. `Compute`) - or `Common Types`
+	// for a Change to the Common Types.
+	Service string `hcl:"service" json:"service"`
+
+	// ApiVersion optionally specifies the API Version containing the Change (e.g. `2023-01-01`).
+	// When unspecified Changes within any API Version are matched.
+	ApiVersion *string `hcl:"api_version,optional" json:"apiVersion,omitempty"`
+
+	// Resource optionally specifies the name of the API Resource (or the label of the Terraform Resource)
+	// containing the Change. When unspecified Changes within any Resource are matched.
+	Resource *string `hcl:"resource,optional" json:"resource,omitempty"`
+
+	// Name optionally specifies the name of the item which has been changed (e.g. `VirtualMachine` for a
+	// Model, `VirtualMachine.Name` for a Field within that Model, or `List.Filter` for an Option within an
+	// Operation). When unspecified any item is matched.
+	Name *string `hcl:"name,optional" json:"name,omitempty"`
+
+	// Reason specifies why this Change is intentional.
+	Reason string `hcl:"reason" json:"reason"`
+
+	// Expires optionally specifies the date (in the format `YYYY-MM-DD`) after which this Suppression no
+	// longer applies, meaning that the matching Changes are reported again.
+	Expires *string `hcl:"expires,optional" json:"expires,omitempty"`
+}
+
+// LoadFromFile loads and validates the Suppressions File at the specified filePath.
+func LoadFromFile(filePath string) (*Config, error) {
+	var config Config
+	if err := hclsimple.DecodeFile(filePath, nil, &config); err != nil {
+		return nil, fmt.Errorf("parsing: %+v", err)
+	}
+
+	for i, item := range config.Suppressions {
+		if err := item.validate(); err != nil {
+			return nil, fmt.Errorf("validating the Suppression %d (%s): %+v", i, item.String(), err)
+		}
+	}
+
+	return &config, nil
+}
+
+// String returns a human-readable description of the Changes matched by this Suppression.
+func (s Suppression) String() string {
+	components := []string{
+		s.Kind,
+		fmt.Sprintf("service %q", s.Service),
+	}
+	if s.ApiVersion != nil {
+		components = append(components, fmt.Sprintf("api_version %q", *s.ApiVersion))
+	}
+	if s.Resource != nil {
+		components = append(components, fmt.Sprintf("resource %q", *s.Resource))
+	}
+	if s.Name != nil {
+		components = append(components, fmt.Sprintf("name %q", *s.Name))
+	}
+	return strings.Join(components, " / ")
+}
+
+// expiryDate returns the date after which this Suppression no longer applies, if specified.
+func (s Suppression) expiryDate() (*time.Time, error) {
+	if s.Expires == nil {
+		return nil, nil
+	}
+
+	value, err := time.Parse(expiryDateFormat, *s.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("parsing the `expires` date %q (expected the format `YYYY-MM-DD`): %+v", *s.Expires, err)
+	}
+	return &value, nil
+}
+
+func (s Suppression) validate() error {
+	if strings.TrimSpace(s.Kind) == "" {
+		return fmt.Errorf("the `kind` label must be specified")
+	}
+	if strings.TrimSpace(s.Service) == "" {
+		return fmt.Errorf("`service` must be specified")
+	}
+	if strings.TrimSpace(s.Reason) == "" {
+		return fmt.Errorf("a `reason` must be specified")
+	}
+	if _, err := s.expiryDate(); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package suppressions
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadFromFile(t *testing.T) {
+	filePath := writeSuppressionsFile(t, `
+suppression "FieldIsNowRequired" {
+  service     = "Compute"
+  api_version = "2020-01-01"
+  resource    = "VirtualMachines"
+  name        = "VirtualMachine.Name"
+  reason      = "The API always required this field, the Swagger was incorrect"
+  expires     = "2030-01-01"
+}
+
+suppression "ServiceRemoved" {
+  service = "Legacy"
+  reason  = "This Service has been retired"
+}
+`)
+	actual, err := LoadFromFile(filePath)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(actual.Suppressions) != 2 {
+		t.Fatalf("expected 2 Suppressions but got %d", len(actual.Suppressions))
+	}
+
+	first := actual.Suppressions[0]
+	if first.Kind != "FieldIsNowRequired" || first.Service != "Compute" {
+		t.Fatalf("expected the first Suppression to be `FieldIsNowRequired` for `Compute` but got %q for %q", first.Kind, first.Service)
+	}
+	if first.Name == nil || *first.Name != "VirtualMachine.Name" {
+		t.Fatalf("expected the first Suppression to have the name `VirtualMachine.Name` but got %+v", first.Name)
+	}
+	if first.Expires == nil || *first.Expires != "2030-01-01" {
+		t.Fatalf("expected the first Suppression to expire on `2030-01-01` but got %+v", first.Expires)
+	}
+
+	second := actual.Suppressions[1]
+	if second.ApiVersion != nil || second.Resource != nil || second.Name != nil || second.Expires != nil {
+		t.Fatalf("expected the optional fields within the second Suppression to be nil but got %+v", second)
+	}
+}
+
+func TestLoadFromFile_MissingReason(t *testing.T) {
+	filePath := writeSuppressionsFile(t, `
+suppression "ServiceRemoved" {
+  service = "Legacy"
+  reason  = ""
+}
+`)
+	_, err := LoadFromFile(filePath)
+	if err == nil || !strings.Contains(err.Error(), "reason") {
+		t.Fatalf("expected an error about the missing `reason` but got %+v", err)
+	}
+}
+
+func TestLoadFromFile_InvalidExpiryDate(t *testing.T) {
+	filePath := writeSuppressionsFile(t, `
+suppression "ServiceRemoved" {
+  service = "Legacy"
+  reason  = "This Service has been retired"
+  expires = "01/02/2030"
+}
+`)
+	_, err := LoadFromFile(filePath)
+	if err == nil || !strings.Contains(err.Error(), "YYYY-MM-DD") {
+		t.Fatalf("expected an error about the `expires` date but got %+v", err)
+	}
+}
+
+func writeSuppressionsFile(t *testing.T, contents string) string {
+	filePath := filepath.Join(t.TempDir(), "suppressions.hcl")
+	if err := os.WriteFile(filePath, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+	return filePath
+}
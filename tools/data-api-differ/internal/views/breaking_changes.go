@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/suppressions"
 )
 
 var _ View = BreakingChangeView{}
@@ -17,6 +18,9 @@ var _ View = BreakingChangeView{}
 type BreakingChangeView struct {
 	// breakingChanges is a slice of the breaking changes that should be rendered
 	breakingChanges []changes.Change
+
+	// suppressions optionally contains the result of applying the Suppressions File
+	suppressions *suppressions.Result
 }
 
 func NewBreakingChangesView(input []changes.Change) BreakingChangeView {
@@ -33,17 +37,43 @@ func NewBreakingChangesView(input []changes.Change) BreakingChangeView {
 	}
 }
 
+// WithSuppressions returns a copy of this View which also renders the Breaking Changes which were acknowledged in
+// the Suppressions File, and any Suppressions which need to be reviewed.
+func (v BreakingChangeView) WithSuppressions(input suppressions.Result) BreakingChangeView {
+	v.suppressions = &input
+	return v
+}
+
 // RenderMarkdown renders the Breaking Changes View using Markdown, intended for both display
 // in a Terminal and to be output as a GitHub Comment.
 func (v BreakingChangeView) RenderMarkdown() (*string, error) {
+	sections := make([]string, 0)
 	if len(v.breakingChanges) == 0 {
-		return trimSpaceAround(`
+		sections = append(sections, `
 ## Breaking Changes
 
 No Breaking Changes were found 👍
 `)
+	} else {
+		section, err := v.renderBreakingChangesToMarkdown()
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, *section)
 	}
 
+	suppressionSections, err := renderSuppressionsToMarkdown(v.suppressions, true)
+	if err != nil {
+		return nil, err
+	}
+	sections = append(sections, suppressionSections...)
+
+	output := strings.Join(sections, "\n---\n\n")
+	return trimSpaceAround(output)
+}
+
+func (v BreakingChangeView) renderBreakingChangesToMarkdown() (*string, error) {
+
 	diff := make([]string, 0)
 	for i, change := range v.breakingChanges {
 		log.Logger.Trace(fmt.Sprintf("Rendering Breaking Change %d", i))
@@ -66,5 +96,18 @@ Summary of changes:
 %s
 
 `, len(v.breakingChanges), strings.Join(diff, "\n"))
-	return trimSpaceAround(output)
+	return &output, nil
+}
+
+// RenderJSON renders the Breaking Changes View as JSON, intended to be consumed by automated tooling.
+func (v BreakingChangeView) RenderJSON() (*string, error) {
+	output, err := buildChangesOutput(v.breakingChanges, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := output.addSuppressions(v.suppressions, true); err != nil {
+		return nil, err
+	}
+
+	return marshalJSON(*output)
 }
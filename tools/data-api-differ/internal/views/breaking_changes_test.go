@@ -7,7 +7,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/suppressions"
 	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
 )
 
@@ -103,3 +105,86 @@ Summary of changes:
 `, "'", "`")
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestBreakingChangeView_Markdown_WithSuppressions(t *testing.T) {
+	diff := []changes.Change{
+		changes.ServiceRemoved{
+			ServiceName: "First",
+		},
+	}
+	result := suppressions.Result{
+		Changes: diff,
+		Acknowledged: []suppressions.AcknowledgedChange{
+			{
+				Change: changes.ServiceRemoved{
+					ServiceName: "Second",
+				},
+				Suppression: suppressions.Suppression{
+					Kind:    "ServiceRemoved",
+					Service: "Second",
+					Reason:  "This Service has been retired",
+					Expires: pointer.To("2030-01-01"),
+				},
+			},
+			{
+				// Non-breaking changes should be filtered out
+				Change: changes.ServiceAdded{
+					ServiceName: "Third",
+				},
+				Suppression: suppressions.Suppression{
+					Kind:    "ServiceAdded",
+					Service: "Third",
+					Reason:  "This Service is new",
+				},
+			},
+		},
+		Expired: []suppressions.Suppression{
+			{
+				Kind:    "ServiceRemoved",
+				Service: "Fourth",
+				Reason:  "This Service was retired",
+				Expires: pointer.To("2020-01-01"),
+			},
+		},
+		Unmatched: []suppressions.Suppression{
+			{
+				Kind:    "ServiceRemoved",
+				Service: "Fifth",
+				Reason:  "This Service is being retired",
+			},
+		},
+	}
+	actual, err := NewBreakingChangesView(result.Changes).WithSuppressions(result).RenderMarkdown()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := strings.ReplaceAll(`
+## Breaking Changes
+
+🛑 **1 Breaking Changes** were detected.
+
+---
+
+Summary of changes:
+
+* ❌ **Removed Service:** 'First'.
+
+---
+
+## Acknowledged Changes
+
+**1 Changes** were acknowledged in the Suppressions File:
+
+* ☑️ **Removed Service:** 'Second'. Reason: This Service has been retired (until '2030-01-01')
+
+---
+
+## Suppressions File
+
+**2 Suppressions** need to be reviewed:
+
+* ⌛ **Expired Suppression:** 'ServiceRemoved / service "Fourth"' expired on '2020-01-01' and should be removed or renewed.
+* ❓ **Unmatched Suppression:** 'ServiceRemoved / service "Fifth"' did not match any Changes and should be removed.
+`, "'", "`")
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
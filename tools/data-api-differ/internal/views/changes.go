@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
 	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/suppressions"
 )
 
 var _ View = ChangesView{}
@@ -20,6 +21,9 @@ type ChangesView struct {
 
 	// nonBreakingChanges is a slice of the non-breaking changes that should be rendered
 	nonBreakingChanges []changes.Change
+
+	// suppressions optionally contains the result of applying the Suppressions File
+	suppressions *suppressions.Result
 }
 
 func NewChangesView(input []changes.Change) ChangesView {
@@ -41,16 +45,42 @@ func NewChangesView(input []changes.Change) ChangesView {
 	}
 }
 
+// WithSuppressions returns a copy of this View which also renders the Changes which were acknowledged in
+// the Suppressions File, and any Suppressions which need to be reviewed.
+func (v ChangesView) WithSuppressions(input suppressions.Result) ChangesView {
+	v.suppressions = &input
+	return v
+}
+
 // RenderMarkdown renders the Changes View using Markdown, intended for both display
 // in a Terminal and to be output as a GitHub Comment.
 func (v ChangesView) RenderMarkdown() (*string, error) {
+	sections := make([]string, 0)
 	if len(v.breakingChanges) == 0 && len(v.nonBreakingChanges) == 0 {
-		return trimSpaceAround(`
+		sections = append(sections, `
 ## Summary of Changes
 
 No Breaking or Non-Breaking Changes were found 👍
 `)
+	} else {
+		changesSections, err := v.renderChangesToMarkdown()
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, changesSections...)
+	}
+
+	suppressionSections, err := renderSuppressionsToMarkdown(v.suppressions, false)
+	if err != nil {
+		return nil, err
 	}
+	sections = append(sections, suppressionSections...)
+
+	output := strings.Join(sections, "\n---\n\n")
+	return trimSpaceAround(output)
+}
+
+func (v ChangesView) renderChangesToMarkdown() ([]string, error) {
 
 	summaryLines := make([]string, 0)
 	if len(v.breakingChanges) > 0 {
@@ -114,6 +144,18 @@ No Breaking or Non-Breaking Changes were found 👍
 `, len(v.nonBreakingChanges), strings.Join(nonBreakingChangesSummary, "\n")))
 	}
 
-	output := strings.Join(sections, "\n---\n\n")
-	return trimSpaceAround(output)
+	return sections, nil
+}
+
+// RenderJSON renders the Changes View as JSON, intended to be consumed by automated tooling.
+func (v ChangesView) RenderJSON() (*string, error) {
+	output, err := buildChangesOutput(v.breakingChanges, v.nonBreakingChanges)
+	if err != nil {
+		return nil, err
+	}
+	if err := output.addSuppressions(v.suppressions, false); err != nil {
+		return nil, err
+	}
+
+	return marshalJSON(*output)
 }
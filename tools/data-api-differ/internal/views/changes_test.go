@@ -133,3 +133,45 @@ func TestChangesView_Markdown_WithBreakingAndNonBreakingChanges(t *testing.T) {
 `, "'", "`")
 	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
 }
+
+func TestChangesView_Markdown_WithCommonTypesChanges(t *testing.T) {
+	diff := []changes.Change{
+		changes.FieldRemoved{
+			ServiceName:          changes.CommonTypesServiceName,
+			ModelName:            "Sku",
+			FieldName:            "Tier",
+			ReferencedByServices: []string{"Compute", "Network"},
+		},
+		changes.ModelAdded{
+			ServiceName: changes.CommonTypesServiceName,
+			ModelName:   "Unused",
+		},
+	}
+	actual, err := NewChangesView(diff).RenderMarkdown()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := strings.ReplaceAll(`
+ ## Summary of Changes
+
+* 🛑 **1 Breaking Changes** were detected.
+* 👀 1 Non-Breaking Changes were detected.
+
+---
+
+## Breaking Changes
+
+**1 Breaking Changes** were detected:
+
+* ❌ **Field Removed:** 'Tier' from Model 'Sku' in Common Types (referenced by 'Compute', 'Network').
+
+---
+
+## Non-Breaking Changes
+
+**1 Non-Breaking Changes** were detected:
+
+* ✅ **Model Added:** 'Unused' in Common Types (not referenced by any Services).
+`, "'", "`")
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
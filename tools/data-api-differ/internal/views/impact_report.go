@@ -0,0 +1,664 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ View = ImpactReportView{}
+
+// Impact specifies how a generated Go Package, Terraform Resource or file is affected by a set of Changes.
+type Impact string
+
+const (
+	// AddedImpact specifies that this will be generated for the first time.
+	AddedImpact Impact = "added"
+
+	// RegeneratedImpact specifies that this already exists and will be regenerated.
+	RegeneratedImpact Impact = "regenerated"
+
+	// DeletedImpact specifies that this will no longer be generated, and as such should be removed.
+	DeletedImpact Impact = "deleted"
+)
+
+// ImpactReportView projects the Changes onto the output of `generator-go-sdk` and `generator-terraform`, which
+// allows determining which generated Go Packages, Terraform Resources and Documentation pages are affected prior
+// to running the generators.
+type ImpactReportView struct {
+	// packages is a list of the affected Go Packages, sorted by the path to the Package.
+	packages []impactReportPackage
+
+	// terraformResources is a list of the affected Terraform Resources, sorted by the Service and Resource Label.
+	terraformResources []impactReportTerraformResource
+}
+
+// impactReportPackage describes how a single Go Package generated by `generator-go-sdk` is affected.
+type impactReportPackage struct {
+	// path is the path to the Go Package within the Go SDK (e.g. `resource-manager/compute/2022-01-01/virtualmachines`).
+	path string
+
+	// serviceName, apiVersion and resourceName specify the API Resource which this Go Package is generated from.
+	serviceName  string
+	apiVersion   string
+	resourceName string
+
+	// impact specifies how this Go Package is affected.
+	impact Impact
+
+	// files is a map of the name of each affected file within this Go Package (e.g. `model_example.go`) to how
+	// that file is affected. When the Go Package is added or deleted this contains every file in the Go Package.
+	files map[string]Impact
+}
+
+// impactReportTerraformResource describes how a single Terraform Resource generated by `generator-terraform` is affected.
+type impactReportTerraformResource struct {
+	// serviceName specifies the name of the Service which contains this Terraform Resource.
+	serviceName string
+
+	// resourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	resourceLabel string
+
+	// impact specifies how this Terraform Resource is affected.
+	impact Impact
+
+	// terraformDefinitionChanged specifies whether the Terraform Definition for this Terraform Resource has changed.
+	terraformDefinitionChanged bool
+
+	// goSdkPackages is a list of the paths to the affected Go Packages which this Terraform Resource uses.
+	goSdkPackages []string
+
+	// files is a map of the path to each affected file within the Provider (e.g. the Documentation page)
+	// to how that file is affected.
+	files map[string]Impact
+}
+
+// NewImpactReportView returns an ImpactReportView for the Changes within input, where initial and updated are the
+// API Definitions which were compared to determine these Changes.
+//
+// Changes to the Common Types are not included, since these are not generated by `generator-go-sdk`.
+func NewImpactReportView(input []changes.Change, initial, updated v1.LoadAllDataResult, sourceDataType models.SourceDataType) ImpactReportView {
+	builder := impactReportBuilder{
+		initial:            initial,
+		updated:            updated,
+		sourceDataType:     sourceDataType,
+		packages:           make(map[string]*impactReportPackage),
+		terraformResources: make(map[string]*impactReportTerraformResource),
+	}
+	for _, change := range input {
+		builder.addChange(change)
+	}
+	builder.addTerraformResourcesUsingAffectedPackages()
+
+	output := ImpactReportView{
+		packages:           make([]impactReportPackage, 0),
+		terraformResources: make([]impactReportTerraformResource, 0),
+	}
+	for _, key := range sortedKeys(builder.packages) {
+		output.packages = append(output.packages, *builder.packages[key])
+	}
+	for _, key := range sortedKeys(builder.terraformResources) {
+		resource := *builder.terraformResources[key]
+		sort.Strings(resource.goSdkPackages)
+		output.terraformResources = append(output.terraformResources, resource)
+	}
+	return output
+}
+
+// RenderMarkdown renders the Impact Report View using Markdown.
+func (v ImpactReportView) RenderMarkdown() (*string, error) {
+	if len(v.packages) == 0 && len(v.terraformResources) == 0 {
+		output := `
+## Impact Report
+
+No generated files are affected by these changes 👍
+`
+		return trimSpaceAround(output)
+	}
+
+	packages := v.countPackages()
+	terraformResources := v.countTerraformResources()
+	sections := []string{
+		fmt.Sprintf(`
+## Impact Report
+
+* Go SDK Packages: **%d Added**, **%d Regenerated** and **%d Deleted**.
+* Terraform Resources: **%d Added**, **%d Regenerated** and **%d Deleted** (affecting %d Documentation pages).
+`, packages.Added, packages.Regenerated, packages.Deleted, terraformResources.Added, terraformResources.Regenerated, terraformResources.Deleted, v.countDocumentationPages()),
+	}
+
+	if len(v.packages) > 0 {
+		lines := make([]string, 0)
+		for _, pkg := range v.packages {
+			lines = append(lines, fmt.Sprintf("* `%s` (%s)", pkg.path, impactTitle(pkg.impact)))
+
+			// the files within an added/deleted package are implied, so are only output in the JSON
+			if pkg.impact == RegeneratedImpact {
+				lines = append(lines, markdownForImpactedFiles(pkg.files)...)
+			}
+		}
+		sections = append(sections, fmt.Sprintf(`
+### Go SDK Packages
+
+%s
+`, strings.Join(lines, "\n")))
+	}
+
+	if len(v.terraformResources) > 0 {
+		lines := make([]string, 0)
+		for _, resource := range v.terraformResources {
+			reasons := make([]string, 0)
+			if resource.terraformDefinitionChanged {
+				reasons = append(reasons, "the Terraform Definition has changed")
+			}
+			if len(resource.goSdkPackages) > 0 {
+				packagePaths := make([]string, 0)
+				for _, path := range resource.goSdkPackages {
+					packagePaths = append(packagePaths, fmt.Sprintf("`%s`", path))
+				}
+				reasons = append(reasons, fmt.Sprintf("the Go SDK Package %s is affected", strings.Join(packagePaths, ", ")))
+			}
+			lines = append(lines, fmt.Sprintf("* `%s` in Service `%s` (%s) - since %s:", resource.resourceLabel, resource.serviceName, impactTitle(resource.impact), strings.Join(reasons, " and ")))
+			lines = append(lines, markdownForImpactedFiles(resource.files)...)
+		}
+		sections = append(sections, fmt.Sprintf(`
+### Terraform Resources
+
+%s
+`, strings.Join(lines, "\n")))
+	}
+
+	output := strings.Join(sections, "")
+	return trimSpaceAround(output)
+}
+
+// RenderJSON renders the Impact Report View as JSON, intended to be consumed by automated tooling.
+func (v ImpactReportView) RenderJSON() (*string, error) {
+	output := impactReportOutput{
+		SchemaVersion: JSONSchemaVersion,
+		Summary: impactReportSummary{
+			GoSdkPackages:      v.countPackages(),
+			TerraformResources: v.countTerraformResources(),
+			DocumentationPages: v.countDocumentationPages(),
+		},
+		GoSdkPackages:      make([]impactReportPackageOutput, 0),
+		TerraformResources: make([]impactReportTerraformResourceOutput, 0),
+	}
+	for _, pkg := range v.packages {
+		output.GoSdkPackages = append(output.GoSdkPackages, impactReportPackageOutput{
+			Path:         pkg.path,
+			ServiceName:  pkg.serviceName,
+			ApiVersion:   pkg.apiVersion,
+			ResourceName: pkg.resourceName,
+			Impact:       pkg.impact,
+			Files:        buildImpactReportFileOutputs(pkg.files),
+		})
+	}
+	for _, resource := range v.terraformResources {
+		output.TerraformResources = append(output.TerraformResources, impactReportTerraformResourceOutput{
+			ServiceName:                resource.serviceName,
+			ResourceLabel:              resource.resourceLabel,
+			Impact:                     resource.impact,
+			TerraformDefinitionChanged: resource.terraformDefinitionChanged,
+			GoSdkPackages:              append([]string{}, resource.goSdkPackages...),
+			Files:                      buildImpactReportFileOutputs(resource.files),
+		})
+	}
+
+	return marshalJSON(output)
+}
+
+func (v ImpactReportView) countPackages() impactReportCounts {
+	output := impactReportCounts{}
+	for _, pkg := range v.packages {
+		output.increment(pkg.impact)
+	}
+	return output
+}
+
+func (v ImpactReportView) countTerraformResources() impactReportCounts {
+	output := impactReportCounts{}
+	for _, resource := range v.terraformResources {
+		output.increment(resource.impact)
+	}
+	return output
+}
+
+func (v ImpactReportView) countDocumentationPages() int {
+	count := 0
+	for _, resource := range v.terraformResources {
+		for path := range resource.files {
+			if strings.HasPrefix(path, "website/") {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+func (c *impactReportCounts) increment(impact Impact) {
+	switch impact {
+	case AddedImpact:
+		c.Added++
+	case RegeneratedImpact:
+		c.Regenerated++
+	case DeletedImpact:
+		c.Deleted++
+	}
+}
+
+// impactReportBuilder accumulates the Go Packages and Terraform Resources affected by each Change.
+type impactReportBuilder struct {
+	initial        v1.LoadAllDataResult
+	updated        v1.LoadAllDataResult
+	sourceDataType models.SourceDataType
+
+	// packages is a map of the path to the Go Package to the affected Go Package.
+	packages map[string]*impactReportPackage
+
+	// terraformResources is a map of `{serviceName}/{resourceLabel}` to the affected Terraform Resource.
+	terraformResources map[string]*impactReportTerraformResource
+}
+
+func (b *impactReportBuilder) addChange(input changes.Change) {
+	switch v := input.(type) {
+	case changes.ServiceAdded:
+		b.addPackagesForService(v.ServiceName)
+		return
+
+	case changes.ServiceRemoved:
+		b.addPackagesForService(v.ServiceName)
+		return
+
+	case changes.ApiVersionAdded:
+		b.addPackagesForApiVersion(v.ServiceName, v.ApiVersion)
+		return
+
+	case changes.ApiVersionRemoved:
+		b.addPackagesForApiVersion(v.ServiceName, v.ApiVersion)
+		return
+
+	case changes.ApiResourceAdded:
+		b.addPackage(v.ServiceName, v.ApiVersion, v.ResourceName, nil)
+		return
+
+	case changes.ApiResourceRemoved:
+		b.addPackage(v.ServiceName, v.ApiVersion, v.ResourceName, nil)
+		return
+	}
+
+	identifiers := input.Identifiers()
+	serviceName := identifiers.ServiceName
+	if isTerraformChange(input) {
+		b.addTerraformChange(input, serviceName)
+		return
+	}
+
+	// the Common Types aren't generated by `generator-go-sdk`
+	if serviceName == changes.CommonTypesServiceName {
+		return
+	}
+
+	apiVersion := identifiers.ApiVersion
+	resourceName := identifiers.ResourceName
+	if apiVersion == "" || resourceName == "" {
+		return
+	}
+	b.addPackage(serviceName, apiVersion, resourceName, b.goSdkFileNamesForChange(input))
+}
+
+// addPackagesForService adds each of the Go Packages generated for the specified Service, in either set of API Definitions.
+func (b *impactReportBuilder) addPackagesForService(serviceName string) {
+	apiVersions := make(map[string]struct{})
+	for _, data := range []v1.LoadAllDataResult{b.initial, b.updated} {
+		for apiVersion := range data.Services[serviceName].APIVersions {
+			apiVersions[apiVersion] = struct{}{}
+		}
+	}
+	for _, apiVersion := range sortedKeys(apiVersions) {
+		b.addPackagesForApiVersion(serviceName, apiVersion)
+	}
+}
+
+// addPackagesForApiVersion adds each of the Go Packages generated for the specified API Version, in either set of API Definitions.
+func (b *impactReportBuilder) addPackagesForApiVersion(serviceName, apiVersion string) {
+	resourceNames := make(map[string]struct{})
+	for _, data := range []v1.LoadAllDataResult{b.initial, b.updated} {
+		for resourceName := range data.Services[serviceName].APIVersions[apiVersion].Resources {
+			resourceNames[resourceName] = struct{}{}
+		}
+	}
+	for _, resourceName := range sortedKeys(resourceNames) {
+		b.addPackage(serviceName, apiVersion, resourceName, nil)
+	}
+}
+
+// addPackage adds the Go Package generated for the specified API Resource - where fileNames specifies the names of
+// the files within this Go Package which are affected when the Go Package is being regenerated.
+func (b *impactReportBuilder) addPackage(serviceName, apiVersion, resourceName string, fileNames []string) {
+	initialResource, existsInInitial := b.initial.Services[serviceName].APIVersions[apiVersion].Resources[resourceName]
+	updatedResource, existsInUpdated := b.updated.Services[serviceName].APIVersions[apiVersion].Resources[resourceName]
+	initialFiles := goSdkFileNamesForAPIResource(initialResource)
+	updatedFiles := goSdkFileNamesForAPIResource(updatedResource)
+
+	path := goSdkPackagePath(b.sourceDataType, serviceName, apiVersion, resourceName)
+	pkg, ok := b.packages[path]
+	if !ok {
+		pkg = &impactReportPackage{
+			path:         path,
+			serviceName:  serviceName,
+			apiVersion:   apiVersion,
+			resourceName: resourceName,
+			impact:       RegeneratedImpact,
+			files:        make(map[string]Impact),
+		}
+		b.packages[path] = pkg
+
+		// when the Go Package is added/deleted every file within it is affected
+		if !existsInInitial {
+			pkg.impact = AddedImpact
+			for fileName := range updatedFiles {
+				pkg.files[fileName] = AddedImpact
+			}
+		}
+		if !existsInUpdated {
+			pkg.impact = DeletedImpact
+			for fileName := range initialFiles {
+				pkg.files[fileName] = DeletedImpact
+			}
+		}
+	}
+	if pkg.impact != RegeneratedImpact {
+		return
+	}
+
+	for _, fileName := range fileNames {
+		_, existsInInitial := initialFiles[fileName]
+		_, existsInUpdated := updatedFiles[fileName]
+		switch {
+		case existsInInitial && existsInUpdated:
+			pkg.files[fileName] = RegeneratedImpact
+		case existsInUpdated:
+			pkg.files[fileName] = AddedImpact
+		case existsInInitial:
+			pkg.files[fileName] = DeletedImpact
+		}
+	}
+}
+
+// addTerraformChange adds the Terraform Resource(s) affected by a Change to the Terraform Definitions.
+func (b *impactReportBuilder) addTerraformChange(input changes.Change, serviceName string) {
+	if resourceLabel := input.Identifiers().ResourceLabel; resourceLabel != "" {
+		resource := b.addTerraformResource(serviceName, resourceLabel)
+		resource.terraformDefinitionChanged = true
+
+		// Changes to the Mappings and Tests don't affect the Documentation
+		typeName := changeTypeName(input)
+		if !strings.HasPrefix(typeName, "TerraformMapping") && !strings.HasPrefix(typeName, "TerraformTest") {
+			if path := b.terraformDocumentationFilePath(resourceLabel); path != "" {
+				resource.files[path] = resource.impact
+			}
+		}
+		return
+	}
+
+	// otherwise this Change applies to every Terraform Resource within the Service (e.g. the Package Name changing)
+	for _, resourceLabel := range b.terraformResourceLabelsForService(serviceName) {
+		resource := b.addTerraformResource(serviceName, resourceLabel)
+		resource.terraformDefinitionChanged = true
+	}
+}
+
+// addTerraformResourcesUsingAffectedPackages adds each of the Terraform Resources using an affected Go Package,
+// that is, where the API Version and API Resource used by the Terraform Resource match the Go Package.
+func (b *impactReportBuilder) addTerraformResourcesUsingAffectedPackages() {
+	for _, path := range sortedKeys(b.packages) {
+		pkg := b.packages[path]
+		for _, resourceLabel := range b.terraformResourceLabelsForService(pkg.serviceName) {
+			usesPackage := false
+			for _, data := range []v1.LoadAllDataResult{b.initial, b.updated} {
+				service := data.Services[pkg.serviceName]
+				if service.TerraformDefinition == nil {
+					continue
+				}
+				definition, ok := service.TerraformDefinition.Resources[resourceLabel]
+				if ok && definition.APIVersion == pkg.apiVersion && definition.APIResource == pkg.resourceName {
+					usesPackage = true
+				}
+			}
+			if !usesPackage {
+				continue
+			}
+
+			resource := b.addTerraformResource(pkg.serviceName, resourceLabel)
+			resource.goSdkPackages = append(resource.goSdkPackages, pkg.path)
+		}
+	}
+}
+
+// addTerraformResource adds (or returns the existing) Terraform Resource, along with the generated Resource and Test files.
+func (b *impactReportBuilder) addTerraformResource(serviceName, resourceLabel string) *impactReportTerraformResource {
+	key := fmt.Sprintf("%s/%s", serviceName, resourceLabel)
+	if existing, ok := b.terraformResources[key]; ok {
+		return existing
+	}
+
+	resource := &impactReportTerraformResource{
+		serviceName:   serviceName,
+		resourceLabel: resourceLabel,
+		impact:        RegeneratedImpact,
+		goSdkPackages: make([]string, 0),
+		files:         make(map[string]Impact),
+	}
+	if !terraformResourceExists(b.initial, serviceName, resourceLabel) {
+		resource.impact = AddedImpact
+	}
+	if !terraformResourceExists(b.updated, serviceName, resourceLabel) {
+		resource.impact = DeletedImpact
+	}
+
+	// when the Terraform Package Name changes the Resource moves, so both the old and new files are affected
+	packageNames := make(map[string]Impact)
+	if name := terraformPackageName(b.initial, serviceName); name != "" && resource.impact != AddedImpact {
+		packageNames[name] = DeletedImpact
+	}
+	if name := terraformPackageName(b.updated, serviceName); name != "" && resource.impact != DeletedImpact {
+		if _, ok := packageNames[name]; ok {
+			packageNames[name] = resource.impact
+		} else {
+			packageNames[name] = AddedImpact
+		}
+	}
+	for packageName, impact := range packageNames {
+		directory := fmt.Sprintf("internal/services/%s", packageName)
+		resource.files[fmt.Sprintf("%s/%s_resource_gen.go", directory, resourceLabel)] = impact
+		resource.files[fmt.Sprintf("%s/%s_resource_gen_test.go", directory, resourceLabel)] = impact
+	}
+	if resource.impact != RegeneratedImpact {
+		resource.files[b.terraformDocumentationFilePath(resourceLabel)] = resource.impact
+	}
+
+	b.terraformResources[key] = resource
+	return resource
+}
+
+// terraformDocumentationFilePath returns the path to the Documentation page generated for the Terraform Resource.
+func (b *impactReportBuilder) terraformDocumentationFilePath(resourceLabel string) string {
+	return fmt.Sprintf("website/docs/r/%s.html.markdown", resourceLabel)
+}
+
+// terraformResourceLabelsForService returns the sorted labels of the Terraform Resources within the specified Service,
+// in either set of API Definitions.
+func (b *impactReportBuilder) terraformResourceLabelsForService(serviceName string) []string {
+	resourceLabels := make(map[string]struct{})
+	for _, data := range []v1.LoadAllDataResult{b.initial, b.updated} {
+		service := data.Services[serviceName]
+		if service.TerraformDefinition == nil {
+			continue
+		}
+		for resourceLabel := range service.TerraformDefinition.Resources {
+			resourceLabels[resourceLabel] = struct{}{}
+		}
+	}
+	return sortedKeys(resourceLabels)
+}
+
+func terraformResourceExists(input v1.LoadAllDataResult, serviceName, resourceLabel string) bool {
+	service := input.Services[serviceName]
+	if service.TerraformDefinition == nil {
+		return false
+	}
+	_, ok := service.TerraformDefinition.Resources[resourceLabel]
+	return ok
+}
+
+func terraformPackageName(input v1.LoadAllDataResult, serviceName string) string {
+	service := input.Services[serviceName]
+	if service.TerraformDefinition == nil {
+		return ""
+	}
+	return service.TerraformDefinition.TerraformPackageName
+}
+
+// goSdkFileNamesForAPIResource returns the names of the files which `generator-go-sdk` outputs for the API Resource.
+func goSdkFileNamesForAPIResource(input models.APIResource) map[string]struct{} {
+	output := make(map[string]struct{})
+	if len(input.Constants) == 0 && len(input.Models) == 0 && len(input.Operations) == 0 && len(input.ResourceIDs) == 0 {
+		return output
+	}
+
+	output["client.go"] = struct{}{}
+	output["fakes/client.go"] = struct{}{}
+	output["interface.go"] = struct{}{}
+	output["version.go"] = struct{}{}
+	if len(input.Constants) > 0 {
+		output["constants.go"] = struct{}{}
+	}
+	for resourceIdName, resourceId := range input.ResourceIDs {
+		if resourceId.CommonIDAlias != nil || len(resourceId.Segments) == 0 {
+			continue
+		}
+		for _, fileName := range goSdkFileNamesForResourceId(resourceIdName) {
+			output[fileName] = struct{}{}
+		}
+	}
+	for operationName, operation := range input.Operations {
+		output[goSdkFileNameForOperation(operationName)] = struct{}{}
+
+		// the Poller Resume Tokens are only output when the API Resource contains Long Running Operations
+		if operation.LongRunning {
+			output["pollers.go"] = struct{}{}
+		}
+
+		if operation.FieldContainingPaginationDetails != nil && operation.ResponseObject != nil && operation.ResponseObject.ReferenceName != nil {
+			output["predicates.go"] = struct{}{}
+		}
+	}
+	for modelName := range input.Models {
+		output[goSdkFileNameForModel(modelName)] = struct{}{}
+	}
+	if len(input.Models) > 0 {
+		output["README.md"] = struct{}{}
+		output["validation.go"] = struct{}{}
+	}
+	return output
+}
+
+// goSdkFileNamesForChange returns the names of the files within the Go Package which are affected by the Change.
+func (b *impactReportBuilder) goSdkFileNamesForChange(input changes.Change) []string {
+	identifiers := input.Identifiers()
+	// Changes to an Operation can also reference a Resource ID, however only the Operation is regenerated - together
+	// with the Client Interface and the Fakes, which contain the signature for every Operation
+	if identifiers.OperationName != "" {
+		output := []string{goSdkFileNameForOperation(identifiers.OperationName), "fakes/client.go", "interface.go"}
+		if b.operationIsLongRunning(identifiers) {
+			// the functions used to resume polling a Long Running Operation are output into a single file
+			output = append(output, "pollers.go")
+		}
+		return output
+	}
+	if identifiers.ModelName != "" {
+		// the validation functions for every Model are output into a single file
+		return []string{goSdkFileNameForModel(identifiers.ModelName), "validation.go"}
+	}
+	if identifiers.ConstantName != "" {
+		return []string{"constants.go"}
+	}
+	if identifiers.ResourceIdName != "" {
+		return goSdkFileNamesForResourceId(identifiers.ResourceIdName)
+	}
+	return []string{}
+}
+
+// operationIsLongRunning returns whether the Operation identified by identifiers is a Long Running Operation, in
+// either set of API Definitions.
+func (b *impactReportBuilder) operationIsLongRunning(identifiers changes.Identifiers) bool {
+	for _, data := range []v1.LoadAllDataResult{b.initial, b.updated} {
+		resource := data.Services[identifiers.ServiceName].APIVersions[identifiers.ApiVersion].Resources[identifiers.ResourceName]
+		if operation, ok := resource.Operations[identifiers.OperationName]; ok && operation.LongRunning {
+			return true
+		}
+	}
+	return false
+}
+
+func goSdkFileNameForModel(modelName string) string {
+	return fmt.Sprintf("model_%s.go", strings.ToLower(modelName))
+}
+
+func goSdkFileNameForOperation(operationName string) string {
+	fileName := fmt.Sprintf("method_%s.go", strings.ToLower(operationName))
+	if strings.HasSuffix(fileName, "_test.go") {
+		// `generator-go-sdk` avoids outputting these as a test file, which wouldn't be compiled
+		return fmt.Sprintf("method_%s_operation.go", strings.ToLower(operationName))
+	}
+	return fileName
+}
+
+func goSdkFileNamesForResourceId(resourceIdName string) []string {
+	// `generator-go-sdk` trims the `Id` suffix from the name of the Resource ID
+	fileNamePrefix := strings.ToLower(strings.TrimSuffix(resourceIdName, "Id"))
+	return []string{
+		fmt.Sprintf("id_%s.go", fileNamePrefix),
+		fmt.Sprintf("id_%s_test.go", fileNamePrefix),
+	}
+}
+
+func buildImpactReportFileOutputs(input map[string]Impact) []impactReportFileOutput {
+	output := make([]impactReportFileOutput, 0)
+	for _, path := range sortedKeys(input) {
+		output = append(output, impactReportFileOutput{
+			Path:   path,
+			Impact: input[path],
+		})
+	}
+	return output
+}
+
+func markdownForImpactedFiles(input map[string]Impact) []string {
+	output := make([]string, 0)
+	for _, path := range sortedKeys(input) {
+		output = append(output, fmt.Sprintf("  * `%s` (%s)", path, impactTitle(input[path])))
+	}
+	return output
+}
+
+func impactTitle(input Impact) string {
+	return strings.Title(string(input))
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	output := make([]string, 0)
+	for key := range input {
+		output = append(output, key)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestImpactReportView_Markdown_NoChanges(t *testing.T) {
+	diff := []changes.Change{
+		// the Common Types aren't generated by `generator-go-sdk` so should be filtered out
+		changes.ModelAdded{
+			ServiceName: changes.CommonTypesServiceName,
+			ModelName:   "SystemData",
+		},
+	}
+	data := impactReportTestData(false)
+	actual, err := NewImpactReportView(diff, data, data, models.ResourceManagerSourceDataType).RenderMarkdown()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := `
+## Impact Report
+
+No generated files are affected by these changes 👍
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestImpactReportView_Markdown_WithChanges(t *testing.T) {
+	actual, err := NewImpactReportView(impactReportTestChanges(), impactReportTestData(false), impactReportTestData(true), models.ResourceManagerSourceDataType).RenderMarkdown()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := strings.ReplaceAll(`
+## Impact Report
+
+* Go SDK Packages: **1 Added**, **1 Regenerated** and **1 Deleted**.
+* Terraform Resources: **0 Added**, **2 Regenerated** and **0 Deleted** (affecting 1 Documentation pages).
+
+### Go SDK Packages
+
+* 'resource-manager/compute/2022-01-01/disks' (Added)
+* 'resource-manager/compute/2022-01-01/legacy' (Deleted)
+* 'resource-manager/compute/2022-01-01/virtualmachines' (Regenerated)
+  * 'constants.go' (Added)
+  * 'fakes/client.go' (Regenerated)
+  * 'interface.go' (Regenerated)
+  * 'method_get.go' (Regenerated)
+  * 'method_list.go' (Added)
+  * 'method_restart.go' (Added)
+  * 'model_virtualmachineproperties.go' (Added)
+  * 'pollers.go' (Added)
+  * 'validation.go' (Regenerated)
+
+### Terraform Resources
+
+* 'virtual_machine' in Service 'Compute' (Regenerated) - since the Terraform Definition has changed and the Go SDK Package 'resource-manager/compute/2022-01-01/virtualmachines' is affected:
+  * 'internal/services/compute/virtual_machine_resource_gen.go' (Regenerated)
+  * 'internal/services/compute/virtual_machine_resource_gen_test.go' (Regenerated)
+  * 'website/docs/r/virtual_machine.html.markdown' (Regenerated)
+* 'virtual_machine_extension' in Service 'Compute' (Regenerated) - since the Terraform Definition has changed:
+  * 'internal/services/compute/virtual_machine_extension_resource_gen.go' (Regenerated)
+  * 'internal/services/compute/virtual_machine_extension_resource_gen_test.go' (Regenerated)
+`, "'", "`")
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestImpactReportView_JSON(t *testing.T) {
+	actual, err := NewImpactReportView(impactReportTestChanges(), impactReportTestData(false), impactReportTestData(true), models.ResourceManagerSourceDataType).RenderJSON()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	var output impactReportOutput
+	if err := json.Unmarshal([]byte(*actual), &output); err != nil {
+		t.Fatalf("unmarshaling the output: %+v", err)
+	}
+	if output.SchemaVersion != JSONSchemaVersion {
+		t.Fatalf("expected the schema version to be %d but got %d", JSONSchemaVersion, output.SchemaVersion)
+	}
+	expectedSummary := impactReportSummary{
+		GoSdkPackages: impactReportCounts{
+			Added:       1,
+			Regenerated: 1,
+			Deleted:     1,
+		},
+		TerraformResources: impactReportCounts{
+			Regenerated: 2,
+		},
+		DocumentationPages: 1,
+	}
+	if output.Summary != expectedSummary {
+		t.Fatalf("expected the summary to be %+v but got %+v", expectedSummary, output.Summary)
+	}
+	if len(output.GoSdkPackages) != 3 {
+		t.Fatalf("expected 3 Go SDK Packages but got %d", len(output.GoSdkPackages))
+	}
+
+	// the files within an added Go Package should be output
+	added := output.GoSdkPackages[0]
+	if added.Path != "resource-manager/compute/2022-01-01/disks" || added.Impact != AddedImpact {
+		t.Fatalf("expected the first Go SDK Package to be the added `disks` package but got %+v", added)
+	}
+	expectedFiles := []string{"README.md", "client.go", "fakes/client.go", "id_disk.go", "id_disk_test.go", "interface.go", "method_delete.go", "method_get.go", "model_disk.go", "pollers.go", "validation.go", "version.go"}
+	if len(added.Files) != len(expectedFiles) {
+		t.Fatalf("expected %d files but got %d: %+v", len(expectedFiles), len(added.Files), added.Files)
+	}
+	for i, fileName := range expectedFiles {
+		if added.Files[i].Path != fileName || added.Files[i].Impact != AddedImpact {
+			t.Fatalf("expected file %d to be the added file %q but got %+v", i, fileName, added.Files[i])
+		}
+	}
+
+	if len(output.TerraformResources) != 2 {
+		t.Fatalf("expected 2 Terraform Resources but got %d", len(output.TerraformResources))
+	}
+	resource := output.TerraformResources[0]
+	if resource.ResourceLabel != "virtual_machine" || !resource.TerraformDefinitionChanged || len(resource.GoSdkPackages) != 1 {
+		t.Fatalf("unexpected Terraform Resource: %+v", resource)
+	}
+}
+
+func impactReportTestChanges() []changes.Change {
+	return []changes.Change{
+		changes.ApiResourceAdded{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "Disks",
+		},
+		changes.ApiResourceRemoved{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "Legacy",
+		},
+		changes.ConstantAdded{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "VirtualMachines",
+			ConstantName: "Size",
+		},
+		changes.ModelAdded{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachineProperties",
+		},
+		changes.OperationAdded{
+			ServiceName:   "Compute",
+			ApiVersion:    "2022-01-01",
+			ResourceName:  "VirtualMachines",
+			OperationName: "List",
+		},
+		changes.OperationAdded{
+			ServiceName:   "Compute",
+			ApiVersion:    "2022-01-01",
+			ResourceName:  "VirtualMachines",
+			OperationName: "Restart",
+		},
+		changes.OperationResourceIdChanged{
+			ServiceName:   "Compute",
+			ApiVersion:    "2022-01-01",
+			ResourceName:  "VirtualMachines",
+			OperationName: "Get",
+		},
+		changes.TerraformSchemaFieldAdded{
+			ServiceName:     "Compute",
+			ResourceLabel:   "virtual_machine",
+			SchemaModelName: "VirtualMachineResourceSchema",
+			FieldName:       "Size",
+		},
+		// Changes to the Tests don't affect the Documentation
+		changes.TerraformTestChanged{
+			ServiceName:   "Compute",
+			ResourceLabel: "virtual_machine_extension",
+			TestName:      "Basic",
+		},
+	}
+}
+
+func impactReportTestData(updated bool) v1.LoadAllDataResult {
+	resourceId := models.ResourceID{
+		Segments: []models.ResourceIDSegment{
+			models.NewStaticValueResourceIDSegment("staticSubscriptions", "subscriptions"),
+			models.NewSubscriptionIDResourceIDSegment("subscriptionId"),
+		},
+	}
+	virtualMachines := models.APIResource{
+		Constants: map[string]models.SDKConstant{},
+		Models: map[string]models.SDKModel{
+			"VirtualMachine": {},
+		},
+		Operations: map[string]models.SDKOperation{
+			"Get": {},
+		},
+		ResourceIDs: map[string]models.ResourceID{
+			"VirtualMachineId": resourceId,
+		},
+	}
+	resources := map[string]models.APIResource{
+		"Legacy": {
+			Models: map[string]models.SDKModel{
+				"Legacy": {},
+			},
+		},
+		"VirtualMachines": virtualMachines,
+	}
+	if updated {
+		virtualMachines.Constants = map[string]models.SDKConstant{
+			"Size": {},
+		}
+		virtualMachines.Models = map[string]models.SDKModel{
+			"VirtualMachine":           {},
+			"VirtualMachineProperties": {},
+		}
+		virtualMachines.Operations = map[string]models.SDKOperation{
+			"Get":  {},
+			"List": {},
+			"Restart": {
+				LongRunning: true,
+			},
+		}
+		resources = map[string]models.APIResource{
+			"Disks": {
+				Models: map[string]models.SDKModel{
+					"Disk": {},
+				},
+				Operations: map[string]models.SDKOperation{
+					"Delete": {
+						LongRunning: true,
+					},
+					"Get": {},
+				},
+				ResourceIDs: map[string]models.ResourceID{
+					"DiskId": resourceId,
+				},
+			},
+			"VirtualMachines": virtualMachines,
+		}
+	}
+
+	return v1.LoadAllDataResult{
+		Services: map[string]models.Service{
+			"Compute": {
+				APIVersions: map[string]models.APIVersion{
+					"2022-01-01": {
+						Resources: resources,
+					},
+				},
+				TerraformDefinition: &models.TerraformDefinition{
+					TerraformPackageName: "compute",
+					Resources: map[string]models.TerraformResourceDefinition{
+						"virtual_machine": {
+							APIResource: "VirtualMachines",
+							APIVersion:  "2022-01-01",
+						},
+						"virtual_machine_extension": {
+							APIResource: "VirtualMachineExtensions",
+							APIVersion:  "2022-01-01",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/suppressions"
+)
+
+// JSONSchemaVersion specifies the version of the JSON output, which is documented in `json.md`.
+//
+// This must be incremented when a backwards-incompatible change is made to the JSON output, such as removing or
+// renaming a field or a Change type - adding a new field or Change type is considered backwards-compatible.
+const JSONSchemaVersion = 1
+
+// changesOutput is the JSON output for both the ChangesView and the BreakingChangeView.
+type changesOutput struct {
+	// SchemaVersion specifies the version of this schema, see JSONSchemaVersion.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Summary contains the number of Breaking and Non-Breaking Changes.
+	Summary changesSummary `json:"summary"`
+
+	// Changes is a list of the Changes which were detected, with the Breaking Changes first.
+	Changes []changeOutput `json:"changes"`
+
+	// AcknowledgedChanges is a list of the Changes which were matched by the Suppressions File (if specified).
+	AcknowledgedChanges []acknowledgedChangeOutput `json:"acknowledgedChanges,omitempty"`
+
+	// ExpiredSuppressions is a list of the Suppressions within the Suppressions File (if specified) which have expired.
+	ExpiredSuppressions []suppressions.Suppression `json:"expiredSuppressions,omitempty"`
+
+	// UnmatchedSuppressions is a list of the Suppressions within the Suppressions File (if specified) which didn't match any Changes.
+	UnmatchedSuppressions []suppressions.Suppression `json:"unmatchedSuppressions,omitempty"`
+}
+
+type changesSummary struct {
+	// BreakingChanges specifies the number of Breaking Changes which were detected.
+	BreakingChanges int `json:"breakingChanges"`
+
+	// NonBreakingChanges specifies the number of Non-Breaking Changes which were detected.
+	NonBreakingChanges int `json:"nonBreakingChanges"`
+}
+
+type changeOutput struct {
+	// Type specifies the type of this Change, which is the name of the Change (e.g. `FieldIsNowRequired`)
+	// and determines the fields available within Details.
+	Type string `json:"type"`
+
+	// IsBreaking specifies whether this Change is considered a Breaking Change.
+	IsBreaking bool `json:"isBreaking"`
+
+	// Markdown is a summary of this Change in Markdown, as output by RenderMarkdown.
+	Markdown string `json:"markdown"`
+
+	// Details contains the payload for this Change, the fields of which depend on the Type.
+	Details changes.Change `json:"details"`
+}
+
+// resourceIdSegmentsOutput is the JSON output for the ResourceIdSegmentsView.
+type resourceIdSegmentsOutput struct {
+	// SchemaVersion specifies the version of this schema, see JSONSchemaVersion.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// StaticIdentifierSegments is a unique, sorted list of the Static Identifiers present within the Changes.
+	StaticIdentifierSegments []string `json:"staticIdentifierSegments"`
+}
+
+// releaseNotesOutput is the JSON output for the ReleaseNotesView.
+type releaseNotesOutput struct {
+	// SchemaVersion specifies the version of this schema, see JSONSchemaVersion.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// RecommendedVersionBump specifies the Semantic Version component which should be incremented for this release.
+	RecommendedVersionBump VersionBump `json:"recommendedVersionBump"`
+
+	// Summary contains the number of Breaking Changes, Features and Bug Fixes.
+	Summary releaseNotesSummary `json:"summary"`
+
+	// Packages is a list of the Go Packages containing Changes, sorted by the path to the Package.
+	Packages []releaseNotesPackageOutput `json:"packages"`
+}
+
+type releaseNotesSummary struct {
+	// BreakingChanges specifies the number of Breaking Changes which were detected.
+	BreakingChanges int `json:"breakingChanges"`
+
+	// Features specifies the number of Non-Breaking Changes which add new functionality.
+	Features int `json:"features"`
+
+	// BugFixes specifies the number of Non-Breaking Changes which don't add new functionality.
+	BugFixes int `json:"bugFixes"`
+}
+
+type releaseNotesPackageOutput struct {
+	// Path specifies the path to the Go Package within the Go SDK (e.g. `resource-manager/compute/2022-01-01/virtualmachines`).
+	Path string `json:"path"`
+
+	// ServiceName specifies the name of the Service which this Go Package is generated from.
+	ServiceName string `json:"serviceName"`
+
+	// ApiVersion specifies the API Version which this Go Package is generated from, if applicable.
+	ApiVersion string `json:"apiVersion,omitempty"`
+
+	// ResourceName specifies the name of the API Resource which this Go Package is generated from, if applicable.
+	ResourceName string `json:"resourceName,omitempty"`
+
+	// BreakingChanges is a list of the Breaking Changes to this Go Package.
+	BreakingChanges []changeOutput `json:"breakingChanges"`
+
+	// Features is a list of the Non-Breaking Changes to this Go Package which add new functionality.
+	Features []changeOutput `json:"features"`
+
+	// BugFixes is a list of the remaining Non-Breaking Changes to this Go Package.
+	BugFixes []changeOutput `json:"bugFixes"`
+}
+
+// impactReportOutput is the JSON output for the ImpactReportView.
+type impactReportOutput struct {
+	// SchemaVersion specifies the version of this schema, see JSONSchemaVersion.
+	SchemaVersion int `json:"schemaVersion"`
+
+	// Summary specifies the number of Go Packages, Terraform Resources and Documentation pages which are affected.
+	Summary impactReportSummary `json:"summary"`
+
+	// GoSdkPackages is a list of the affected Go Packages, sorted by the path to the Package.
+	GoSdkPackages []impactReportPackageOutput `json:"goSdkPackages"`
+
+	// TerraformResources is a list of the affected Terraform Resources, sorted by the Service and Resource Label.
+	TerraformResources []impactReportTerraformResourceOutput `json:"terraformResources"`
+}
+
+type impactReportSummary struct {
+	// GoSdkPackages specifies the number of Go Packages which will be added, regenerated and deleted.
+	GoSdkPackages impactReportCounts `json:"goSdkPackages"`
+
+	// TerraformResources specifies the number of Terraform Resources which will be added, regenerated and deleted.
+	TerraformResources impactReportCounts `json:"terraformResources"`
+
+	// DocumentationPages specifies the number of Documentation pages for Terraform Resources which are affected.
+	DocumentationPages int `json:"documentationPages"`
+}
+
+type impactReportCounts struct {
+	// Added specifies the number of items which will be generated for the first time.
+	Added int `json:"added"`
+
+	// Regenerated specifies the number of existing items which will be regenerated.
+	Regenerated int `json:"regenerated"`
+
+	// Deleted specifies the number of items which will no longer be generated.
+	Deleted int `json:"deleted"`
+}
+
+type impactReportPackageOutput struct {
+	// Path specifies the path to the Go Package within the Go SDK (e.g. `resource-manager/compute/2022-01-01/virtualmachines`).
+	Path string `json:"path"`
+
+	// ServiceName specifies the name of the Service which this Go Package is generated from.
+	ServiceName string `json:"serviceName"`
+
+	// ApiVersion specifies the API Version which this Go Package is generated from.
+	ApiVersion string `json:"apiVersion"`
+
+	// ResourceName specifies the name of the API Resource which this Go Package is generated from.
+	ResourceName string `json:"resourceName"`
+
+	// Impact specifies how this Go Package is affected.
+	Impact Impact `json:"impact"`
+
+	// Files is a list of the affected files within this Go Package, sorted by the name of the file.
+	Files []impactReportFileOutput `json:"files"`
+}
+
+type impactReportTerraformResourceOutput struct {
+	// ServiceName specifies the name of the Service which contains this Terraform Resource.
+	ServiceName string `json:"serviceName"`
+
+	// ResourceLabel specifies the label of the Terraform Resource (e.g. `chaos_studio_target`).
+	ResourceLabel string `json:"resourceLabel"`
+
+	// Impact specifies how this Terraform Resource is affected.
+	Impact Impact `json:"impact"`
+
+	// TerraformDefinitionChanged specifies whether the Terraform Definition for this Terraform Resource has changed.
+	TerraformDefinitionChanged bool `json:"terraformDefinitionChanged"`
+
+	// GoSdkPackages is a list of the paths to the affected Go Packages which this Terraform Resource uses.
+	GoSdkPackages []string `json:"goSdkPackages"`
+
+	// Files is a list of the affected files within the Provider, sorted by the path to the file.
+	Files []impactReportFileOutput `json:"files"`
+}
+
+type impactReportFileOutput struct {
+	// Path specifies the path to the file, relative to the Go Package or the root of the Provider.
+	Path string `json:"path"`
+
+	// Impact specifies how this file is affected.
+	Impact Impact `json:"impact"`
+}
+
+// changeTypeName returns the name of the type of Change, which is used as the type discriminator in the JSON output.
+func changeTypeName(input changes.Change) string {
+	return reflect.TypeOf(input).Name()
+}
+
+func buildChangesOutput(breakingChanges []changes.Change, nonBreakingChanges []changes.Change) (*changesOutput, error) {
+	output := changesOutput{
+		SchemaVersion: JSONSchemaVersion,
+		Summary: changesSummary{
+			BreakingChanges:    len(breakingChanges),
+			NonBreakingChanges: len(nonBreakingChanges),
+		},
+		Changes: make([]changeOutput, 0),
+	}
+	changeOutputs, err := buildChangeOutputs(append(append([]changes.Change{}, breakingChanges...), nonBreakingChanges...))
+	if err != nil {
+		return nil, err
+	}
+	output.Changes = changeOutputs
+
+	return &output, nil
+}
+
+func buildChangeOutputs(input []changes.Change) ([]changeOutput, error) {
+	output := make([]changeOutput, 0)
+	for i, change := range input {
+		markdown, err := renderChangeToMarkdown(change)
+		if err != nil {
+			return nil, fmt.Errorf("rendering Change %d: %+v", i, err)
+		}
+
+		output = append(output, changeOutput{
+			Type:       changeTypeName(change),
+			IsBreaking: change.IsBreaking(),
+			Markdown:   *markdown,
+			Details:    change,
+		})
+	}
+	return output, nil
+}
+
+func marshalJSON(input interface{}) (*string, error) {
+	data, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("marshaling to JSON: %+v", err)
+	}
+
+	output := string(data)
+	return &output, nil
+}
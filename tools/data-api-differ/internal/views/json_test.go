@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+)
+
+func TestChangesView_JSON(t *testing.T) {
+	diff := []changes.Change{
+		changes.ServiceAdded{
+			ServiceName: "Network",
+		},
+		changes.FieldIsNowRequired{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "Example",
+			ModelName:    "Example",
+			FieldName:    "Name",
+		},
+	}
+	actual, err := NewChangesView(diff).RenderJSON()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	var output map[string]interface{}
+	if err := json.Unmarshal([]byte(*actual), &output); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	expected := map[string]interface{}{
+		"schemaVersion": float64(1),
+		"summary": map[string]interface{}{
+			"breakingChanges":    float64(1),
+			"nonBreakingChanges": float64(1),
+		},
+		"changes": []interface{}{
+			map[string]interface{}{
+				"type":       "FieldIsNowRequired",
+				"isBreaking": true,
+				"markdown":   "**Field Now Required:** `Name` in Model `Example` in `Compute@2022-01-01/Example`.",
+				"details": map[string]interface{}{
+					"serviceName":  "Compute",
+					"apiVersion":   "2022-01-01",
+					"resourceName": "Example",
+					"modelName":    "Example",
+					"fieldName":    "Name",
+				},
+			},
+			map[string]interface{}{
+				"type":       "ServiceAdded",
+				"isBreaking": false,
+				"markdown":   "**New Service:** `Network`.",
+				"details": map[string]interface{}{
+					"serviceName": "Network",
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, output) {
+		t.Fatalf("expected %+v but got %+v", expected, output)
+	}
+}
+
+func TestBreakingChangesView_JSON_NoBreakingChanges(t *testing.T) {
+	actual, err := NewBreakingChangesView([]changes.Change{changes.ServiceAdded{ServiceName: "Network"}}).RenderJSON()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	var output changesOutput
+	if err := json.Unmarshal([]byte(*actual), &output); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if output.SchemaVersion != JSONSchemaVersion || output.Summary.BreakingChanges != 0 || output.Summary.NonBreakingChanges != 0 {
+		t.Fatalf("unexpected output: %s", *actual)
+	}
+	if !strings.Contains(*actual, `"changes": []`) {
+		t.Fatalf("expected an empty list of changes but got: %s", *actual)
+	}
+}
+
+func TestResourceIdSegmentsView_JSON(t *testing.T) {
+	diff := []changes.Change{
+		changes.ResourceIdAdded{
+			StaticIdentifiersInNewValue: []string{"providers", "Microsoft.Compute"},
+		},
+	}
+	actual, err := NewResourceIdSegmentsView(diff).RenderJSON()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	var output resourceIdSegmentsOutput
+	if err := json.Unmarshal([]byte(*actual), &output); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	expected := resourceIdSegmentsOutput{
+		SchemaVersion:            JSONSchemaVersion,
+		StaticIdentifierSegments: []string{"Microsoft.Compute", "providers"},
+	}
+	if !reflect.DeepEqual(expected, output) {
+		t.Fatalf("expected %+v but got %+v", expected, output)
+	}
+}
+
+func TestJSONSchemaDocumentsEveryChange(t *testing.T) {
+	// each type of Change needs to be documented in `json.md`, since these are consumed by automated tooling
+	documentation, err := os.ReadFile("json.md")
+	if err != nil {
+		t.Fatalf("reading json.md: %+v", err)
+	}
+
+	files, err := filepath.Glob("../changes/*.go")
+	if err != nil {
+		t.Fatalf("listing changes: %+v", err)
+	}
+	r := regexp.MustCompile("var _ (?:CommonType)?Change = (\\w+){}")
+	found := 0
+	changeFiles := 0
+	for _, file := range files {
+		// `change.go` defines the interfaces, every other file defines a single Change
+		if filepath.Base(file) == "change.go" {
+			continue
+		}
+		changeFiles++
+
+		contents, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf("reading %q: %+v", file, err)
+		}
+		for _, match := range r.FindAllStringSubmatch(string(contents), -1) {
+			found++
+			if !strings.Contains(string(documentation), "### `"+match[1]+"`") {
+				t.Errorf("the Change %q is not documented in json.md", match[1])
+			}
+		}
+	}
+	if found == 0 || found != changeFiles {
+		t.Fatalf("expected to find a Change in each of the %d files but found %d", changeFiles, found)
+	}
+}
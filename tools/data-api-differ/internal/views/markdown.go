@@ -30,6 +30,12 @@ func renderChangeToMarkdown(input changes.Change) (*string, error) {
 			line := fmt.Sprintf("**Removed Service:** `%s`.", v.ServiceName)
 			return trimSpaceAround(line)
 		}
+	case changes.ServiceTerraformPackageNameChanged:
+		{
+			v := input.(changes.ServiceTerraformPackageNameChanged)
+			line := fmt.Sprintf("**Terraform Package Name Changed:** for Service `%s` (was `%s` now `%s`).", v.ServiceName, v.OldValue, v.NewValue)
+			return trimSpaceAround(line)
+		}
 
 		// API Versions
 	case changes.ApiVersionAdded:
@@ -64,38 +70,38 @@ func renderChangeToMarkdown(input changes.Change) (*string, error) {
 		{
 			v := input.(changes.ConstantAdded)
 			keysAndValues := strings.Join(sortConstantKeysAndValues(v.KeysAndValues), ", ")
-			line := fmt.Sprintf("**New Constant:** `%s` (Type `%s`) in `%s@%s/%s`. Possible Values: %s.", v.ConstantName, v.ConstantType, v.ServiceName, v.ApiVersion, v.ResourceName, keysAndValues)
+			line := fmt.Sprintf("**New Constant:** `%s` (Type `%s`) in %s. Possible Values: %s.", v.ConstantName, v.ConstantType, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices), keysAndValues)
 			return trimSpaceAround(line)
 		}
 	case changes.ConstantKeyValueAdded:
 		{
 			v := input.(changes.ConstantKeyValueAdded)
-			line := fmt.Sprintf("**New Key/Value for Constant:** `%s` - Key `%s` / Value `%s` in `%s@%s/%s`.", v.ConstantName, v.ConstantKey, v.ConstantValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**New Key/Value for Constant:** `%s` - Key `%s` / Value `%s` in %s.", v.ConstantName, v.ConstantKey, v.ConstantValue, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ConstantKeyValueChanged:
 		{
 			v := input.(changes.ConstantKeyValueChanged)
-			line := fmt.Sprintf("**Updated Value for Constant Key:** Constant `%s` Key `%s` - Old Value `%s` / New Value `%s` in `%s@%s/%s`.", v.ConstantName, v.ConstantKey, v.OldConstantValue, v.NewConstantValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Updated Value for Constant Key:** Constant `%s` Key `%s` - Old Value `%s` / New Value `%s` in %s.", v.ConstantName, v.ConstantKey, v.OldConstantValue, v.NewConstantValue, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ConstantKeyValueRemoved:
 		{
 			v := input.(changes.ConstantKeyValueRemoved)
-			line := fmt.Sprintf("**Removed Key/Value for Constant:** `%s` - Key `%s` / Value `%s` in `%s@%s/%s`.", v.ConstantName, v.ConstantKey, v.ConstantValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Removed Key/Value for Constant:** `%s` - Key `%s` / Value `%s` in %s.", v.ConstantName, v.ConstantKey, v.ConstantValue, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ConstantRemoved:
 		{
 			// intentionally not outputting the old values for now, but they're on the object if this is useful
 			v := input.(changes.ConstantRemoved)
-			line := fmt.Sprintf("**Removed Constant:** `%s` (Type `%s`) in `%s@%s/%s`.", v.ConstantName, v.ConstantType, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Removed Constant:** `%s` (Type `%s`) in %s.", v.ConstantName, v.ConstantType, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ConstantTypeChanged:
 		{
 			v := input.(changes.ConstantTypeChanged)
-			line := fmt.Sprintf("**Updated Type for Constant:** `%s` - Old Type `%s` / New Type `%s` in `%s@%s/%s`.", v.ConstantName, v.OldType, v.NewType, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Updated Type for Constant:** `%s` - Old Type `%s` / New Type `%s` in %s.", v.ConstantName, v.OldType, v.NewType, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 
@@ -103,38 +109,74 @@ func renderChangeToMarkdown(input changes.Change) (*string, error) {
 	case changes.FieldAdded:
 		{
 			v := input.(changes.FieldAdded)
-			line := fmt.Sprintf("**Field Added:** `%s` to Model `%s` in `%s@%s/%s`.", v.FieldName, v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Field Added:** `%s` to Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 
+	case changes.FieldDateFormatChanged:
+		{
+			v := input.(changes.FieldDateFormatChanged)
+			line := fmt.Sprintf("**Field DateFormat Changed:** `%s` (was `%s` now `%s`) in Model `%s` in %s.", v.FieldName, v.OldValue, v.NewValue, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
+			return trimSpaceAround(line)
+		}
+	case changes.FieldDescriptionChanged:
+		{
+			v := input.(changes.FieldDescriptionChanged)
+			line := fmt.Sprintf("**Field Description Changed:** `%s` in Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
+			return trimSpaceAround(line)
+		}
+	case changes.FieldIsNoLongerReadOnly:
+		{
+			v := input.(changes.FieldIsNoLongerReadOnly)
+			line := fmt.Sprintf("**Field No Longer ReadOnly:** `%s` in Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
+			return trimSpaceAround(line)
+		}
+	case changes.FieldIsNoLongerSensitive:
+		{
+			v := input.(changes.FieldIsNoLongerSensitive)
+			line := fmt.Sprintf("**Field No Longer Sensitive:** `%s` in Model `%s` in %s - the value for this Field will no longer be hidden.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
+			return trimSpaceAround(line)
+		}
 	case changes.FieldIsNowOptional:
 		{
 			v := input.(changes.FieldIsNowOptional)
-			line := fmt.Sprintf("**Field Now Optional:** `%s` in Model `%s` in `%s@%s/%s`.", v.FieldName, v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Field Now Optional:** `%s` in Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
+			return trimSpaceAround(line)
+		}
+	case changes.FieldIsNowReadOnly:
+		{
+			v := input.(changes.FieldIsNowReadOnly)
+			line := fmt.Sprintf("**Field Now ReadOnly:** `%s` in Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.FieldIsNowRequired:
 		{
 			v := input.(changes.FieldIsNowRequired)
-			line := fmt.Sprintf("**Field Now Required:** `%s` in Model `%s` in `%s@%s/%s`.", v.FieldName, v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Field Now Required:** `%s` in Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
+			return trimSpaceAround(line)
+		}
+	case changes.FieldIsNowSensitive:
+		{
+			v := input.(changes.FieldIsNowSensitive)
+			line := fmt.Sprintf("**Field Now Sensitive:** `%s` in Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.FieldJsonNameChanged:
 		{
 			v := input.(changes.FieldJsonNameChanged)
-			line := fmt.Sprintf("**Field JsonName Changed:** `%s` (was `%s` now `%s`) in Model `%s` in `%s@%s/%s`.", v.FieldName, v.OldValue, v.NewValue, v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Field JsonName Changed:** `%s` (was `%s` now `%s`) in Model `%s` in %s.", v.FieldName, v.OldValue, v.NewValue, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.FieldObjectDefinitionChanged:
 		{
 			v := input.(changes.FieldObjectDefinitionChanged)
-			line := fmt.Sprintf("**Field Object Definition Changed:** `%s` (was `%s` now `%s`) in Model `%s` in `%s@%s/%s`.", v.FieldName, v.OldValue, v.NewValue, v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Field Object Definition Changed:** `%s` (was `%s` now `%s`) in Model `%s` in %s.", v.FieldName, v.OldValue, v.NewValue, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.FieldRemoved:
 		{
 			v := input.(changes.FieldRemoved)
-			line := fmt.Sprintf("**Field Removed:** `%s` from Model `%s` in `%s@%s/%s`.", v.FieldName, v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Field Removed:** `%s` from Model `%s` in %s.", v.FieldName, v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 
@@ -142,43 +184,43 @@ func renderChangeToMarkdown(input changes.Change) (*string, error) {
 	case changes.ModelAdded:
 		{
 			v := input.(changes.ModelAdded)
-			line := fmt.Sprintf("**Model Added:** `%s` in `%s@%s/%s`.", v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Model Added:** `%s` in %s.", v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ModelDiscriminatedParentTypeAdded:
 		{
 			v := input.(changes.ModelDiscriminatedParentTypeAdded)
-			line := fmt.Sprintf("**Parent Type was Added to Model:** `%s` (now `%s`) in `%s@%s/%s`.", v.ModelName, v.NewParentModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Parent Type was Added to Model:** `%s` (now `%s`) in %s.", v.ModelName, v.NewParentModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ModelDiscriminatedParentTypeChanged:
 		{
 			v := input.(changes.ModelDiscriminatedParentTypeChanged)
-			line := fmt.Sprintf("**Parent Type was Changed for Model:** `%s` (was `%s` now `%s`) in `%s@%s/%s`.", v.ModelName, v.OldParentModelName, v.NewParentModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Parent Type was Changed for Model:** `%s` (was `%s` now `%s`) in %s.", v.ModelName, v.OldParentModelName, v.NewParentModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ModelDiscriminatedParentTypeRemoved:
 		{
 			v := input.(changes.ModelDiscriminatedParentTypeRemoved)
-			line := fmt.Sprintf("**Parent Type was Removed for Model:** `%s` (was `%s`) in `%s@%s/%s`.", v.ModelName, v.OldParentModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Parent Type was Removed for Model:** `%s` (was `%s`) in %s.", v.ModelName, v.OldParentModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ModelDiscriminatedTypeHintInChanged:
 		{
 			v := input.(changes.ModelDiscriminatedTypeHintInChanged)
-			line := fmt.Sprintf("**Model has an updated value for Discriminated TypeHintIn:** `%s` (was `%s` now `%s`) in `%s@%s/%s`.", v.ModelName, v.OldValue, v.NewValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Model has an updated value for Discriminated TypeHintIn:** `%s` (was `%s` now `%s`) in %s.", v.ModelName, v.OldValue, v.NewValue, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ModelDiscriminatedTypeValueChanged:
 		{
 			v := input.(changes.ModelDiscriminatedTypeValueChanged)
-			line := fmt.Sprintf("**Model has an updated value for Discriminated Type Value:** `%s` (was `%s` now `%s`) in `%s@%s/%s`.", v.ModelName, v.OldValue, v.NewValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Model has an updated value for Discriminated Type Value:** `%s` (was `%s` now `%s`) in %s.", v.ModelName, v.OldValue, v.NewValue, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 	case changes.ModelRemoved:
 		{
 			v := input.(changes.ModelRemoved)
-			line := fmt.Sprintf("**Model Removed:** `%s` in `%s@%s/%s`.", v.ModelName, v.ServiceName, v.ApiVersion, v.ResourceName)
+			line := fmt.Sprintf("**Model Removed:** `%s` in %s.", v.ModelName, apiResourceLocation(v.ServiceName, v.ApiVersion, v.ResourceName, v.ReferencedByServices))
 			return trimSpaceAround(line)
 		}
 
@@ -219,6 +261,36 @@ func renderChangeToMarkdown(input changes.Change) (*string, error) {
 			line := fmt.Sprintf("**Operation uses a different HTTP Method:** `%s` (was `%s` now `%s`) in `%s@%s/%s`.", v.OperationName, v.OldValue, v.NewValue, v.ServiceName, v.ApiVersion, v.ResourceName)
 			return trimSpaceAround(line)
 		}
+	case changes.OperationOptionHeaderNameChanged:
+		{
+			v := input.(changes.OperationOptionHeaderNameChanged)
+			line := fmt.Sprintf("**Operation Option Header Name Changed:** `%s` in Operation `%s` (was `%s` now `%s`) in `%s@%s/%s`.", v.OptionName, v.OperationName, v.OldValue, v.NewValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			return trimSpaceAround(line)
+		}
+	case changes.OperationOptionQueryStringNameChanged:
+		{
+			v := input.(changes.OperationOptionQueryStringNameChanged)
+			line := fmt.Sprintf("**Operation Option QueryString Name Changed:** `%s` in Operation `%s` (was `%s` now `%s`) in `%s@%s/%s`.", v.OptionName, v.OperationName, v.OldValue, v.NewValue, v.ServiceName, v.ApiVersion, v.ResourceName)
+			return trimSpaceAround(line)
+		}
+	case changes.OperationOptionsAdded:
+		{
+			v := input.(changes.OperationOptionsAdded)
+			line := fmt.Sprintf("**Operation Options Added:** `%s` in `%s@%s/%s`. New Options: %s.", v.OperationName, v.ServiceName, v.ApiVersion, v.ResourceName, strings.Join(sortConstantKeysAndValues(v.NewValue), ", "))
+			return trimSpaceAround(line)
+		}
+	case changes.OperationOptionsChanged:
+		{
+			v := input.(changes.OperationOptionsChanged)
+			line := fmt.Sprintf("**Operation Options Changed:** `%s` in `%s@%s/%s`. Old Options: %s / New Options: %s.", v.OperationName, v.ServiceName, v.ApiVersion, v.ResourceName, strings.Join(sortConstantKeysAndValues(v.OldValue), ", "), strings.Join(sortConstantKeysAndValues(v.NewValue), ", "))
+			return trimSpaceAround(line)
+		}
+	case changes.OperationOptionsRemoved:
+		{
+			v := input.(changes.OperationOptionsRemoved)
+			line := fmt.Sprintf("**Operation Options Removed:** `%s` in `%s@%s/%s`. Old Options: %s.", v.OperationName, v.ServiceName, v.ApiVersion, v.ResourceName, strings.Join(sortConstantKeysAndValues(v.OldValue), ", "))
+			return trimSpaceAround(line)
+		}
 	case changes.OperationPaginationFieldChanged:
 		{
 			v := input.(changes.OperationPaginationFieldChanged)
@@ -359,6 +431,158 @@ func renderChangeToMarkdown(input changes.Change) (*string, error) {
 			line := fmt.Sprintf("**Resource ID Segments Changed:** `%s` (was `%+v` now `%+v`) in `%s@%s/%s`.", v.ResourceIdName, v.OldValue, v.NewValue, v.ServiceName, v.ApiVersion, v.ResourceName)
 			return trimSpaceAround(line)
 		}
+
+	// Terraform Resources
+	case changes.TerraformResourceAdded:
+		{
+			v := input.(changes.TerraformResourceAdded)
+			line := fmt.Sprintf("**New Terraform Resource:** `%s` in `%s`.", v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformResourceApiVersionChanged:
+		{
+			v := input.(changes.TerraformResourceApiVersionChanged)
+			line := fmt.Sprintf("**Terraform Resource API Version Changed:** (was `%s` now `%s`) in Terraform Resource `%s` in `%s`.", v.OldValue, v.NewValue, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformResourceRemoved:
+		{
+			v := input.(changes.TerraformResourceRemoved)
+			line := fmt.Sprintf("**Removed Terraform Resource:** `%s` in `%s`.", v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformResourceResourceIdNameChanged:
+		{
+			v := input.(changes.TerraformResourceResourceIdNameChanged)
+			line := fmt.Sprintf("**Terraform Resource ID Changed:** (was `%s` now `%s`) in Terraform Resource `%s` in `%s`.", v.OldValue, v.NewValue, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformResourceUpdateMethodAdded:
+		{
+			v := input.(changes.TerraformResourceUpdateMethodAdded)
+			line := fmt.Sprintf("**Terraform Resource Can Now Be Updated:** using `%s` in Terraform Resource `%s` in `%s`.", v.SDKOperationName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformResourceUpdateMethodRemoved:
+		{
+			v := input.(changes.TerraformResourceUpdateMethodRemoved)
+			line := fmt.Sprintf("**Terraform Resource Can No Longer Be Updated:** (was using `%s`) in Terraform Resource `%s` in `%s`.", v.SDKOperationName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+
+	// Terraform Schema Models
+	case changes.TerraformSchemaModelAdded:
+		{
+			v := input.(changes.TerraformSchemaModelAdded)
+			line := fmt.Sprintf("**Terraform Schema Model Added:** `%s` in Terraform Resource `%s` in `%s`.", v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaModelRemoved:
+		{
+			v := input.(changes.TerraformSchemaModelRemoved)
+			line := fmt.Sprintf("**Terraform Schema Model Removed:** `%s` in Terraform Resource `%s` in `%s`.", v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+
+	// Terraform Schema Fields
+	case changes.TerraformSchemaFieldAdded:
+		{
+			v := input.(changes.TerraformSchemaFieldAdded)
+			requiredOrOptional := "Optional"
+			if v.Required {
+				requiredOrOptional = "Required"
+			}
+			line := fmt.Sprintf("**Terraform Schema Field Added:** `%s` (HCL Name `%s`, %s) to Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.HclName, requiredOrOptional, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldComputedAdded:
+		{
+			v := input.(changes.TerraformSchemaFieldComputedAdded)
+			line := fmt.Sprintf("**Terraform Schema Field Now Computed:** `%s` in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldComputedRemoved:
+		{
+			v := input.(changes.TerraformSchemaFieldComputedRemoved)
+			line := fmt.Sprintf("**Terraform Schema Field No Longer Computed:** `%s` in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldForceNewAdded:
+		{
+			v := input.(changes.TerraformSchemaFieldForceNewAdded)
+			line := fmt.Sprintf("**Terraform Schema Field Now ForceNew:** `%s` in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldForceNewRemoved:
+		{
+			v := input.(changes.TerraformSchemaFieldForceNewRemoved)
+			line := fmt.Sprintf("**Terraform Schema Field No Longer ForceNew:** `%s` in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldHclNameChanged:
+		{
+			v := input.(changes.TerraformSchemaFieldHclNameChanged)
+			line := fmt.Sprintf("**Terraform Schema Field HCL Name Changed:** `%s` (was `%s` now `%s`) in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.OldValue, v.NewValue, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldIsNowOptional:
+		{
+			v := input.(changes.TerraformSchemaFieldIsNowOptional)
+			line := fmt.Sprintf("**Terraform Schema Field Now Optional:** `%s` in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldIsNowRequired:
+		{
+			v := input.(changes.TerraformSchemaFieldIsNowRequired)
+			line := fmt.Sprintf("**Terraform Schema Field Now Required:** `%s` in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldObjectDefinitionChanged:
+		{
+			v := input.(changes.TerraformSchemaFieldObjectDefinitionChanged)
+			line := fmt.Sprintf("**Terraform Schema Field Object Definition Changed:** `%s` (was `%s` now `%s`) in Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.OldValue, v.NewValue, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformSchemaFieldRemoved:
+		{
+			v := input.(changes.TerraformSchemaFieldRemoved)
+			line := fmt.Sprintf("**Terraform Schema Field Removed:** `%s` (HCL Name `%s`) from Schema Model `%s` in Terraform Resource `%s` in `%s`.", v.FieldName, v.HclName, v.SchemaModelName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+
+	// Terraform Mappings
+	case changes.TerraformMappingAdded:
+		{
+			v := input.(changes.TerraformMappingAdded)
+			line := fmt.Sprintf("**Terraform Mapping Added:** %s Mapping `%s` in Terraform Resource `%s` in `%s`.", v.MappingType, v.Mapping, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformMappingRemoved:
+		{
+			v := input.(changes.TerraformMappingRemoved)
+			line := fmt.Sprintf("**Terraform Mapping Removed:** %s Mapping `%s` in Terraform Resource `%s` in `%s`.", v.MappingType, v.Mapping, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+
+	// Terraform Tests
+	case changes.TerraformTestAdded:
+		{
+			v := input.(changes.TerraformTestAdded)
+			line := fmt.Sprintf("**Terraform Test Added:** `%s` in Terraform Resource `%s` in `%s`.", v.TestName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformTestChanged:
+		{
+			v := input.(changes.TerraformTestChanged)
+			line := fmt.Sprintf("**Terraform Test Changed:** `%s` in Terraform Resource `%s` in `%s`.", v.TestName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
+	case changes.TerraformTestRemoved:
+		{
+			v := input.(changes.TerraformTestRemoved)
+			line := fmt.Sprintf("**Terraform Test Removed:** `%s` in Terraform Resource `%s` in `%s`.", v.TestName, v.ResourceLabel, v.ServiceName)
+			return trimSpaceAround(line)
+		}
 	}
 
 	return nil, fmt.Errorf("internal-error: unimplemented change type %q", reflect.TypeOf(input).Name())
@@ -379,3 +603,20 @@ func trimSpaceAround(input string) (*string, error) {
 	output = strings.TrimSpace(output)
 	return pointer.To(output), nil
 }
+
+// apiResourceLocation returns the location of an item within an API Resource (e.g. `Compute@2020-01-01/VirtualMachines`)
+// in Markdown - or when the item is a Common Type, the Services referencing it.
+func apiResourceLocation(serviceName, apiVersion, resourceName string, referencedByServices []string) string {
+	if serviceName != changes.CommonTypesServiceName {
+		return fmt.Sprintf("`%s@%s/%s`", serviceName, apiVersion, resourceName)
+	}
+
+	if len(referencedByServices) == 0 {
+		return "Common Types (not referenced by any Services)"
+	}
+	services := make([]string, 0)
+	for _, service := range referencedByServices {
+		services = append(services, fmt.Sprintf("`%s`", service))
+	}
+	return fmt.Sprintf("Common Types (referenced by %s)", strings.Join(services, ", "))
+}
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ View = ReleaseNotesView{}
+
+// VersionBump specifies the Semantic Version component which should be incremented when releasing a set of Changes.
+type VersionBump string
+
+const (
+	// MajorVersionBump is recommended when any Breaking Changes are present.
+	MajorVersionBump VersionBump = "major"
+
+	// MinorVersionBump is recommended when new functionality has been added, but no Breaking Changes are present.
+	MinorVersionBump VersionBump = "minor"
+
+	// PatchVersionBump is recommended when only Bug Fixes are present.
+	PatchVersionBump VersionBump = "patch"
+
+	// NoVersionBump is used when there are no Changes to the Go SDK, and as such no release is needed.
+	NoVersionBump VersionBump = "none"
+)
+
+// ReleaseNotesView renders the Release Notes for the Go SDK (`hashicorp/go-azure-sdk`), grouping the Changes by
+// the generated Go Package (that is, the Service, API Version and API Resource) they affect.
+type ReleaseNotesView struct {
+	// packages is a list of the Go Packages containing Changes, sorted by the path to the Package.
+	packages []releaseNotesPackage
+
+	// recommendedVersionBump specifies the Semantic Version component which should be incremented for this release.
+	recommendedVersionBump VersionBump
+}
+
+// releaseNotesPackage contains the Changes to a single Go Package.
+type releaseNotesPackage struct {
+	// path is the path to the Go Package within the Go SDK (e.g. `resource-manager/compute/2022-01-01/virtualmachines`)
+	// or `Common Types` for Changes to the Common Types.
+	path string
+
+	// serviceName, apiVersion and resourceName specify the API Resource which this Go Package is generated from.
+	// apiVersion and resourceName are empty when the Change applies to the entire Service/API Version.
+	serviceName  string
+	apiVersion   string
+	resourceName string
+
+	// breakingChanges, features and bugFixes contain the Changes to this Go Package, grouped by their category.
+	breakingChanges []changes.Change
+	features        []changes.Change
+	bugFixes        []changes.Change
+}
+
+// NewReleaseNotesView returns a ReleaseNotesView for the Changes within input. Changes to the Terraform Definitions
+// are ignored, since these don't affect the Go SDK.
+func NewReleaseNotesView(input []changes.Change, sourceDataType models.SourceDataType) ReleaseNotesView {
+	packages := make(map[string]*releaseNotesPackage)
+	for _, change := range input {
+		if isTerraformChange(change) {
+			continue
+		}
+
+		identifiers := change.Identifiers()
+		path := goSdkPackagePath(sourceDataType, identifiers.ServiceName, identifiers.ApiVersion, identifiers.ResourceName)
+		pkg, ok := packages[path]
+		if !ok {
+			pkg = &releaseNotesPackage{
+				path:            path,
+				serviceName:     identifiers.ServiceName,
+				apiVersion:      identifiers.ApiVersion,
+				resourceName:    identifiers.ResourceName,
+				breakingChanges: make([]changes.Change, 0),
+				features:        make([]changes.Change, 0),
+				bugFixes:        make([]changes.Change, 0),
+			}
+			packages[path] = pkg
+		}
+
+		switch {
+		case change.IsBreaking():
+			pkg.breakingChanges = append(pkg.breakingChanges, change)
+		case isFeature(change):
+			pkg.features = append(pkg.features, change)
+		default:
+			pkg.bugFixes = append(pkg.bugFixes, change)
+		}
+	}
+
+	paths := make([]string, 0)
+	for path := range packages {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	output := ReleaseNotesView{
+		packages:               make([]releaseNotesPackage, 0),
+		recommendedVersionBump: NoVersionBump,
+	}
+	for _, path := range paths {
+		output.packages = append(output.packages, *packages[path])
+	}
+	output.recommendedVersionBump = output.determineRecommendedVersionBump()
+	return output
+}
+
+// RenderMarkdown renders the Release Notes View using Markdown, in the style of a CHANGELOG entry.
+func (v ReleaseNotesView) RenderMarkdown() (*string, error) {
+	if v.recommendedVersionBump == NoVersionBump {
+		output := `
+## Release Notes
+
+No changes to the Go SDK were detected - as such no release is needed 👍
+`
+		return trimSpaceAround(output)
+	}
+
+	sections := []string{
+		fmt.Sprintf(`
+## Release Notes
+
+Recommended Version Bump: **%s** (%d Breaking Changes, %d Features and %d Bug Fixes were detected).
+`, strings.Title(string(v.recommendedVersionBump)), v.countChanges(breakingChangesOf), v.countChanges(featuresOf), v.countChanges(bugFixesOf)),
+	}
+
+	categories := []struct {
+		title   string
+		changes func(releaseNotesPackage) []changes.Change
+	}{
+		{title: "Breaking Changes", changes: breakingChangesOf},
+		{title: "Features", changes: featuresOf},
+		{title: "Bug Fixes", changes: bugFixesOf},
+	}
+	for _, category := range categories {
+		lines := make([]string, 0)
+		for _, pkg := range v.packages {
+			items := category.changes(pkg)
+			if len(items) == 0 {
+				continue
+			}
+
+			lines = append(lines, fmt.Sprintf("* `%s`:", pkg.path))
+			for i, change := range items {
+				log.Logger.Trace(fmt.Sprintf("Rendering %s %d for %q", category.title, i, pkg.path))
+				markdown, err := renderChangeToMarkdown(change)
+				if err != nil {
+					return nil, fmt.Errorf("rendering %s %d for %q: %+v", category.title, i, pkg.path, err)
+				}
+				lines = append(lines, fmt.Sprintf("  * %s", *markdown))
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+
+		sections = append(sections, fmt.Sprintf(`
+### %s
+
+%s
+`, category.title, strings.Join(lines, "\n")))
+	}
+
+	output := strings.Join(sections, "")
+	return trimSpaceAround(output)
+}
+
+// RenderJSON renders the Release Notes View as JSON, intended to be consumed by automated tooling.
+func (v ReleaseNotesView) RenderJSON() (*string, error) {
+	output := releaseNotesOutput{
+		SchemaVersion:          JSONSchemaVersion,
+		RecommendedVersionBump: v.recommendedVersionBump,
+		Summary: releaseNotesSummary{
+			BreakingChanges: v.countChanges(breakingChangesOf),
+			Features:        v.countChanges(featuresOf),
+			BugFixes:        v.countChanges(bugFixesOf),
+		},
+		Packages: make([]releaseNotesPackageOutput, 0),
+	}
+	for _, pkg := range v.packages {
+		breakingChanges, err := buildChangeOutputs(pkg.breakingChanges)
+		if err != nil {
+			return nil, fmt.Errorf("building the Breaking Changes for %q: %+v", pkg.path, err)
+		}
+		features, err := buildChangeOutputs(pkg.features)
+		if err != nil {
+			return nil, fmt.Errorf("building the Features for %q: %+v", pkg.path, err)
+		}
+		bugFixes, err := buildChangeOutputs(pkg.bugFixes)
+		if err != nil {
+			return nil, fmt.Errorf("building the Bug Fixes for %q: %+v", pkg.path, err)
+		}
+
+		output.Packages = append(output.Packages, releaseNotesPackageOutput{
+			Path:            pkg.path,
+			ServiceName:     pkg.serviceName,
+			ApiVersion:      pkg.apiVersion,
+			ResourceName:    pkg.resourceName,
+			BreakingChanges: breakingChanges,
+			Features:        features,
+			BugFixes:        bugFixes,
+		})
+	}
+
+	return marshalJSON(output)
+}
+
+// determineRecommendedVersionBump returns the Semantic Version component which should be incremented, based on
+// the most significant category of Change present.
+func (v ReleaseNotesView) determineRecommendedVersionBump() VersionBump {
+	if v.countChanges(breakingChangesOf) > 0 {
+		return MajorVersionBump
+	}
+	if v.countChanges(featuresOf) > 0 {
+		return MinorVersionBump
+	}
+	if v.countChanges(bugFixesOf) > 0 {
+		return PatchVersionBump
+	}
+	return NoVersionBump
+}
+
+func (v ReleaseNotesView) countChanges(category func(releaseNotesPackage) []changes.Change) int {
+	count := 0
+	for _, pkg := range v.packages {
+		count += len(category(pkg))
+	}
+	return count
+}
+
+func breakingChangesOf(input releaseNotesPackage) []changes.Change {
+	return input.breakingChanges
+}
+
+func featuresOf(input releaseNotesPackage) []changes.Change {
+	return input.features
+}
+
+func bugFixesOf(input releaseNotesPackage) []changes.Change {
+	return input.bugFixes
+}
+
+// goSdkPackagePath returns the path to the Go Package within the Go SDK which is generated from the specified
+// Service, API Version and API Resource - which mirrors the directory structure used by `generator-go-sdk`.
+func goSdkPackagePath(sourceDataType models.SourceDataType, serviceName, apiVersion, resourceName string) string {
+	if serviceName == changes.CommonTypesServiceName {
+		return changes.CommonTypesServiceName
+	}
+
+	components := []string{
+		string(sourceDataType),
+		strings.ToLower(serviceName),
+	}
+	if apiVersion != "" {
+		components = append(components, strings.ToLower(apiVersion))
+	}
+	if resourceName != "" {
+		components = append(components, strings.ToLower(resourceName))
+	}
+	return strings.Join(components, "/")
+}
+
+// isFeature returns whether the (Non-Breaking) Change adds new functionality to the Go SDK - any other
+// Non-Breaking Change is considered a Bug Fix.
+func isFeature(input changes.Change) bool {
+	return input.Category() == changes.SdkFeatureCategory
+}
+
+// isTerraformChange returns whether the Change is to the Terraform Definitions, which don't affect the Go SDK.
+func isTerraformChange(input changes.Change) bool {
+	return input.Category() == changes.TerraformCategory
+}
@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/changes"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/testhelpers"
+)
+
+func TestReleaseNotesView_Markdown_NoChanges(t *testing.T) {
+	diff := []changes.Change{
+		// Changes to the Terraform Definitions should be filtered out
+		changes.TerraformResourceAdded{
+			ServiceName:   "Compute",
+			ResourceLabel: "virtual_machine",
+		},
+	}
+	actual, err := NewReleaseNotesView(diff, models.ResourceManagerSourceDataType).RenderMarkdown()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := `
+## Release Notes
+
+No changes to the Go SDK were detected - as such no release is needed 👍
+`
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestReleaseNotesView_Markdown_WithChanges(t *testing.T) {
+	diff := []changes.Change{
+		changes.FieldIsNowRequired{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachine",
+			FieldName:    "Name",
+		},
+		changes.ModelAdded{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachineProperties",
+		},
+		changes.ApiVersionAdded{
+			ServiceName: "Compute",
+			ApiVersion:  "2023-01-01",
+		},
+		changes.ServiceAdded{
+			ServiceName: "Network",
+		},
+		changes.TerraformResourceRemoved{
+			ServiceName:   "Compute",
+			ResourceLabel: "virtual_machine",
+		},
+	}
+	actual, err := NewReleaseNotesView(diff, models.ResourceManagerSourceDataType).RenderMarkdown()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expected := strings.ReplaceAll(`
+## Release Notes
+
+Recommended Version Bump: **Major** (1 Breaking Changes, 3 Features and 0 Bug Fixes were detected).
+
+### Breaking Changes
+
+* 'resource-manager/compute/2022-01-01/virtualmachines':
+  * **Field Now Required:** 'Name' in Model 'VirtualMachine' in 'Compute@2022-01-01/VirtualMachines'.
+
+### Features
+
+* 'resource-manager/compute/2022-01-01/virtualmachines':
+  * **Model Added:** 'VirtualMachineProperties' in 'Compute@2022-01-01/VirtualMachines'.
+* 'resource-manager/compute/2023-01-01':
+  * **New API Version:** '2023-01-01' in 'Compute'.
+* 'resource-manager/network':
+  * **New Service:** 'Network'.
+`, "'", "`")
+	testhelpers.AssertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestReleaseNotesView_RecommendedVersionBump(t *testing.T) {
+	testData := []struct {
+		input    []changes.Change
+		expected VersionBump
+	}{
+		{
+			input:    []changes.Change{},
+			expected: NoVersionBump,
+		},
+		{
+			input: []changes.Change{
+				changes.TerraformSchemaFieldIsNowOptional{ServiceName: "Compute", ResourceLabel: "virtual_machine"},
+			},
+			expected: NoVersionBump,
+		},
+		{
+			input: []changes.Change{
+				changes.ModelAdded{ServiceName: "Compute", ApiVersion: "2022-01-01", ResourceName: "VirtualMachines"},
+			},
+			expected: MinorVersionBump,
+		},
+		{
+			input: []changes.Change{
+				changes.ModelAdded{ServiceName: "Compute", ApiVersion: "2022-01-01", ResourceName: "VirtualMachines"},
+				changes.ModelRemoved{ServiceName: changes.CommonTypesServiceName},
+			},
+			expected: MajorVersionBump,
+		},
+	}
+	for i, v := range testData {
+		t.Logf("[DEBUG] Test %d", i)
+
+		actual, err := NewReleaseNotesView(v.input, models.ResourceManagerSourceDataType).RenderJSON()
+		if err != nil {
+			t.Fatalf(err.Error())
+		}
+
+		var output map[string]interface{}
+		if err := json.Unmarshal([]byte(*actual), &output); err != nil {
+			t.Fatalf("unmarshaling: %+v", err)
+		}
+		if output["schemaVersion"] != float64(JSONSchemaVersion) {
+			t.Fatalf("expected the schema version to be %d but got %+v", JSONSchemaVersion, output["schemaVersion"])
+		}
+		if output["recommendedVersionBump"] != string(v.expected) {
+			t.Fatalf("expected the recommended version bump to be %q but got %+v", string(v.expected), output["recommendedVersionBump"])
+		}
+	}
+}
+
+func TestReleaseNotesView_JSON(t *testing.T) {
+	diff := []changes.Change{
+		changes.ModelRemoved{
+			ServiceName: changes.CommonTypesServiceName,
+			ModelName:   "Sku",
+		},
+		changes.ModelAdded{
+			ServiceName:  "Compute",
+			ApiVersion:   "2022-01-01",
+			ResourceName: "VirtualMachines",
+			ModelName:    "VirtualMachine",
+		},
+	}
+	actual, err := NewReleaseNotesView(diff, models.MicrosoftGraphSourceDataType).RenderJSON()
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	// the `details` for each Change are omitted here, since these are covered by the tests for the Changes View
+	var output struct {
+		RecommendedVersionBump string              `json:"recommendedVersionBump"`
+		Summary                releaseNotesSummary `json:"summary"`
+		Packages               []struct {
+			Path            string `json:"path"`
+			ServiceName     string `json:"serviceName"`
+			ApiVersion      string `json:"apiVersion"`
+			ResourceName    string `json:"resourceName"`
+			BreakingChanges []struct {
+				Type string `json:"type"`
+			} `json:"breakingChanges"`
+			Features []struct {
+				Type string `json:"type"`
+			} `json:"features"`
+			BugFixes []struct {
+				Type string `json:"type"`
+			} `json:"bugFixes"`
+		} `json:"packages"`
+	}
+	if err := json.Unmarshal([]byte(*actual), &output); err != nil {
+		t.Fatalf("unmarshaling: %+v", err)
+	}
+	if output.RecommendedVersionBump != string(MajorVersionBump) {
+		t.Fatalf("expected the recommended version bump to be %q but got %q", string(MajorVersionBump), output.RecommendedVersionBump)
+	}
+	if output.Summary.BreakingChanges != 1 || output.Summary.Features != 1 || output.Summary.BugFixes != 0 {
+		t.Fatalf("unexpected summary: %+v", output.Summary)
+	}
+	if len(output.Packages) != 2 {
+		t.Fatalf("expected 2 Packages but got %d", len(output.Packages))
+	}
+	first := output.Packages[0]
+	if first.Path != changes.CommonTypesServiceName || len(first.BreakingChanges) != 1 || first.BreakingChanges[0].Type != "ModelRemoved" {
+		t.Fatalf("expected the first Package to be the Common Types containing a Breaking Change but got %+v", first)
+	}
+	second := output.Packages[1]
+	if second.Path != "microsoft-graph/compute/2022-01-01/virtualmachines" || second.ApiVersion != "2022-01-01" || second.ResourceName != "VirtualMachines" {
+		t.Fatalf("expected the second Package to be for `Compute@2022-01-01/VirtualMachines` but got %+v", second)
+	}
+	if len(second.Features) != 1 || second.Features[0].Type != "ModelAdded" || len(second.BreakingChanges) != 0 || len(second.BugFixes) != 0 {
+		t.Fatalf("expected the second Package to contain a single Feature but got %+v", second)
+	}
+}
+
+func TestReleaseNotesView_ClassifiesEveryChange(t *testing.T) {
+	testData := []struct {
+		change            changes.Change
+		expectedFeature   bool
+		expectedTerraform bool
+	}{
+		{changes.ApiResourceAdded{}, true, false},
+		{changes.ApiResourceRemoved{}, false, false},
+		{changes.ApiVersionAdded{}, true, false},
+		{changes.ApiVersionRemoved{}, false, false},
+		{changes.ConstantAdded{}, true, false},
+		{changes.ConstantKeyValueAdded{}, true, false},
+		{changes.ConstantKeyValueChanged{}, false, false},
+		{changes.ConstantKeyValueRemoved{}, false, false},
+		{changes.ConstantRemoved{}, false, false},
+		{changes.ConstantTypeChanged{}, false, false},
+		{changes.FieldAdded{}, true, false},
+		{changes.FieldDateFormatChanged{}, false, false},
+		{changes.FieldDescriptionChanged{}, false, false},
+		{changes.FieldIsNoLongerReadOnly{}, false, false},
+		{changes.FieldIsNoLongerSensitive{}, false, false},
+		{changes.FieldIsNowOptional{}, false, false},
+		{changes.FieldIsNowReadOnly{}, false, false},
+		{changes.FieldIsNowRequired{}, false, false},
+		{changes.FieldIsNowSensitive{}, false, false},
+		{changes.FieldJsonNameChanged{}, false, false},
+		{changes.FieldObjectDefinitionChanged{}, false, false},
+		{changes.FieldRemoved{}, false, false},
+		{changes.ModelAdded{}, true, false},
+		{changes.ModelDiscriminatedParentTypeAdded{}, true, false},
+		{changes.ModelDiscriminatedParentTypeChanged{}, false, false},
+		{changes.ModelDiscriminatedParentTypeRemoved{}, false, false},
+		{changes.ModelDiscriminatedTypeHintInChanged{}, false, false},
+		{changes.ModelDiscriminatedTypeValueChanged{}, false, false},
+		{changes.ModelRemoved{}, false, false},
+		{changes.OperationAdded{}, true, false},
+		{changes.OperationContentTypeChanged{}, false, false},
+		{changes.OperationExpectedStatusCodesChanged{}, false, false},
+		{changes.OperationLongRunningAdded{}, true, false},
+		{changes.OperationLongRunningRemoved{}, false, false},
+		{changes.OperationMethodChanged{}, false, false},
+		{changes.OperationOptionHeaderNameChanged{}, false, false},
+		{changes.OperationOptionQueryStringNameChanged{}, false, false},
+		{changes.OperationOptionsAdded{}, true, false},
+		{changes.OperationOptionsChanged{}, true, false},
+		{changes.OperationOptionsRemoved{}, false, false},
+		{changes.OperationPaginationFieldChanged{}, false, false},
+		{changes.OperationRemoved{}, false, false},
+		{changes.OperationRequestObjectAdded{}, true, false},
+		{changes.OperationRequestObjectChanged{}, false, false},
+		{changes.OperationRequestObjectRemoved{}, false, false},
+		{changes.OperationResourceIdAdded{}, true, false},
+		{changes.OperationResourceIdChanged{}, false, false},
+		{changes.OperationResourceIdRemoved{}, false, false},
+		{changes.OperationResourceIdRenamed{}, false, false},
+		{changes.OperationResponseObjectAdded{}, true, false},
+		{changes.OperationResponseObjectChanged{}, false, false},
+		{changes.OperationResponseObjectRemoved{}, false, false},
+		{changes.OperationUriSuffixAdded{}, true, false},
+		{changes.OperationUriSuffixChanged{}, false, false},
+		{changes.OperationUriSuffixRemoved{}, false, false},
+		{changes.ResourceIdAdded{}, true, false},
+		{changes.ResourceIdCommonIdAdded{}, true, false},
+		{changes.ResourceIdCommonIdChanged{}, false, false},
+		{changes.ResourceIdCommonIdRemoved{}, false, false},
+		{changes.ResourceIdRemoved{}, false, false},
+		{changes.ResourceIdSegmentChangedValue{}, false, false},
+		{changes.ResourceIdSegmentsChangedLength{}, false, false},
+		{changes.ServiceAdded{}, true, false},
+		{changes.ServiceRemoved{}, false, false},
+		{changes.ServiceTerraformPackageNameChanged{}, false, true},
+		{changes.TerraformMappingAdded{}, false, true},
+		{changes.TerraformMappingRemoved{}, false, true},
+		{changes.TerraformResourceAdded{}, false, true},
+		{changes.TerraformResourceApiVersionChanged{}, false, true},
+		{changes.TerraformResourceRemoved{}, false, true},
+		{changes.TerraformResourceResourceIdNameChanged{}, false, true},
+		{changes.TerraformResourceUpdateMethodAdded{}, false, true},
+		{changes.TerraformResourceUpdateMethodRemoved{}, false, true},
+		{changes.TerraformSchemaFieldAdded{}, false, true},
+		{changes.TerraformSchemaFieldComputedAdded{}, false, true},
+		{changes.TerraformSchemaFieldComputedRemoved{}, false, true},
+		{changes.TerraformSchemaFieldForceNewAdded{}, false, true},
+		{changes.TerraformSchemaFieldForceNewRemoved{}, false, true},
+		{changes.TerraformSchemaFieldHclNameChanged{}, false, true},
+		{changes.TerraformSchemaFieldIsNowOptional{}, false, true},
+		{changes.TerraformSchemaFieldIsNowRequired{}, false, true},
+		{changes.TerraformSchemaFieldObjectDefinitionChanged{}, false, true},
+		{changes.TerraformSchemaFieldRemoved{}, false, true},
+		{changes.TerraformSchemaModelAdded{}, false, true},
+		{changes.TerraformSchemaModelRemoved{}, false, true},
+		{changes.TerraformTestAdded{}, false, true},
+		{changes.TerraformTestChanged{}, false, true},
+		{changes.TerraformTestRemoved{}, false, true},
+	}
+
+	// every type of Change needs to be classified, so ensure each of the files in `../changes` is covered
+	files, err := filepath.Glob("../changes/*.go")
+	if err != nil {
+		t.Fatalf("listing changes: %+v", err)
+	}
+	changeFiles := 0
+	for _, file := range files {
+		// `change.go` defines the interfaces, every other file defines a single Change
+		if filepath.Base(file) != "change.go" {
+			changeFiles++
+		}
+	}
+	if len(testData) != changeFiles {
+		t.Fatalf("expected %d Changes to be tested but got %d", changeFiles, len(testData))
+	}
+
+	seen := make(map[string]struct{})
+	for _, v := range testData {
+		typeName := changeTypeName(v.change)
+		t.Logf("[DEBUG] Testing %q", typeName)
+		if _, ok := seen[typeName]; ok {
+			t.Fatalf("the Change %q is tested more than once", typeName)
+		}
+		seen[typeName] = struct{}{}
+
+		if actual := isFeature(v.change); actual != v.expectedFeature {
+			t.Errorf("expected isFeature to be %t for %q but got %t", v.expectedFeature, typeName, actual)
+		}
+		if actual := isTerraformChange(v.change); actual != v.expectedTerraform {
+			t.Errorf("expected isTerraformChange to be %t for %q but got %t", v.expectedTerraform, typeName, actual)
+		}
+	}
+}
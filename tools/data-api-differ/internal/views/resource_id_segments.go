@@ -91,3 +91,11 @@ Please review the following list of Static Identifiers:
 	//TODO: add a "see the link for how to fix this" to the comment above when the associated documentation is available
 	return trimSpaceAround(output)
 }
+
+// RenderJSON renders the Resource ID Segments View as JSON, intended to be consumed by automated tooling.
+func (v ResourceIdSegmentsView) RenderJSON() (*string, error) {
+	return marshalJSON(resourceIdSegmentsOutput{
+		SchemaVersion:            JSONSchemaVersion,
+		StaticIdentifierSegments: v.staticIdentifierSegments,
+	})
+}
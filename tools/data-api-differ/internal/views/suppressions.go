@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/log"
+	"github.com/hashicorp/pandora/tools/data-api-differ/internal/suppressions"
+)
+
+// acknowledgedChangeOutput is the JSON output for a Change which was matched by the Suppressions File.
+type acknowledgedChangeOutput struct {
+	changeOutput
+
+	// Reason specifies why this Change is intentional, as defined in the Suppressions File.
+	Reason string `json:"reason"`
+
+	// Expires optionally specifies the date after which the Suppression matching this Change no longer applies.
+	Expires *string `json:"expires,omitempty"`
+}
+
+// acknowledgedChanges returns the Changes which were matched by the Suppressions File, optionally limited
+// to only the Breaking Changes.
+func acknowledgedChanges(input *suppressions.Result, onlyBreakingChanges bool) []suppressions.AcknowledgedChange {
+	output := make([]suppressions.AcknowledgedChange, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, item := range input.Acknowledged {
+		if onlyBreakingChanges && !item.Change.IsBreaking() {
+			continue
+		}
+		output = append(output, item)
+	}
+	return output
+}
+
+// renderSuppressionsToMarkdown renders the Changes which were acknowledged in the Suppressions File, and any
+// Suppressions which have expired or didn't match a Change, as a Markdown section for each (where present).
+func renderSuppressionsToMarkdown(input *suppressions.Result, onlyBreakingChanges bool) ([]string, error) {
+	sections := make([]string, 0)
+	if input == nil {
+		return sections, nil
+	}
+
+	acknowledged := acknowledgedChanges(input, onlyBreakingChanges)
+	acknowledgedLines := make([]string, 0)
+	for i, item := range acknowledged {
+		log.Logger.Trace(fmt.Sprintf("Rendering Acknowledged Change %d", i))
+		markdown, err := renderChangeToMarkdown(item.Change)
+		if err != nil {
+			return nil, fmt.Errorf("rendering Acknowledged Change %d: %+v", i, err)
+		}
+		line := fmt.Sprintf("* ☑️ %s Reason: %s", *markdown, item.Suppression.Reason)
+		if item.Suppression.Expires != nil {
+			line += fmt.Sprintf(" (until `%s`)", *item.Suppression.Expires)
+		}
+		acknowledgedLines = append(acknowledgedLines, line)
+	}
+	if len(acknowledgedLines) > 0 {
+		sections = append(sections, fmt.Sprintf(`
+## Acknowledged Changes
+
+**%d Changes** were acknowledged in the Suppressions File:
+
+%s
+`, len(acknowledged), strings.Join(acknowledgedLines, "\n")))
+	}
+
+	suppressionLines := make([]string, 0)
+	for _, item := range input.Expired {
+		suppressionLines = append(suppressionLines, fmt.Sprintf("* ⌛ **Expired Suppression:** `%s` expired on `%s` and should be removed or renewed.", item.String(), *item.Expires))
+	}
+	for _, item := range input.Unmatched {
+		suppressionLines = append(suppressionLines, fmt.Sprintf("* ❓ **Unmatched Suppression:** `%s` did not match any Changes and should be removed.", item.String()))
+	}
+	if len(suppressionLines) > 0 {
+		sections = append(sections, fmt.Sprintf(`
+## Suppressions File
+
+**%d Suppressions** need to be reviewed:
+
+%s
+`, len(suppressionLines), strings.Join(suppressionLines, "\n")))
+	}
+
+	return sections, nil
+}
+
+// addSuppressions adds the Changes which were acknowledged in the Suppressions File, and any Suppressions which
+// have expired or didn't match a Change, to the JSON output.
+func (o *changesOutput) addSuppressions(input *suppressions.Result, onlyBreakingChanges bool) error {
+	if input == nil {
+		return nil
+	}
+
+	o.AcknowledgedChanges = make([]acknowledgedChangeOutput, 0)
+	for i, item := range acknowledgedChanges(input, onlyBreakingChanges) {
+		markdown, err := renderChangeToMarkdown(item.Change)
+		if err != nil {
+			return fmt.Errorf("rendering Acknowledged Change %d: %+v", i, err)
+		}
+
+		o.AcknowledgedChanges = append(o.AcknowledgedChanges, acknowledgedChangeOutput{
+			changeOutput: changeOutput{
+				Type:       changeTypeName(item.Change),
+				IsBreaking: item.Change.IsBreaking(),
+				Markdown:   *markdown,
+				Details:    item.Change,
+			},
+			Reason:  item.Suppression.Reason,
+			Expires: item.Suppression.Expires,
+		})
+	}
+	o.ExpiredSuppressions = input.Expired
+	o.UnmatchedSuppressions = input.Unmatched
+	return nil
+}
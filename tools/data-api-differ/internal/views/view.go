@@ -7,4 +7,8 @@ type View interface {
 	// RenderMarkdown renders the View using Markdown, intended for both display
 	// in a Terminal and to be output as a GitHub Comment.
 	RenderMarkdown() (*string, error)
+
+	// RenderJSON renders the View as JSON, intended to be consumed by automated tooling.
+	// The schema for this is versioned and documented in `json.md`.
+	RenderJSON() (*string, error)
 }
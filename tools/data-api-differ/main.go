@@ -50,7 +50,9 @@ func run() error {
 	c.Commands = map[string]cli.CommandFactory{
 		"detect-breaking-changes":     commands.NewDetectBreakingChangesCommand(*sourceDataType),
 		"detect-changes":              commands.NewDetectChangesCommand(*sourceDataType),
+		"impact-report":               commands.NewImpactReportCommand(*sourceDataType),
 		"output-resource-id-segments": commands.NewOutputResourceIdSegmentsCommand(*sourceDataType),
+		"release-notes":               commands.NewReleaseNotesCommand(*sourceDataType),
 	}
 
 	exitStatus, err := c.Run()
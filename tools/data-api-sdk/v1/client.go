@@ -39,6 +39,22 @@ func NewClient(endpoint string, sourceDataType models.SourceDataType) *Client {
 	}
 }
 
+// NewClientForHandler returns an instance of Client configured for the sourceDataType which serves
+// requests using handler rather than over the network - allowing the Data API to be used in-process.
+func NewClientForHandler(handler http.Handler, sourceDataType models.SourceDataType) *Client {
+	return &Client{
+		client: &http.Client{
+			Transport: &handlerTransport{
+				handler: handler,
+			},
+		},
+		// NOTE: requests are served by the handler, so this endpoint is never resolved
+		endpoint:       "http://data-api.local",
+		logger:         hclog.NewNullLogger(),
+		sourceDataType: sourceDataType,
+	}
+}
+
 // SetLogger enables configuring a logger for debug purposes
 func (c *Client) SetLogger(logger hclog.Logger) {
 	c.logger = logger
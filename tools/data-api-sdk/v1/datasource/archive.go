@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// openArchive opens the `.tar.gz`, `.tgz` or `.zip` archive at filePath. The files within a zip archive are read
+// from the archive as they're opened, whereas since a tar archive can only be read sequentially, this is extracted
+// into a temporary directory which is removed when the FileSystem is closed.
+func openArchive(filePath string) (FileSystem, error) {
+	if strings.HasSuffix(strings.ToLower(filePath), ".zip") {
+		reader, err := zip.OpenReader(filePath)
+		if err != nil {
+			return nil, err
+		}
+		return closableFileSystem{
+			FS:        reader,
+			closeFunc: reader.Close,
+		}, nil
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader, err := gzip.NewReader(file)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing %q: %+v", filePath, err)
+	}
+	defer reader.Close()
+
+	return extractToTemporaryDirectory(func(directory string) error {
+		return extractTar(reader, directory)
+	})
+}
+
+// extractToTemporaryDirectory creates a temporary directory and calls extract to populate it, returning a FileSystem
+// for the temporary directory which is removed when the FileSystem is closed.
+func extractToTemporaryDirectory(extract func(directory string) error) (FileSystem, error) {
+	directory, err := os.MkdirTemp("", "data-api-datasource-")
+	if err != nil {
+		return nil, fmt.Errorf("creating a temporary directory: %+v", err)
+	}
+
+	if err := extract(directory); err != nil {
+		os.RemoveAll(directory)
+		return nil, err
+	}
+
+	return closableFileSystem{
+		FS: os.DirFS(directory),
+		closeFunc: func() error {
+			return os.RemoveAll(directory)
+		},
+	}, nil
+}
+
+// extractTar extracts the files and directories within the tar stream from reader into directory.
+func extractTar(reader io.Reader, directory string) error {
+	tarReader := tar.NewReader(reader)
+	for {
+		header, err := tarReader.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading the tar archive: %+v", err)
+		}
+
+		// other entries (e.g. symlinks and the global header output by `git archive`) aren't used within the
+		// API Definitions, so can be ignored
+		if header.Typeflag != tar.TypeDir && header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		name := archivePath(header.Name)
+		if !fs.ValidPath(name) {
+			return fmt.Errorf("the tar archive contains the invalid path %q", header.Name)
+		}
+		filePath := filepath.Join(directory, filepath.FromSlash(name))
+
+		if header.Typeflag == tar.TypeDir {
+			if err := os.MkdirAll(filePath, 0755); err != nil {
+				return fmt.Errorf("creating the directory %q: %+v", name, err)
+			}
+			continue
+		}
+
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return fmt.Errorf("creating the directory for %q: %+v", name, err)
+		}
+		if err := extractFile(tarReader, filePath); err != nil {
+			return fmt.Errorf("extracting %q from the tar archive: %+v", header.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func extractFile(reader io.Reader, filePath string) error {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, reader); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// archivePath returns the path to an entry within an archive in the format used by fs.FS.
+func archivePath(name string) string {
+	name = strings.Trim(strings.ReplaceAll(name, "\\", "/"), "/")
+	if name == "" {
+		return "."
+	}
+	return path.Clean(name)
+}
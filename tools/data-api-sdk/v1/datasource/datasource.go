@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Type specifies where the API Definitions within a Data Source are read from.
+type Type string
+
+const (
+	// ArchiveType is a `.tar.gz`, `.tgz` or `.zip` archive containing the API Definitions.
+	ArchiveType Type = "Archive"
+
+	// DirectoryType is a directory on disk containing the API Definitions.
+	DirectoryType Type = "Directory"
+
+	// GitType is a directory within a commit of a git repository, which is read from the object database rather
+	// than requiring the commit to be checked out.
+	GitType Type = "Git"
+)
+
+// gitPrefix is the prefix used for a Git Data Source, e.g. `git:main:api-definitions`.
+const gitPrefix = "git:"
+
+var archivePattern = regexp.MustCompile(`(?i)^(.+\.(?:tar\.gz|tgz|zip))(?::(.*))?$`)
+
+// DataSource describes where a set of API Definitions should be read from.
+type DataSource struct {
+	// Type specifies the kind of Data Source this is.
+	Type Type
+
+	// Path is the path to the directory or archive on disk - or for a Git Data Source, optionally the path to the
+	// git repository, which defaults to the git repository in the current working directory.
+	Path string
+
+	// Ref is the git ref (e.g. a branch, tag or commit SHA) which should be read - this is only set for a Git
+	// Data Source.
+	Ref string
+
+	// SubDirectory is the directory within the archive or git repository which contains the API Definitions.
+	// When empty, the root of the archive/repository is used.
+	SubDirectory string
+}
+
+// Parse parses the Data Source specified in input, which is one of:
+//
+// * `git:{ref}:{path}` - the directory `path` within the commit `ref` of the git repository in the current
+// working directory (e.g. `git:main:api-definitions`).
+// * `{file}.tar.gz`, `{file}.tgz` or `{file}.zip` - an archive, optionally followed by `:{path}` to use a directory
+// within the archive (e.g. `definitions.tar.gz:api-definitions`).
+// * any other value is a path to a directory on disk.
+func Parse(input string) (*DataSource, error) {
+	if input == "" {
+		return nil, fmt.Errorf("a Data Source must be specified")
+	}
+
+	if strings.HasPrefix(input, gitPrefix) {
+		ref, subDirectory, _ := strings.Cut(strings.TrimPrefix(input, gitPrefix), ":")
+		if ref == "" {
+			return nil, fmt.Errorf("expected a git Data Source in the format `git:{ref}:{path}` but got %q", input)
+		}
+		subDirectory, err := normalizeSubDirectory(subDirectory)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", input, err)
+		}
+		return &DataSource{
+			Type:         GitType,
+			Ref:          ref,
+			SubDirectory: subDirectory,
+		}, nil
+	}
+
+	if matches := archivePattern.FindStringSubmatch(input); matches != nil {
+		subDirectory, err := normalizeSubDirectory(matches[2])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", input, err)
+		}
+		return &DataSource{
+			Type:         ArchiveType,
+			Path:         matches[1],
+			SubDirectory: subDirectory,
+		}, nil
+	}
+
+	return &DataSource{
+		Type: DirectoryType,
+		Path: input,
+	}, nil
+}
+
+// FileSystem is a filesystem containing the API Definitions within a Data Source, which should be closed once it's no
+// longer needed - removing any temporary files used to read the Data Source.
+type FileSystem interface {
+	fs.FS
+	io.Closer
+}
+
+var _ FileSystem = closableFileSystem{}
+
+// closableFileSystem is a FileSystem which calls closeFunc (when set) once closed.
+type closableFileSystem struct {
+	fs.FS
+	closeFunc func() error
+}
+
+func (c closableFileSystem) Close() error {
+	if c.closeFunc == nil {
+		return nil
+	}
+	return c.closeFunc()
+}
+
+// Open parses the Data Source specified in input (see Parse) and then opens it.
+func Open(input string) (FileSystem, error) {
+	dataSource, err := Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataSource.Open()
+}
+
+// Open returns a filesystem containing the API Definitions within this Data Source, which should be closed once
+// it's no longer needed.
+//
+// The contents of a `.tar.gz` archive or a Git Data Source are extracted into a temporary directory (which is
+// removed when the filesystem is closed), meaning that subsequent changes to the archive/repository are not
+// reflected in the returned filesystem.
+func (d DataSource) Open() (FileSystem, error) {
+	var fileSystem FileSystem
+	var err error
+	switch d.Type {
+	case ArchiveType:
+		fileSystem, err = openArchive(d.Path)
+	case DirectoryType:
+		fileSystem, err = openDirectory(d.Path)
+	case GitType:
+		fileSystem, err = openGitTree(d.Path, d.Ref, d.SubDirectory)
+	default:
+		return nil, fmt.Errorf("internal-error: unimplemented Data Source type %q", string(d.Type))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %+v", d.String(), err)
+	}
+
+	// git only outputs the sub directory, so there's nothing further to do here
+	if d.SubDirectory == "" || d.Type == GitType {
+		return fileSystem, nil
+	}
+
+	info, err := fs.Stat(fileSystem, d.SubDirectory)
+	if err != nil || !info.IsDir() {
+		fileSystem.Close()
+		return nil, fmt.Errorf("the directory %q was not found within %s", d.SubDirectory, d.String())
+	}
+	subDirectory, err := fs.Sub(fileSystem, d.SubDirectory)
+	if err != nil {
+		fileSystem.Close()
+		return nil, err
+	}
+	return closableFileSystem{
+		FS:        subDirectory,
+		closeFunc: fileSystem.Close,
+	}, nil
+}
+
+// Validate checks that this Data Source exists, without reading the API Definitions within it.
+func (d DataSource) Validate() error {
+	switch d.Type {
+	case ArchiveType, DirectoryType:
+		info, err := os.Stat(d.Path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return fmt.Errorf("%q does not exist", d.Path)
+			}
+			return fmt.Errorf("retrieving information for %q: %+v", d.Path, err)
+		}
+		if d.Type == DirectoryType && !info.IsDir() {
+			return fmt.Errorf("%q is not a directory", d.Path)
+		}
+		if d.Type == ArchiveType && info.IsDir() {
+			return fmt.Errorf("%q is a directory rather than an archive", d.Path)
+		}
+		return nil
+
+	case GitType:
+		return validateGitTree(d.Path, d.Ref, d.SubDirectory)
+	}
+
+	return fmt.Errorf("internal-error: unimplemented Data Source type %q", string(d.Type))
+}
+
+// String returns this Data Source in the format accepted by Parse.
+func (d DataSource) String() string {
+	switch d.Type {
+	case ArchiveType:
+		if d.SubDirectory != "" {
+			return fmt.Sprintf("%s:%s", d.Path, d.SubDirectory)
+		}
+		return d.Path
+
+	case GitType:
+		if d.SubDirectory != "" {
+			return fmt.Sprintf("%s%s:%s", gitPrefix, d.Ref, d.SubDirectory)
+		}
+		return fmt.Sprintf("%s%s", gitPrefix, d.Ref)
+	}
+
+	return d.Path
+}
+
+func openDirectory(directory string) (FileSystem, error) {
+	info, err := os.Stat(directory)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%q is not a directory", directory)
+	}
+
+	return closableFileSystem{
+		FS: os.DirFS(directory),
+	}, nil
+}
+
+// normalizeSubDirectory returns the specified directory in the format used by fs.FS, that is slash-separated and
+// without any leading, trailing or `.`/`..` elements.
+func normalizeSubDirectory(input string) (string, error) {
+	if input == "" {
+		return "", nil
+	}
+
+	directory := path.Clean(strings.Trim(strings.ReplaceAll(input, "\\", "/"), "/"))
+	if directory == "." {
+		return "", nil
+	}
+	if !fs.ValidPath(directory) {
+		return "", fmt.Errorf("the directory %q must be relative and must not contain `..`", input)
+	}
+
+	return directory, nil
+}
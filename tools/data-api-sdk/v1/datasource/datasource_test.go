@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+var testFiles = map[string]string{
+	"api-definitions/resource-manager/metadata.json":                  `{"dataSource": "AzureResourceManager"}`,
+	"api-definitions/resource-manager/Example/ServiceDefinition.json": `{"name": "Example"}`,
+	"README.md": "hello",
+}
+
+func TestParse(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected *DataSource
+	}{
+		{
+			input:    "../../api-definitions",
+			expected: &DataSource{Type: DirectoryType, Path: "../../api-definitions"},
+		},
+		{
+			input:    "git:main:api-definitions",
+			expected: &DataSource{Type: GitType, Ref: "main", SubDirectory: "api-definitions"},
+		},
+		{
+			input:    "git:abc123:./api-definitions/",
+			expected: &DataSource{Type: GitType, Ref: "abc123", SubDirectory: "api-definitions"},
+		},
+		{
+			input:    "git:HEAD~1",
+			expected: &DataSource{Type: GitType, Ref: "HEAD~1"},
+		},
+		{
+			input:    "/tmp/definitions.tar.gz",
+			expected: &DataSource{Type: ArchiveType, Path: "/tmp/definitions.tar.gz"},
+		},
+		{
+			input:    "definitions.ZIP:api-definitions",
+			expected: &DataSource{Type: ArchiveType, Path: "definitions.ZIP", SubDirectory: "api-definitions"},
+		},
+		{
+			input:    "definitions.tgz:nested/api-definitions",
+			expected: &DataSource{Type: ArchiveType, Path: "definitions.tgz", SubDirectory: "nested/api-definitions"},
+		},
+		{
+			input: "git::api-definitions",
+		},
+		{
+			input: "git:main:../api-definitions",
+		},
+		{
+			input: "",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.input)
+
+		actual, err := Parse(v.input)
+		if v.expected == nil {
+			if err == nil {
+				t.Fatalf("expected an error but got %+v", *actual)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.input, err)
+		}
+		if !reflect.DeepEqual(*v.expected, *actual) {
+			t.Fatalf("expected %+v but got %+v", *v.expected, *actual)
+		}
+		if actual.String() != v.input && v.input != "git:abc123:./api-definitions/" {
+			t.Fatalf("expected String() to return %q but got %q", v.input, actual.String())
+		}
+	}
+}
+
+func TestOpenTarGz(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "definitions.tar.gz")
+	file, err := os.Create(filePath)
+	if err != nil {
+		t.Fatalf("creating %q: %+v", filePath, err)
+	}
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, contents := range testFiles {
+		if err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(contents)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatalf("writing header for %q: %+v", name, err)
+		}
+		if _, err := tarWriter.Write([]byte(contents)); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+	tarWriter.Close()
+	gzipWriter.Close()
+	file.Close()
+
+	testOpenedDataSource(t, filePath+":api-definitions")
+}
+
+func TestOpenTarGz_InvalidPath(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "definitions.tar.gz")
+	file, err := os.Create(filePath)
+	if err != nil {
+		t.Fatalf("creating %q: %+v", filePath, err)
+	}
+	gzipWriter := gzip.NewWriter(file)
+	tarWriter := tar.NewWriter(gzipWriter)
+	contents := "outside"
+	if err := tarWriter.WriteHeader(&tar.Header{Name: "../outside.json", Mode: 0644, Size: int64(len(contents)), Typeflag: tar.TypeReg}); err != nil {
+		t.Fatalf("writing header: %+v", err)
+	}
+	if _, err := tarWriter.Write([]byte(contents)); err != nil {
+		t.Fatalf("writing: %+v", err)
+	}
+	tarWriter.Close()
+	gzipWriter.Close()
+	file.Close()
+
+	if _, err := Open(filePath); err == nil {
+		t.Fatalf("expected an error opening an archive containing a path outside of the archive")
+	}
+}
+
+func TestOpenZip(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "definitions.zip")
+	file, err := os.Create(filePath)
+	if err != nil {
+		t.Fatalf("creating %q: %+v", filePath, err)
+	}
+	zipWriter := zip.NewWriter(file)
+	for name, contents := range testFiles {
+		writer, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatalf("creating %q: %+v", name, err)
+		}
+		if _, err := writer.Write([]byte(contents)); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+	zipWriter.Close()
+	file.Close()
+
+	testOpenedDataSource(t, filePath+":api-definitions")
+}
+
+func TestOpenGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	repository := t.TempDir()
+	for name, contents := range testFiles {
+		writeTestFile(t, repository, name, contents)
+	}
+	runGit(t, repository, "init", "--quiet")
+	runGit(t, repository, "add", "-A")
+	runGit(t, repository, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "initial")
+
+	// the working tree shouldn't be read
+	writeTestFile(t, repository, "api-definitions/resource-manager/metadata.json", "{}")
+
+	// the path within the git tree is relative to the root of the repository, even when run from a sub directory
+	dataSource := DataSource{
+		Type:         GitType,
+		Path:         filepath.Join(repository, "api-definitions", "resource-manager"),
+		Ref:          "HEAD",
+		SubDirectory: "api-definitions",
+	}
+	if err := dataSource.Validate(); err != nil {
+		t.Fatalf("validating: %+v", err)
+	}
+	fileSystem, err := dataSource.Open()
+	if err != nil {
+		t.Fatalf("opening: %+v", err)
+	}
+	testFileSystem(t, fileSystem)
+	testClosedFileSystem(t, fileSystem)
+
+	missing := DataSource{
+		Type:         GitType,
+		Path:         repository,
+		Ref:          "HEAD",
+		SubDirectory: "missing",
+	}
+	if err := missing.Validate(); err == nil {
+		t.Fatalf("expected an error when validating a directory which doesn't exist")
+	}
+}
+
+func testOpenedDataSource(t *testing.T, input string) {
+	dataSource, err := Parse(input)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", input, err)
+	}
+	if err := dataSource.Validate(); err != nil {
+		t.Fatalf("validating %q: %+v", input, err)
+	}
+	fileSystem, err := dataSource.Open()
+	if err != nil {
+		t.Fatalf("opening %q: %+v", input, err)
+	}
+	testFileSystem(t, fileSystem)
+	testClosedFileSystem(t, fileSystem)
+}
+
+// testClosedFileSystem closes fileSystem, checking that the files within it can no longer be read - that is any
+// temporary files have been removed.
+func testClosedFileSystem(t *testing.T, fileSystem FileSystem) {
+	if err := fileSystem.Close(); err != nil {
+		t.Fatalf("closing: %+v", err)
+	}
+	if _, err := fs.ReadFile(fileSystem, "resource-manager/metadata.json"); err == nil {
+		t.Fatalf("expected an error reading metadata.json once the filesystem is closed")
+	}
+}
+
+func testFileSystem(t *testing.T, fileSystem fs.FS) {
+	if err := fstest.TestFS(fileSystem, "resource-manager/metadata.json", "resource-manager/Example/ServiceDefinition.json"); err != nil {
+		t.Fatalf("testing the filesystem: %+v", err)
+	}
+
+	contents, err := fs.ReadFile(fileSystem, "resource-manager/metadata.json")
+	if err != nil {
+		t.Fatalf("reading metadata.json: %+v", err)
+	}
+	if string(contents) != testFiles["api-definitions/resource-manager/metadata.json"] {
+		t.Fatalf("unexpected contents for metadata.json: %q", string(contents))
+	}
+
+	entries, err := fs.ReadDir(fileSystem, "resource-manager")
+	if err != nil {
+		t.Fatalf("listing resource-manager: %+v", err)
+	}
+	names := make([]string, 0)
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	if !reflect.DeepEqual(names, []string{"Example", "metadata.json"}) {
+		t.Fatalf("expected the entries `Example` and `metadata.json` but got %+v", names)
+	}
+	if !entries[0].IsDir() || entries[1].IsDir() {
+		t.Fatalf("expected `Example` to be a directory and `metadata.json` to be a file")
+	}
+
+	if _, err := fs.Stat(fileSystem, "README.md"); err == nil {
+		t.Fatalf("expected files outside of the sub directory to be excluded")
+	}
+}
+
+func runGit(t *testing.T, directory string, args ...string) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = directory
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("running `git %v`: %+v\n%s", args, err, string(output))
+	}
+}
+
+func writeTestFile(t *testing.T, directory, fileName, contents string) {
+	filePath := filepath.Join(directory, fileName)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", filePath, err)
+	}
+	if err := os.WriteFile(filePath, []byte(contents), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", filePath, err)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datasource
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+)
+
+// openGitTree opens the directory subDirectory within the commit ref of the git repository at repository (or the
+// current working directory when empty). This is read directly from the object database (using `git archive`) rather
+// than requiring the commit to be checked out, and is extracted into a temporary directory which is removed when the
+// FileSystem is closed.
+func openGitTree(repository, ref, subDirectory string) (FileSystem, error) {
+	// when run from a sub directory `git archive` only includes the files within that sub directory, so this needs
+	// to be run from the root of the repository
+	root, err := repositoryRoot(repository)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractToTemporaryDirectory(func(directory string) error {
+		var stderr bytes.Buffer
+		cmd := exec.Command("git", "archive", "--format=tar", treeIsh(ref, subDirectory))
+		cmd.Dir = root
+		cmd.Stderr = &stderr
+		stdout, err := cmd.StdoutPipe()
+		if err != nil {
+			return fmt.Errorf("obtaining the output for `git archive`: %+v", err)
+		}
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("running `git archive`: %+v", err)
+		}
+
+		extractErr := extractTar(stdout, directory)
+		if extractErr != nil {
+			// drain the remaining output so that `git archive` can exit
+			io.Copy(io.Discard, stdout)
+		}
+		if err := cmd.Wait(); err != nil {
+			return fmt.Errorf("running `git archive`: %+v: %s", err, strings.TrimSpace(stderr.String()))
+		}
+		return extractErr
+	})
+}
+
+// validateGitTree validates that subDirectory exists as a directory within the commit ref of the git repository at
+// repository (or the current working directory when empty).
+func validateGitTree(repository, ref, subDirectory string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "cat-file", "-t", treeIsh(ref, subDirectory))
+	cmd.Dir = repository
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("the directory %q was not found within the git ref %q: %s", subDirectory, ref, strings.TrimSpace(stderr.String()))
+	}
+	if objectType := strings.TrimSpace(string(output)); objectType != "tree" && objectType != "commit" {
+		return fmt.Errorf("expected %q to be a directory within the git ref %q but got a %s", subDirectory, ref, objectType)
+	}
+
+	return nil
+}
+
+// treeIsh returns the git object name for the directory subDirectory within the commit ref.
+func treeIsh(ref, subDirectory string) string {
+	if subDirectory == "" {
+		return ref
+	}
+	return fmt.Sprintf("%s:%s", ref, subDirectory)
+}
+
+// repositoryRoot returns the path to the root of the git repository containing directory (or the current working
+// directory when empty).
+func repositoryRoot(directory string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
+	cmd.Dir = directory
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("locating the git repository: %+v: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type ExportResponse struct {
+	// HttpResponse is the raw HTTP Response.
+	HttpResponse *http.Response
+
+	// Model contains the Common Types and all of the (requested) Services within this Source Data Type.
+	// This is nil when the Data API doesn't support the export endpoint.
+	Model *LoadAllDataResult
+}
+
+// Export retrieves the Common Types and every Service (including all API Versions, API Resources and
+// Terraform Definitions) within this Source Data Type using a single request.
+//
+// serviceNamesToLimitTo is an optional value allowing limiting the returned result to a subset of the available
+// services, Service Names which don't exist are ignored.
+//
+// Older versions of the Data API don't support this endpoint, in which case the Model will be nil.
+func (c *Client) Export(ctx context.Context, serviceNamesToLimitTo []string) (*ExportResponse, error) {
+	uri := fmt.Sprintf("%s/v1/%s/_export", c.endpoint, string(c.sourceDataType))
+	if len(serviceNamesToLimitTo) > 0 {
+		uri = fmt.Sprintf("%s?services=%s", uri, url.QueryEscape(strings.Join(serviceNamesToLimitTo, ",")))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request to the %q endpoint: %+v", uri, err)
+	}
+
+	out := ExportResponse{}
+	out.HttpResponse, err = c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request to %q: %+v", uri, err)
+	}
+	defer out.HttpResponse.Body.Close()
+
+	if out.HttpResponse.StatusCode == http.StatusNotFound {
+		// this version of the Data API doesn't support the export endpoint
+		return &out, nil
+	}
+
+	if out.HttpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected a 200 OK but got %d %s for %q", out.HttpResponse.StatusCode, out.HttpResponse.Status, uri)
+	}
+
+	if err := json.NewDecoder(out.HttpResponse.Body).Decode(&out.Model); err != nil {
+		return nil, fmt.Errorf("decoding the response from %q: %+v", uri, err)
+	}
+
+	return &out, nil
+}
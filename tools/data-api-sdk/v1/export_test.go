@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestLoadAllDataUsesExport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/resource-manager/_export" {
+			t.Errorf("unexpected request to %q", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if services := r.URL.Query().Get("services"); services != "Example,Other" {
+			t.Errorf("expected the services `Example,Other` but got %q", services)
+		}
+
+		w.Write([]byte(`{"CommonTypes":{"constants":{},"models":{}},"Services":{"Example":{"APIVersions":{"2020-01-01":{"Generate":true,"Preview":false,"Resources":{},"Source":"HandWritten"}},"generate":true,"ResourceProvider":"Microsoft.Example","TerraformDefinition":null}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, models.ResourceManagerSourceDataType)
+	result, err := client.LoadAllData(context.TODO(), []string{"Example", "Other"})
+	if err != nil {
+		t.Fatalf("loading all data: %+v", err)
+	}
+
+	service, ok := result.Services["Example"]
+	if !ok || len(result.Services) != 1 {
+		t.Fatalf("expected only the Service `Example` but got %+v", result.Services)
+	}
+	if !service.Generate || service.ResourceProvider == nil || *service.ResourceProvider != "Microsoft.Example" {
+		t.Fatalf("unexpected Service details: %+v", service)
+	}
+	if _, ok := service.APIVersions["2020-01-01"]; !ok {
+		t.Fatalf("expected the API Version `2020-01-01` but got %+v", service.APIVersions)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"net/http"
+	"net/http/httptest"
+)
+
+var _ http.RoundTripper = &handlerTransport{}
+
+// handlerTransport is an http.RoundTripper which serves each request using an http.Handler (e.g. the router
+// for the Data API) rather than sending it over the network.
+type handlerTransport struct {
+	handler http.Handler
+}
+
+func (t *handlerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorder := httptest.NewRecorder()
+	t.handler.ServeHTTP(recorder, req)
+
+	resp := recorder.Result()
+	resp.Request = req
+	return resp, nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// FindReferencesWithinAPIResource returns every item within resource which references the SDKConstant, SDKModel
+// or ResourceID named name - including items which reference it transitively (for example an SDKOperation which
+// returns an SDKModel which contains a Field referencing the target).
+//
+// Direct references are returned first, followed by transitive references in order of increasing distance.
+func FindReferencesWithinAPIResource(resource models.APIResource, name string) (*[]models.SDKReference, error) {
+	scopes := []referenceScope{
+		{
+			constants:   resource.Constants,
+			models:      resource.Models,
+			operations:  resource.Operations,
+			resourceIDs: resource.ResourceIDs,
+		},
+	}
+
+	target := referenceTarget{scope: 0, name: name}
+	_, isConstant := resource.Constants[name]
+	_, isModel := resource.Models[name]
+	if _, isResourceID := resource.ResourceIDs[name]; isResourceID {
+		target.isResourceID = true
+	} else if !isConstant && !isModel {
+		return nil, fmt.Errorf("%q was not found as a Constant, Model or Resource ID", name)
+	}
+
+	output := findReferences(scopes, -1, target)
+	return &output, nil
+}
+
+// FindReferencesToCommonType returns every item within the Common Types and within each APIResource in services
+// which references the Common Type (SDKConstant or SDKModel) named name - including items which reference it
+// transitively. References within an APIResource are only considered to be to a Common Type when the APIResource
+// doesn't define an SDKConstant or SDKModel of the same name.
+//
+// Direct references are returned first, followed by transitive references in order of increasing distance.
+func FindReferencesToCommonType(commonTypes models.CommonTypes, services map[string]models.Service, name string) (*[]models.SDKReference, error) {
+	_, isConstant := commonTypes.Constants[name]
+	_, isModel := commonTypes.Models[name]
+	if !isConstant && !isModel {
+		return nil, fmt.Errorf("%q was not found as a Common Type", name)
+	}
+
+	scopes := []referenceScope{
+		{
+			constants: commonTypes.Constants,
+			models:    commonTypes.Models,
+		},
+	}
+	for _, serviceName := range sortedKeys(services) {
+		serviceName := serviceName
+		service := services[serviceName]
+		for _, apiVersion := range sortedKeys(service.APIVersions) {
+			apiVersion := apiVersion
+			resources := service.APIVersions[apiVersion].Resources
+			for _, resourceName := range sortedKeys(resources) {
+				resourceName := resourceName
+				resource := resources[resourceName]
+				scopes = append(scopes, referenceScope{
+					serviceName: &serviceName,
+					apiVersion:  &apiVersion,
+					apiResource: &resourceName,
+					constants:   resource.Constants,
+					models:      resource.Models,
+					operations:  resource.Operations,
+					resourceIDs: resource.ResourceIDs,
+				})
+			}
+		}
+	}
+
+	output := findReferences(scopes, 0, referenceTarget{scope: 0, name: name})
+	return &output, nil
+}
+
+// referenceScope is a set of types which can reference one another by name, such as an APIResource
+// or the Common Types.
+type referenceScope struct {
+	serviceName *string
+	apiVersion  *string
+	apiResource *string
+
+	constants   map[string]models.SDKConstant
+	models      map[string]models.SDKModel
+	operations  map[string]models.SDKOperation
+	resourceIDs map[string]models.ResourceID
+}
+
+// referenceTarget identifies a type (or a ResourceID) within a referenceScope.
+type referenceTarget struct {
+	scope        int
+	name         string
+	isResourceID bool
+}
+
+// findReferences performs a breadth-first search from target, returning every item which references it either
+// directly or via the types which reference it. commonTypesScope is the index of the scope containing the Common
+// Types (or -1 if there isn't one), which is used to resolve references to types not defined within a scope.
+func findReferences(scopes []referenceScope, commonTypesScope int, target referenceTarget) []models.SDKReference {
+	type queueItem struct {
+		target referenceTarget
+		via    []string
+	}
+
+	resolves := func(scope int, referenceName *string, target referenceTarget) bool {
+		if referenceName == nil || *referenceName != target.name || target.isResourceID {
+			return false
+		}
+		_, isConstant := scopes[scope].constants[*referenceName]
+		_, isModel := scopes[scope].models[*referenceName]
+		if isConstant || isModel {
+			return scope == target.scope
+		}
+		return target.scope == commonTypesScope
+	}
+
+	output := make([]models.SDKReference, 0)
+	visited := map[referenceTarget]struct{}{
+		target: {},
+	}
+	queue := []queueItem{{target: target}}
+	enqueue := func(next referenceTarget, via []string) {
+		if _, ok := visited[next]; ok {
+			return
+		}
+		visited[next] = struct{}{}
+		queue = append(queue, queueItem{
+			target: next,
+			via:    append([]string{next.name}, via...),
+		})
+	}
+
+	for len(queue) > 0 {
+		item := queue[0]
+		queue = queue[1:]
+
+		for scopeIndex, scope := range scopes {
+			scopeIndex := scopeIndex
+			newReference := func(referenceType models.SDKReferenceType) models.SDKReference {
+				return models.SDKReference{
+					Type:        referenceType,
+					ServiceName: scope.serviceName,
+					APIVersion:  scope.apiVersion,
+					APIResource: scope.apiResource,
+					Via:         item.via,
+				}
+			}
+
+			if item.target.isResourceID {
+				if scopeIndex != item.target.scope {
+					continue
+				}
+				for _, operationName := range sortedKeys(scope.operations) {
+					operationName := operationName
+					operation := scope.operations[operationName]
+					if operation.ResourceIDName != nil && *operation.ResourceIDName == item.target.name {
+						reference := newReference(models.OperationResourceIDSDKReferenceType)
+						reference.OperationName = &operationName
+						output = append(output, reference)
+					}
+				}
+				continue
+			}
+
+			for _, modelName := range sortedKeys(scope.models) {
+				modelName := modelName
+				model := scope.models[modelName]
+				if resolves(scopeIndex, model.ParentTypeName, item.target) {
+					reference := newReference(models.ModelParentTypeSDKReferenceType)
+					reference.ModelName = &modelName
+					output = append(output, reference)
+				}
+
+				for _, fieldName := range sortedKeys(model.Fields) {
+					fieldName := fieldName
+					objectDefinition := InnerMostSDKObjectDefinition(model.Fields[fieldName].ObjectDefinition)
+					if resolves(scopeIndex, objectDefinition.ReferenceName, item.target) {
+						reference := newReference(models.ModelFieldSDKReferenceType)
+						reference.ModelName = &modelName
+						reference.FieldName = &fieldName
+						output = append(output, reference)
+						enqueue(referenceTarget{scope: scopeIndex, name: modelName}, item.via)
+					}
+				}
+			}
+
+			for _, operationName := range sortedKeys(scope.operations) {
+				operationName := operationName
+				operation := scope.operations[operationName]
+				if operation.RequestObject != nil {
+					objectDefinition := InnerMostSDKObjectDefinition(*operation.RequestObject)
+					if resolves(scopeIndex, objectDefinition.ReferenceName, item.target) {
+						reference := newReference(models.OperationRequestSDKReferenceType)
+						reference.OperationName = &operationName
+						output = append(output, reference)
+					}
+				}
+				if operation.ResponseObject != nil {
+					objectDefinition := InnerMostSDKObjectDefinition(*operation.ResponseObject)
+					if resolves(scopeIndex, objectDefinition.ReferenceName, item.target) {
+						reference := newReference(models.OperationResponseSDKReferenceType)
+						reference.OperationName = &operationName
+						output = append(output, reference)
+					}
+				}
+				for _, optionName := range sortedKeys(operation.Options) {
+					optionName := optionName
+					objectDefinition := innerMostSDKOperationOptionObjectDefinition(operation.Options[optionName].ObjectDefinition)
+					if resolves(scopeIndex, objectDefinition.ReferenceName, item.target) {
+						reference := newReference(models.OperationOptionSDKReferenceType)
+						reference.OperationName = &operationName
+						reference.OptionName = &optionName
+						output = append(output, reference)
+					}
+				}
+			}
+
+			for _, resourceIDName := range sortedKeys(scope.resourceIDs) {
+				resourceIDName := resourceIDName
+				for _, segment := range scope.resourceIDs[resourceIDName].Segments {
+					if resolves(scopeIndex, segment.ConstantReference, item.target) {
+						segmentName := segment.Name
+						reference := newReference(models.ResourceIDSegmentSDKReferenceType)
+						reference.ResourceIDName = &resourceIDName
+						reference.SegmentName = &segmentName
+						output = append(output, reference)
+						enqueue(referenceTarget{scope: scopeIndex, name: resourceIDName, isResourceID: true}, item.via)
+					}
+				}
+			}
+		}
+	}
+
+	return output
+}
+
+func innerMostSDKOperationOptionObjectDefinition(input models.SDKOperationOptionObjectDefinition) models.SDKOperationOptionObjectDefinition {
+	if input.NestedItem != nil {
+		return innerMostSDKOperationOptionObjectDefinition(*input.NestedItem)
+	}
+
+	return input
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	output := make([]string, 0, len(input))
+	for key := range input {
+		output = append(output, key)
+	}
+	sort.Strings(output)
+	return output
+}
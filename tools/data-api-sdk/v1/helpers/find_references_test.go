@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestFindReferencesWithinAPIResource(t *testing.T) {
+	resource := models.APIResource{
+		Constants: map[string]models.SDKConstant{
+			"SkuTier": {},
+		},
+		Models: map[string]models.SDKModel{
+			"Sku": {
+				Fields: map[string]models.SDKField{
+					"Tier": {ObjectDefinition: models.SDKObjectDefinition{Type: models.ReferenceSDKObjectDefinitionType, ReferenceName: pointer.To("SkuTier")}},
+				},
+			},
+			"Thing": {
+				Fields: map[string]models.SDKField{
+					"Skus": {ObjectDefinition: models.SDKObjectDefinition{Type: models.ListSDKObjectDefinitionType, NestedItem: &models.SDKObjectDefinition{Type: models.ReferenceSDKObjectDefinitionType, ReferenceName: pointer.To("Sku")}}},
+				},
+			},
+		},
+		Operations: map[string]models.SDKOperation{
+			"Get": {
+				ResourceIDName: pointer.To("TierId"),
+				ResponseObject: &models.SDKObjectDefinition{Type: models.ReferenceSDKObjectDefinitionType, ReferenceName: pointer.To("Thing")},
+				Options: map[string]models.SDKOperationOption{
+					"Tier": {ObjectDefinition: models.SDKOperationOptionObjectDefinition{Type: models.ReferenceSDKOperationOptionObjectDefinitionType, ReferenceName: pointer.To("SkuTier")}},
+				},
+			},
+		},
+		ResourceIDs: map[string]models.ResourceID{
+			"TierId": {
+				Segments: []models.ResourceIDSegment{
+					{Name: "tier", Type: models.ConstantResourceIDSegmentType, ConstantReference: pointer.To("SkuTier")},
+				},
+			},
+		},
+	}
+
+	actual, err := FindReferencesWithinAPIResource(resource, "SkuTier")
+	if err != nil {
+		t.Fatalf("finding references: %+v", err)
+	}
+	expected := []models.SDKReference{
+		{Type: models.ModelFieldSDKReferenceType, ModelName: pointer.To("Sku"), FieldName: pointer.To("Tier")},
+		{Type: models.OperationOptionSDKReferenceType, OperationName: pointer.To("Get"), OptionName: pointer.To("Tier")},
+		{Type: models.ResourceIDSegmentSDKReferenceType, ResourceIDName: pointer.To("TierId"), SegmentName: pointer.To("tier")},
+		{Type: models.ModelFieldSDKReferenceType, ModelName: pointer.To("Thing"), FieldName: pointer.To("Skus"), Via: []string{"Sku"}},
+		{Type: models.OperationResourceIDSDKReferenceType, OperationName: pointer.To("Get"), Via: []string{"TierId"}},
+		{Type: models.OperationResponseSDKReferenceType, OperationName: pointer.To("Get"), Via: []string{"Thing", "Sku"}},
+	}
+	if !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+
+	if _, err := FindReferencesWithinAPIResource(resource, "Missing"); err == nil {
+		t.Fatalf("expected an error finding references to a type which doesn't exist")
+	}
+}
+
+func TestFindReferencesToCommonType(t *testing.T) {
+	commonTypes := models.CommonTypes{
+		Constants: map[string]models.SDKConstant{},
+		Models: map[string]models.SDKModel{
+			"Entity": {},
+			"DirectoryObject": {
+				ParentTypeName: pointer.To("Entity"),
+			},
+		},
+	}
+	services := map[string]models.Service{
+		"Users": {
+			APIVersions: map[string]models.APIVersion{
+				"beta": {
+					Resources: map[string]models.APIResource{
+						"User": {
+							Operations: map[string]models.SDKOperation{
+								"GetUser": {ResponseObject: &models.SDKObjectDefinition{Type: models.ReferenceSDKObjectDefinitionType, ReferenceName: pointer.To("Entity")}},
+							},
+						},
+						"Shadowed": {
+							Models: map[string]models.SDKModel{
+								// this is defined locally, so references to it aren't references to the Common Type
+								"Entity": {},
+							},
+							Operations: map[string]models.SDKOperation{
+								"GetEntity": {ResponseObject: &models.SDKObjectDefinition{Type: models.ReferenceSDKObjectDefinitionType, ReferenceName: pointer.To("Entity")}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := FindReferencesToCommonType(commonTypes, services, "Entity")
+	if err != nil {
+		t.Fatalf("finding references: %+v", err)
+	}
+	expected := []models.SDKReference{
+		{Type: models.ModelParentTypeSDKReferenceType, ModelName: pointer.To("DirectoryObject")},
+		{Type: models.OperationResponseSDKReferenceType, ServiceName: pointer.To("Users"), APIVersion: pointer.To("beta"), APIResource: pointer.To("User"), OperationName: pointer.To("GetUser")},
+	}
+	if !reflect.DeepEqual(*actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, *actual)
+	}
+}
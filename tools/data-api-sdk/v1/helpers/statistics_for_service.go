@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"math"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// StatisticsForService returns the Statistics for each API Version within service, along with the sum of these.
+//
+// A ResourceID is considered to have a TerraformResourceDefinition when a TerraformResourceDefinition within
+// the service references the same API Version, APIResource and ResourceID.
+func StatisticsForService(service models.Service) models.ServiceStatistics {
+	terraformResources := make(map[string]map[string]map[string][]models.TerraformResourceDefinition)
+	if service.TerraformDefinition != nil {
+		for _, resource := range service.TerraformDefinition.Resources {
+			if _, ok := terraformResources[resource.APIVersion]; !ok {
+				terraformResources[resource.APIVersion] = make(map[string]map[string][]models.TerraformResourceDefinition)
+			}
+			if _, ok := terraformResources[resource.APIVersion][resource.APIResource]; !ok {
+				terraformResources[resource.APIVersion][resource.APIResource] = make(map[string][]models.TerraformResourceDefinition)
+			}
+			existing := terraformResources[resource.APIVersion][resource.APIResource][resource.ResourceIDName]
+			terraformResources[resource.APIVersion][resource.APIResource][resource.ResourceIDName] = append(existing, resource)
+		}
+	}
+
+	output := models.ServiceStatistics{
+		APIVersions: make(map[string]models.Statistics),
+		Total:       emptyStatistics(),
+	}
+	for apiVersionName, apiVersion := range service.APIVersions {
+		statistics := statisticsForAPIVersion(apiVersion, terraformResources[apiVersionName])
+		output.APIVersions[apiVersionName] = statistics
+		addStatistics(&output.Total, statistics)
+	}
+
+	return output
+}
+
+// AggregateStatisticsForServices returns the sum of the Statistics for each Service within services, along with
+// the sum of these.
+func AggregateStatisticsForServices(services map[string]models.ServiceStatistics) models.AggregateStatistics {
+	output := models.AggregateStatistics{
+		Services: make(map[string]models.Statistics),
+		Total:    emptyStatistics(),
+	}
+	for serviceName, service := range services {
+		output.Services[serviceName] = service.Total
+		addStatistics(&output.Total, service.Total)
+	}
+
+	return output
+}
+
+// statisticsForAPIVersion returns the Statistics for apiVersion, where terraformResources is a map of APIResource
+// Name to ResourceID Name to the TerraformResourceDefinitions for that ResourceID.
+func statisticsForAPIVersion(apiVersion models.APIVersion, terraformResources map[string]map[string][]models.TerraformResourceDefinition) models.Statistics {
+	output := emptyStatistics()
+	output.APIVersions = 1
+
+	for resourceName, resource := range apiVersion.Resources {
+		output.Constants += len(resource.Constants)
+		output.Models += len(resource.Models)
+		for _, model := range resource.Models {
+			if model.DiscriminatedValue != nil || model.FieldNameContainingDiscriminatedValue != nil {
+				output.DiscriminatedTypes++
+			}
+		}
+
+		for _, operation := range resource.Operations {
+			output.Operations.Total++
+			output.Operations.ByHTTPMethod[strings.ToUpper(operation.Method)]++
+			if operation.LongRunning {
+				output.Operations.LongRunning++
+			}
+			if operation.FieldContainingPaginationDetails != nil {
+				output.Operations.Paginated++
+			}
+			if len(operation.Options) > 0 {
+				output.Operations.WithOptions++
+			}
+		}
+
+		for resourceIDName := range resource.ResourceIDs {
+			output.ResourceIDs.Total++
+
+			definitions := terraformResources[resourceName][resourceIDName]
+			if len(definitions) == 0 {
+				continue
+			}
+			output.ResourceIDs.WithTerraformResource++
+			for _, definition := range definitions {
+				addTerraformResourceStatistics(&output.ResourceIDs.TerraformResources, definition)
+			}
+		}
+	}
+
+	output.ResourceIDs.TerraformCoveragePercentage = coveragePercentage(output.ResourceIDs)
+	return output
+}
+
+func addTerraformResourceStatistics(output *models.TerraformResourceStatistics, definition models.TerraformResourceDefinition) {
+	output.Total++
+	if definition.CreateMethod.Generate {
+		output.GenerateCreate++
+	}
+	if definition.ReadMethod.Generate {
+		output.GenerateRead++
+	}
+	if definition.UpdateMethod != nil && definition.UpdateMethod.Generate {
+		output.GenerateUpdate++
+	}
+	if definition.DeleteMethod.Generate {
+		output.GenerateDelete++
+	}
+}
+
+// addStatistics adds the values within input to output, recalculating the Terraform Coverage Percentage.
+func addStatistics(output *models.Statistics, input models.Statistics) {
+	output.APIVersions += input.APIVersions
+	output.Constants += input.Constants
+	output.DiscriminatedTypes += input.DiscriminatedTypes
+	output.Models += input.Models
+
+	output.Operations.Total += input.Operations.Total
+	for method, count := range input.Operations.ByHTTPMethod {
+		output.Operations.ByHTTPMethod[method] += count
+	}
+	output.Operations.LongRunning += input.Operations.LongRunning
+	output.Operations.Paginated += input.Operations.Paginated
+	output.Operations.WithOptions += input.Operations.WithOptions
+
+	output.ResourceIDs.Total += input.ResourceIDs.Total
+	output.ResourceIDs.WithTerraformResource += input.ResourceIDs.WithTerraformResource
+	output.ResourceIDs.TerraformResources.Total += input.ResourceIDs.TerraformResources.Total
+	output.ResourceIDs.TerraformResources.GenerateCreate += input.ResourceIDs.TerraformResources.GenerateCreate
+	output.ResourceIDs.TerraformResources.GenerateRead += input.ResourceIDs.TerraformResources.GenerateRead
+	output.ResourceIDs.TerraformResources.GenerateUpdate += input.ResourceIDs.TerraformResources.GenerateUpdate
+	output.ResourceIDs.TerraformResources.GenerateDelete += input.ResourceIDs.TerraformResources.GenerateDelete
+	output.ResourceIDs.TerraformCoveragePercentage = coveragePercentage(output.ResourceIDs)
+}
+
+func coveragePercentage(input models.ResourceIDStatistics) float64 {
+	if input.Total == 0 {
+		return 0
+	}
+	return math.Round(float64(input.WithTerraformResource)*10000/float64(input.Total)) / 100
+}
+
+func emptyStatistics() models.Statistics {
+	return models.Statistics{
+		Operations: models.OperationStatistics{
+			ByHTTPMethod: make(map[string]int),
+		},
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestStatisticsForService(t *testing.T) {
+	service := models.Service{
+		APIVersions: map[string]models.APIVersion{
+			"2020-01-01": {
+				Resources: map[string]models.APIResource{
+					"Things": {
+						Constants: map[string]models.SDKConstant{
+							"SkuTier": {},
+						},
+						Models: map[string]models.SDKModel{
+							"Animal": {FieldNameContainingDiscriminatedValue: pointer.To("kind")},
+							"Cat":    {DiscriminatedValue: pointer.To("cat"), ParentTypeName: pointer.To("Animal")},
+							"Thing":  {},
+						},
+						Operations: map[string]models.SDKOperation{
+							"CreateOrUpdate": {Method: "PUT", LongRunning: true},
+							"Delete":         {Method: "DELETE", LongRunning: true},
+							"Get":            {Method: "GET"},
+							"List": {
+								Method:                           "get",
+								FieldContainingPaginationDetails: pointer.To("nextLink"),
+								Options: map[string]models.SDKOperationOption{
+									"Filter": {},
+								},
+							},
+						},
+						ResourceIDs: map[string]models.ResourceID{
+							"ThingId":         {},
+							"ResourceGroupId": {},
+						},
+					},
+				},
+			},
+			"2021-01-01": {
+				Resources: map[string]models.APIResource{
+					"Things": {
+						Operations: map[string]models.SDKOperation{
+							"Get": {Method: "GET"},
+						},
+						ResourceIDs: map[string]models.ResourceID{
+							"ThingId": {},
+						},
+					},
+				},
+			},
+		},
+		TerraformDefinition: &models.TerraformDefinition{
+			Resources: map[string]models.TerraformResourceDefinition{
+				"Thing": {
+					APIVersion:     "2020-01-01",
+					APIResource:    "Things",
+					ResourceIDName: "ThingId",
+					CreateMethod:   models.TerraformMethodDefinition{Generate: true},
+					ReadMethod:     models.TerraformMethodDefinition{Generate: true},
+					UpdateMethod:   &models.TerraformMethodDefinition{Generate: false},
+					DeleteMethod:   models.TerraformMethodDefinition{Generate: true},
+				},
+			},
+		},
+	}
+
+	actual := StatisticsForService(service)
+	expected := models.ServiceStatistics{
+		APIVersions: map[string]models.Statistics{
+			"2020-01-01": {
+				APIVersions:        1,
+				Constants:          1,
+				DiscriminatedTypes: 2,
+				Models:             3,
+				Operations: models.OperationStatistics{
+					Total:        4,
+					ByHTTPMethod: map[string]int{"DELETE": 1, "GET": 2, "PUT": 1},
+					LongRunning:  2,
+					Paginated:    1,
+					WithOptions:  1,
+				},
+				ResourceIDs: models.ResourceIDStatistics{
+					Total:                       2,
+					WithTerraformResource:       1,
+					TerraformCoveragePercentage: 50,
+					TerraformResources: models.TerraformResourceStatistics{
+						Total:          1,
+						GenerateCreate: 1,
+						GenerateRead:   1,
+						GenerateDelete: 1,
+					},
+				},
+			},
+			"2021-01-01": {
+				APIVersions: 1,
+				Operations: models.OperationStatistics{
+					Total:        1,
+					ByHTTPMethod: map[string]int{"GET": 1},
+				},
+				ResourceIDs: models.ResourceIDStatistics{
+					Total: 1,
+				},
+			},
+		},
+		Total: models.Statistics{
+			APIVersions:        2,
+			Constants:          1,
+			DiscriminatedTypes: 2,
+			Models:             3,
+			Operations: models.OperationStatistics{
+				Total:        5,
+				ByHTTPMethod: map[string]int{"DELETE": 1, "GET": 3, "PUT": 1},
+				LongRunning:  2,
+				Paginated:    1,
+				WithOptions:  1,
+			},
+			ResourceIDs: models.ResourceIDStatistics{
+				Total:                       3,
+				WithTerraformResource:       1,
+				TerraformCoveragePercentage: 33.33,
+				TerraformResources: models.TerraformResourceStatistics{
+					Total:          1,
+					GenerateCreate: 1,
+					GenerateRead:   1,
+					GenerateDelete: 1,
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+
+	aggregate := AggregateStatisticsForServices(map[string]models.ServiceStatistics{
+		"Example": actual,
+		"Other":   StatisticsForService(models.Service{}),
+	})
+	if !reflect.DeepEqual(aggregate.Total, expected.Total) {
+		t.Fatalf("expected the aggregate total %+v but got %+v", expected.Total, aggregate.Total)
+	}
+	if len(aggregate.Services) != 2 || aggregate.Services["Other"].APIVersions != 0 {
+		t.Fatalf("expected Statistics for the Services `Example` and `Other` but got %+v", aggregate.Services)
+	}
+}
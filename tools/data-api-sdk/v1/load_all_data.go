@@ -6,6 +6,7 @@ package v1
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 )
@@ -23,14 +24,32 @@ type LoadAllDataResult struct {
 	Services map[string]models.Service
 }
 
+// loadAllDataMaxConcurrency specifies the maximum number of Services which are retrieved concurrently when the
+// Data API doesn't support the export endpoint.
+const loadAllDataMaxConcurrency = 10
+
 // LoadAllData is a helper function which returns all information for a given SourceDataType from the Data API.
 // This allows implementations to rely on the entire set of source data being available, and means they don't
 // need to reimplement this logic.
 // This function assumes that the Data API is online and available, which can be checked via the Health function.
 //
+// Where supported by the Data API this data is retrieved in a single request using the Export function, otherwise
+// each Service is retrieved individually using a bounded number of concurrent requests.
+//
 // serviceNamesToLimitTo is an optional value allowing limiting the returned result to a subset of the available
 // services, primarily intended for debugging purposes.
 func (c *Client) LoadAllData(ctx context.Context, serviceNamesToLimitTo []string) (*LoadAllDataResult, error) {
+	c.logger.Debug("Exporting All Services..")
+	export, err := c.Export(ctx, serviceNamesToLimitTo)
+	if err != nil {
+		return nil, fmt.Errorf("exporting all services: %+v", err)
+	}
+	if export.Model != nil {
+		c.logger.Debug(fmt.Sprintf("Exported %d Services", len(export.Model.Services)))
+		return export.Model, nil
+	}
+	c.logger.Debug("The export endpoint isn't supported, retrieving each Service individually..")
+
 	allServices, err := c.GetAvailableServices(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("loading available services: %+v", err)
@@ -52,23 +71,93 @@ func (c *Client) LoadAllData(ctx context.Context, serviceNamesToLimitTo []string
 	result.CommonTypes.Constants = commonTypes.Model.Constants
 	result.CommonTypes.Models = commonTypes.Model.Models
 
-	c.logger.Debug("Retrieving All Services..")
+	servicesToLoad := make(map[string]AvailableServiceSummary)
 	for serviceName, serviceSummary := range allServices.Model.Services {
 		if len(serviceNamesToLimitTo) > 0 && !filteredServiceListContains(serviceNamesToLimitTo, serviceName) {
 			c.logger.Trace(fmt.Sprintf("Skipping Service %q since it's not in the list of services to retrieve data from", serviceName))
 			continue
 		}
+		servicesToLoad[serviceName] = serviceSummary
+	}
 
-		c.logger.Trace(fmt.Sprintf("Retrieving details for Service %q..", serviceName))
-		serviceDetails, err := c.loadAllDetailsForService(ctx, serviceSummary)
-		if err != nil {
-			return nil, fmt.Errorf("retrieving details for Service %q: %+v", serviceName, err)
+	c.logger.Debug(fmt.Sprintf("Retrieving %d Services..", len(servicesToLoad)))
+	services, err := c.loadAllServices(ctx, servicesToLoad)
+	if err != nil {
+		return nil, err
+	}
+	result.Services = *services
+
+	return &result, nil
+}
+
+// loadAllServices retrieves the details for each of the specified Services using a bounded pool of workers,
+// returning the first error encountered (at which point any outstanding requests are cancelled).
+func (c *Client) loadAllServices(ctx context.Context, servicesToLoad map[string]AvailableServiceSummary) (*map[string]models.Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type loadedService struct {
+		name    string
+		service *models.Service
+		err     error
+	}
+
+	serviceNames := make(chan string)
+	loaded := make(chan loadedService)
+
+	var wg sync.WaitGroup
+	workers := loadAllDataMaxConcurrency
+	if len(servicesToLoad) < workers {
+		workers = len(servicesToLoad)
+	}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for serviceName := range serviceNames {
+				c.logger.Trace(fmt.Sprintf("Retrieving details for Service %q..", serviceName))
+				service, err := c.loadAllDetailsForService(ctx, servicesToLoad[serviceName])
+				select {
+				case loaded <- loadedService{name: serviceName, service: service, err: err}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(serviceNames)
+		for serviceName := range servicesToLoad {
+			select {
+			case serviceNames <- serviceName:
+			case <-ctx.Done():
+				return
+			}
 		}
+	}()
 
-		result.Services[serviceName] = *serviceDetails
+	go func() {
+		wg.Wait()
+		close(loaded)
+	}()
+
+	output := make(map[string]models.Service)
+	for item := range loaded {
+		if item.err != nil {
+			return nil, fmt.Errorf("retrieving details for Service %q: %+v", item.name, item.err)
+		}
+
+		output[item.name] = *item.service
+		c.logger.Debug(fmt.Sprintf("Retrieved Service %q (%d/%d)", item.name, len(output), len(servicesToLoad)))
 	}
 
-	return &result, nil
+	// the workers stop early when the context is cancelled, so ensure that we don't return a partial result
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("retrieving Services: %+v", err)
+	}
+
+	return &output, nil
 }
 
 func (c *Client) loadAllDetailsForService(ctx context.Context, summary AvailableServiceSummary) (*models.Service, error) {
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// SDKReference describes an item (such as a Field within an SDKModel, or an SDKOperation) which references
+// an SDKConstant, SDKModel or ResourceID - either directly or transitively via one or more SDKModels/ResourceIDs.
+type SDKReference struct {
+	// Type specifies the type of item which contains this reference.
+	Type SDKReferenceType `json:"type"`
+
+	// ServiceName specifies the name of the Service containing this reference.
+	// This is only specified when searching for references to a Common Type, and is nil when the reference
+	// is contained within the Common Types themselves.
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// APIVersion specifies the API Version containing this reference.
+	// This is only specified when ServiceName is specified.
+	APIVersion *string `json:"apiVersion,omitempty"`
+
+	// APIResource specifies the name of the APIResource containing this reference.
+	// This is only specified when ServiceName is specified.
+	APIResource *string `json:"apiResource,omitempty"`
+
+	// ModelName specifies the name of the SDKModel containing this reference, when Type is
+	// ModelFieldSDKReferenceType or ModelParentTypeSDKReferenceType.
+	ModelName *string `json:"modelName,omitempty"`
+
+	// FieldName specifies the name of the SDKField containing this reference, when Type is ModelFieldSDKReferenceType.
+	FieldName *string `json:"fieldName,omitempty"`
+
+	// OperationName specifies the name of the SDKOperation containing this reference, when Type is one of
+	// the Operation reference types.
+	OperationName *string `json:"operationName,omitempty"`
+
+	// OptionName specifies the name of the SDKOperationOption containing this reference, when Type is
+	// OperationOptionSDKReferenceType.
+	OptionName *string `json:"optionName,omitempty"`
+
+	// ResourceIDName specifies the name of the ResourceID containing this reference, when Type is
+	// ResourceIDSegmentSDKReferenceType.
+	ResourceIDName *string `json:"resourceIdName,omitempty"`
+
+	// SegmentName specifies the name of the ResourceIDSegment containing this reference, when Type is
+	// ResourceIDSegmentSDKReferenceType.
+	SegmentName *string `json:"segmentName,omitempty"`
+
+	// Via specifies the names of the SDKModels/ResourceIDs through which this item references the target,
+	// ordered from the item referenced by this item through to the item which references the target.
+	// This is empty when this is a direct reference to the target.
+	Via []string `json:"via,omitempty"`
+}
+
+// IsDirect returns whether this is a direct reference to the target, rather than a transitive reference.
+func (r SDKReference) IsDirect() bool {
+	return len(r.Via) == 0
+}
@@ -0,0 +1,31 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// SDKReferenceType defines the type of item which contains an SDKReference.
+type SDKReferenceType string
+
+const (
+	// ModelFieldSDKReferenceType specifies that the reference is from a Field within an SDKModel.
+	ModelFieldSDKReferenceType SDKReferenceType = "ModelField"
+
+	// ModelParentTypeSDKReferenceType specifies that the reference is from a Discriminated Implementation
+	// to its Parent Type.
+	ModelParentTypeSDKReferenceType SDKReferenceType = "ModelParentType"
+
+	// OperationOptionSDKReferenceType specifies that the reference is from an SDKOperationOption.
+	OperationOptionSDKReferenceType SDKReferenceType = "OperationOption"
+
+	// OperationRequestSDKReferenceType specifies that the reference is from the Request Object of an SDKOperation.
+	OperationRequestSDKReferenceType SDKReferenceType = "OperationRequest"
+
+	// OperationResourceIDSDKReferenceType specifies that the reference is from the ResourceID used by an SDKOperation.
+	OperationResourceIDSDKReferenceType SDKReferenceType = "OperationResourceID"
+
+	// OperationResponseSDKReferenceType specifies that the reference is from the Response Object of an SDKOperation.
+	OperationResponseSDKReferenceType SDKReferenceType = "OperationResponse"
+
+	// ResourceIDSegmentSDKReferenceType specifies that the reference is from a Constant Segment within a ResourceID.
+	ResourceIDSegmentSDKReferenceType SDKReferenceType = "ResourceIDSegment"
+)
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package models
+
+// Statistics describes the number of Operations, Models, Constants and Resource IDs within one or more API Versions -
+// and how many of those Resource IDs have a TerraformResourceDefinition defined for them.
+type Statistics struct {
+	// APIVersions specifies the number of API Versions these Statistics were calculated from.
+	APIVersions int `json:"apiVersions"`
+
+	// Constants specifies the number of SDKConstants.
+	Constants int `json:"constants"`
+
+	// DiscriminatedTypes specifies the number of SDKModels which are either the Parent Type or an Implementation
+	// of a Discriminated Type.
+	DiscriminatedTypes int `json:"discriminatedTypes"`
+
+	// Models specifies the number of SDKModels.
+	Models int `json:"models"`
+
+	// Operations contains the Statistics for the SDKOperations.
+	Operations OperationStatistics `json:"operations"`
+
+	// ResourceIDs contains the Statistics for the ResourceIDs.
+	ResourceIDs ResourceIDStatistics `json:"resourceIds"`
+}
+
+// OperationStatistics describes the number (and kinds) of SDKOperations within one or more API Versions.
+type OperationStatistics struct {
+	// Total specifies the total number of SDKOperations.
+	Total int `json:"total"`
+
+	// ByHTTPMethod is a map of the HTTP Method (key, e.g. `GET`) to the number of SDKOperations using it (value).
+	ByHTTPMethod map[string]int `json:"byHttpMethod"`
+
+	// LongRunning specifies the number of SDKOperations which are Long Running.
+	LongRunning int `json:"longRunning"`
+
+	// Paginated specifies the number of SDKOperations which are paginated List Operations.
+	Paginated int `json:"paginated"`
+
+	// WithOptions specifies the number of SDKOperations which have one or more Options.
+	WithOptions int `json:"withOptions"`
+}
+
+// ResourceIDStatistics describes the number of ResourceIDs within one or more API Versions, and how many of
+// these have a TerraformResourceDefinition defined for them.
+type ResourceIDStatistics struct {
+	// Total specifies the total number of ResourceIDs.
+	Total int `json:"total"`
+
+	// WithTerraformResource specifies the number of ResourceIDs which have at least one TerraformResourceDefinition.
+	WithTerraformResource int `json:"withTerraformResource"`
+
+	// TerraformCoveragePercentage specifies the percentage (rounded to 2 decimal places) of ResourceIDs which
+	// have at least one TerraformResourceDefinition.
+	TerraformCoveragePercentage float64 `json:"terraformCoveragePercentage"`
+
+	// TerraformResources contains the Statistics for the TerraformResourceDefinitions for these ResourceIDs.
+	TerraformResources TerraformResourceStatistics `json:"terraformResources"`
+}
+
+// TerraformResourceStatistics describes the number of TerraformResourceDefinitions, and how many of these have
+// Generate enabled for each of the Create, Read, Update and Delete methods.
+type TerraformResourceStatistics struct {
+	// Total specifies the total number of TerraformResourceDefinitions.
+	Total int `json:"total"`
+
+	// GenerateCreate specifies the number of TerraformResourceDefinitions where the Create method is generated.
+	GenerateCreate int `json:"generateCreate"`
+
+	// GenerateRead specifies the number of TerraformResourceDefinitions where the Read method is generated.
+	GenerateRead int `json:"generateRead"`
+
+	// GenerateUpdate specifies the number of TerraformResourceDefinitions where the Update method is generated.
+	// TerraformResourceDefinitions without an Update method are not included.
+	GenerateUpdate int `json:"generateUpdate"`
+
+	// GenerateDelete specifies the number of TerraformResourceDefinitions where the Delete method is generated.
+	GenerateDelete int `json:"generateDelete"`
+}
+
+// ServiceStatistics contains the Statistics for each API Version within a Service.
+type ServiceStatistics struct {
+	// APIVersions is a map of the API Version (key) to the Statistics for that API Version (value).
+	APIVersions map[string]Statistics `json:"apiVersions"`
+
+	// Total contains the sum of the Statistics for every API Version within this Service.
+	Total Statistics `json:"total"`
+}
+
+// AggregateStatistics contains the Statistics for each Service within a Source Data Type.
+type AggregateStatistics struct {
+	// Services is a map of the Service Name (key) to the sum of the Statistics for every API Version within
+	// that Service (value).
+	Services map[string]Statistics `json:"services"`
+
+	// Total contains the sum of the Statistics for every Service.
+	Total Statistics `json:"total"`
+}
@@ -47,7 +47,16 @@ type TerraformSchemaField struct {
 }
 
 func (f *TerraformSchemaField) UnmarshalJSON(bytes []byte) error {
-	type alias TerraformSchemaField
+	// NOTE: Validation is an interface, so is unmarshaled separately below
+	type alias struct {
+		Computed         bool                                        `json:"computed"`
+		Documentation    TerraformSchemaFieldDocumentationDefinition `json:"documentation"`
+		ForceNew         bool                                        `json:"forceNew"`
+		HCLName          string                                      `json:"hclName"`
+		ObjectDefinition TerraformSchemaObjectDefinition             `json:"objectDefinition"`
+		Optional         bool                                        `json:"optional"`
+		Required         bool                                        `json:"required"`
+	}
 	var decoded alias
 	if err := json.Unmarshal(bytes, &decoded); err != nil {
 		return fmt.Errorf("unmarshaling into TerraformSchemaField: %+v", err)
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// openAPIVersion is the version of the OpenAPI Specification used for each Document.
+const openAPIVersion = "3.1.0"
+
+// DocumentForAPIVersion returns a normalized OpenAPI 3.1 Document describing the Operations, Models, Constants and
+// Resource IDs within the specified API Version of a Service.
+//
+// Constants and Models with the same name and definition in multiple API Resources are output once - where the
+// definitions differ the Schema for the latter is prefixed with the name of the API Resource. Any Common Types
+// referenced within the API Version are included in the Document.
+//
+// Discriminated Types are output using `oneOf` and `discriminator`, Long Running Operations are marked using the
+// `x-ms-long-running-operation` extension and paginated Operations are marked using the `x-ms-pageable` extension.
+func DocumentForAPIVersion(sourceDataType models.SourceDataType, serviceName, apiVersionName string, apiVersion models.APIVersion, commonTypes models.CommonTypes) (*Document, error) {
+	b := builder{
+		components:  map[string]*Schema{},
+		definitions: map[string]interface{}{},
+		commonTypes: &scope{
+			constants: commonTypes.Constants,
+			models:    commonTypes.Models,
+			prefix:    "Common",
+			typeNames: map[string]string{},
+		},
+	}
+
+	scopes := make([]*scope, 0)
+	for _, resourceName := range sortedKeys(apiVersion.Resources) {
+		resource := apiVersion.Resources[resourceName]
+		s := &scope{
+			name:        resourceName,
+			constants:   resource.Constants,
+			models:      resource.Models,
+			operations:  resource.Operations,
+			resourceIDs: resource.ResourceIDs,
+			prefix:      resourceName,
+			typeNames:   map[string]string{},
+		}
+		for _, constantName := range sortedKeys(s.constants) {
+			b.assignComponentName(s, constantName, s.constants[constantName])
+		}
+		for _, modelName := range sortedKeys(s.models) {
+			b.assignComponentName(s, modelName, s.models[modelName])
+		}
+		scopes = append(scopes, s)
+	}
+
+	document := Document{
+		OpenAPI: openAPIVersion,
+		Info: Info{
+			Title:   serviceName,
+			Version: apiVersionName,
+		},
+		Paths:    map[string]PathItem{},
+		XMSPaths: map[string]PathItem{},
+	}
+
+	for _, s := range scopes {
+		if err := b.buildSchemasForScope(s); err != nil {
+			return nil, fmt.Errorf("building schemas for API Resource %q: %+v", s.name, err)
+		}
+
+		for _, operationName := range sortedKeys(s.operations) {
+			path, method, operation, err := b.operation(s, operationName, s.operations[operationName], sourceDataType, apiVersionName)
+			if err != nil {
+				return nil, fmt.Errorf("building Operation %q for API Resource %q: %+v", operationName, s.name, err)
+			}
+
+			if _, exists := document.Paths[path][method]; exists {
+				// OpenAPI requires that the Path and HTTP Method are unique - so output any duplicates using `x-ms-paths`
+				path = fmt.Sprintf("%s?operationId=%s", path, operation.OperationID)
+				addOperation(document.XMSPaths, path, method, *operation)
+				continue
+			}
+			addOperation(document.Paths, path, method, *operation)
+		}
+	}
+
+	// Common Types are only output when they're referenced, which can be (transitively) from other Common Types
+	for len(b.pendingCommonTypes) > 0 {
+		typeName := b.pendingCommonTypes[0]
+		b.pendingCommonTypes = b.pendingCommonTypes[1:]
+		if err := b.buildSchema(b.commonTypes, typeName); err != nil {
+			return nil, fmt.Errorf("building schema for Common Type %q: %+v", typeName, err)
+		}
+	}
+
+	document.Components = Components{
+		Schemas: b.components,
+	}
+	return &document, nil
+}
+
+// scope is a set of Constants and Models which reference one another by name, such as an API Resource or the
+// Common Types.
+type scope struct {
+	name        string
+	constants   map[string]models.SDKConstant
+	models      map[string]models.SDKModel
+	operations  map[string]models.SDKOperation
+	resourceIDs map[string]models.ResourceID
+
+	// prefix is prepended to the name of a Constant or Model to make the Schema Name unique, where a different
+	// Constant or Model of the same name exists in another scope.
+	prefix string
+
+	// typeNames is a map of the Constant/Model Name (key) to the Schema Name (value) within the Document.
+	typeNames map[string]string
+}
+
+type builder struct {
+	// components is a map of the Schema Name (key) to the Schema (value) for each Constant and Model.
+	components map[string]*Schema
+
+	// definitions is a map of Schema Name (key) to the SDKConstant or SDKModel (value) which it describes, used to
+	// deduplicate Constants and Models which are defined in multiple API Resources.
+	definitions map[string]interface{}
+
+	commonTypes *scope
+
+	// pendingCommonTypes is a list of the Common Types which have been referenced but not yet output.
+	pendingCommonTypes []string
+}
+
+// assignComponentName assigns a unique Schema Name to the Constant/Model typeName within s, reusing an existing
+// Schema where an identical Constant/Model has already been output.
+func (b *builder) assignComponentName(s *scope, typeName string, definition interface{}) string {
+	candidate := typeName
+	for i := 0; ; i++ {
+		if i == 1 {
+			candidate = fmt.Sprintf("%s%s", s.prefix, typeName)
+		} else if i > 1 {
+			candidate = fmt.Sprintf("%s%s%d", s.prefix, typeName, i)
+		}
+
+		existing, ok := b.definitions[candidate]
+		if !ok {
+			b.definitions[candidate] = definition
+			s.typeNames[typeName] = candidate
+			return candidate
+		}
+		if reflect.DeepEqual(existing, definition) {
+			s.typeNames[typeName] = candidate
+			return candidate
+		}
+	}
+}
+
+// resolveReference returns the Schema Name for the Constant/Model referenced as typeName within s, falling back
+// to the Common Types when this isn't defined within s. Common Types are assigned a Schema Name (and queued to be
+// output) when they're first referenced.
+func (b *builder) resolveReference(s *scope, typeName string) (string, error) {
+	if componentName, ok := s.typeNames[typeName]; ok {
+		return componentName, nil
+	}
+	if componentName, ok := b.commonTypes.typeNames[typeName]; ok {
+		return componentName, nil
+	}
+
+	if constant, ok := b.commonTypes.constants[typeName]; ok {
+		b.pendingCommonTypes = append(b.pendingCommonTypes, typeName)
+		return b.assignComponentName(b.commonTypes, typeName, constant), nil
+	}
+	if model, ok := b.commonTypes.models[typeName]; ok {
+		b.pendingCommonTypes = append(b.pendingCommonTypes, typeName)
+		return b.assignComponentName(b.commonTypes, typeName, model), nil
+	}
+
+	return "", fmt.Errorf("reference %q was not found as a Constant or Model", typeName)
+}
+
+func (b *builder) buildSchemasForScope(s *scope) error {
+	for _, typeName := range sortedKeys(s.typeNames) {
+		if err := b.buildSchema(s, typeName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// buildSchema outputs the Schema for the Constant/Model typeName within s, unless an identical Schema has already
+// been output.
+func (b *builder) buildSchema(s *scope, typeName string) error {
+	componentName := s.typeNames[typeName]
+	if _, ok := b.components[componentName]; ok {
+		return nil
+	}
+
+	if constant, ok := s.constants[typeName]; ok {
+		b.components[componentName] = schemaForConstant(typeName, constant)
+		return nil
+	}
+
+	model, ok := s.models[typeName]
+	if !ok {
+		return fmt.Errorf("%q was not found as a Constant or Model", typeName)
+	}
+	schema, err := b.schemaForModel(s, typeName, model)
+	if err != nil {
+		return fmt.Errorf("building schema for Model %q: %+v", typeName, err)
+	}
+	b.components[componentName] = schema
+	return nil
+}
+
+func addOperation(paths map[string]PathItem, path, method string, operation Operation) {
+	if _, ok := paths[path]; !ok {
+		paths[path] = PathItem{}
+	}
+	paths[path][method] = operation
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	output := make([]string, 0, len(input))
+	for key := range input {
+		output = append(output, key)
+	}
+	sort.Strings(output)
+	return output
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestDocumentForAPIVersion(t *testing.T) {
+	reference := func(name string) *models.SDKObjectDefinition {
+		return &models.SDKObjectDefinition{Type: models.ReferenceSDKObjectDefinitionType, ReferenceName: pointer.To(name)}
+	}
+	stringField := func(jsonName string, required bool) models.SDKField {
+		return models.SDKField{JsonName: jsonName, Required: required, ObjectDefinition: models.SDKObjectDefinition{Type: models.StringSDKObjectDefinitionType}}
+	}
+	resourceID := models.ResourceID{
+		Segments: []models.ResourceIDSegment{
+			{Name: "staticSubscriptions", Type: models.StaticResourceIDSegmentType, FixedValue: pointer.To("subscriptions")},
+			{Name: "subscriptionId", Type: models.SubscriptionIDResourceIDSegmentType},
+			{Name: "staticProviders", Type: models.StaticResourceIDSegmentType, FixedValue: pointer.To("providers")},
+			{Name: "staticMicrosoftExample", Type: models.ResourceProviderResourceIDSegmentType, FixedValue: pointer.To("Microsoft.Example")},
+			{Name: "staticThings", Type: models.StaticResourceIDSegmentType, FixedValue: pointer.To("things")},
+			{Name: "thingName", Type: models.UserSpecifiedResourceIDSegmentType},
+		},
+	}
+
+	apiVersion := models.APIVersion{
+		Resources: map[string]models.APIResource{
+			"Animals": {
+				Constants: map[string]models.SDKConstant{
+					"Sku": {Type: models.StringSDKConstantType, Values: map[string]string{"Basic": "basic"}},
+				},
+				Models: map[string]models.SDKModel{
+					"Animal": {
+						FieldNameContainingDiscriminatedValue: pointer.To("Kind"),
+						Fields: map[string]models.SDKField{
+							"Kind": {JsonName: "kind", Required: true, ContainsDiscriminatedValue: true, ObjectDefinition: models.SDKObjectDefinition{Type: models.StringSDKObjectDefinitionType}},
+							"Name": stringField("name", false),
+						},
+					},
+					"Cat": {
+						DiscriminatedValue: pointer.To("cat"),
+						ParentTypeName:     pointer.To("Animal"),
+						Fields: map[string]models.SDKField{
+							"Lives": {JsonName: "lives", ObjectDefinition: models.SDKObjectDefinition{Type: models.IntegerSDKObjectDefinitionType}},
+						},
+					},
+				},
+				Operations: map[string]models.SDKOperation{
+					"CreateOrUpdate": {
+						ContentType:         "application/json; charset=utf-8",
+						ExpectedStatusCodes: []int{200, 201},
+						LongRunning:         true,
+						Method:              "PUT",
+						RequestObject:       reference("Animal"),
+						ResourceIDName:      pointer.To("ThingId"),
+					},
+				},
+				ResourceIDs: map[string]models.ResourceID{
+					"ThingId": resourceID,
+				},
+			},
+			"Things": {
+				Constants: map[string]models.SDKConstant{
+					"Sku": {Type: models.StringSDKConstantType, Values: map[string]string{"Premium": "premium"}},
+				},
+				Models: map[string]models.SDKModel{
+					"Thing": {
+						Fields: map[string]models.SDKField{
+							"Location": {JsonName: "location", ObjectDefinition: models.SDKObjectDefinition{Type: models.LocationSDKObjectDefinitionType}},
+							"Sku":      {JsonName: "sku", ObjectDefinition: *reference("Sku")},
+							"Common":   {JsonName: "common", ObjectDefinition: *reference("CommonModel")},
+						},
+					},
+				},
+				Operations: map[string]models.SDKOperation{
+					"CreateOrUpdate": {
+						ExpectedStatusCodes: []int{200},
+						Method:              "PUT",
+						RequestObject:       reference("Thing"),
+						ResourceIDName:      pointer.To("ThingId"),
+					},
+					"List": {
+						ExpectedStatusCodes:              []int{200},
+						FieldContainingPaginationDetails: pointer.To("nextLink"),
+						Method:                           "GET",
+						ResponseObject:                   reference("Thing"),
+						URISuffix:                        pointer.To("/list"),
+						ResourceIDName:                   pointer.To("ThingId"),
+					},
+				},
+				ResourceIDs: map[string]models.ResourceID{
+					"ThingId": resourceID,
+				},
+			},
+		},
+	}
+	commonTypes := models.CommonTypes{
+		Models: map[string]models.SDKModel{
+			"CommonModel": {
+				Fields: map[string]models.SDKField{
+					"Value": stringField("value", false),
+				},
+			},
+			"UnusedModel": {},
+		},
+	}
+
+	document, err := DocumentForAPIVersion(models.ResourceManagerSourceDataType, "Example", "2020-01-01", apiVersion, commonTypes)
+	if err != nil {
+		t.Fatalf("building document: %+v", err)
+	}
+
+	expectedSchemas := []string{"Animal", "Cat", "CommonModel", "Sku", "Thing", "ThingsSku"}
+	if actual := sortedKeys(document.Components.Schemas); !reflect.DeepEqual(actual, expectedSchemas) {
+		t.Fatalf("expected the schemas %v but got %v", expectedSchemas, actual)
+	}
+	if ref := document.Components.Schemas["Thing"].Properties["sku"].Ref; ref != "#/components/schemas/ThingsSku" {
+		t.Fatalf("expected `Thing.sku` to reference the prefixed Constant but got %q", ref)
+	}
+
+	animal := document.Components.Schemas["Animal"]
+	if animal.Discriminator == nil || animal.Discriminator.PropertyName != "kind" || animal.Discriminator.Mapping["cat"] != "#/components/schemas/Cat" {
+		t.Fatalf("expected a discriminator mapping `cat` to `Cat` but got %+v", animal.Discriminator)
+	}
+	if len(animal.OneOf) != 1 || animal.OneOf[0].Ref != "#/components/schemas/Cat" {
+		t.Fatalf("expected `Animal` to be oneOf `Cat` but got %+v", animal.OneOf)
+	}
+	cat := document.Components.Schemas["Cat"]
+	if _, ok := cat.Properties["name"]; !ok {
+		t.Fatalf("expected `Cat` to include the Fields from `Animal`")
+	}
+	if cat.Properties["kind"].Const != "cat" {
+		t.Fatalf("expected `Cat.kind` to be the const `cat` but got %v", cat.Properties["kind"].Const)
+	}
+
+	path := "/subscriptions/{subscriptionId}/providers/Microsoft.Example/things/{thingName}"
+	create, ok := document.Paths[path]["put"]
+	if !ok {
+		t.Fatalf("expected a PUT operation for %q", path)
+	}
+	if create.OperationID != "Animals_CreateOrUpdate" || !create.XMSLongRunningOperation {
+		t.Fatalf("expected `Animals_CreateOrUpdate` to be a Long Running Operation but got %+v", create)
+	}
+	if _, ok := create.RequestBody.Content["application/json"]; !ok {
+		t.Fatalf("expected the Request Body to use the Media Type `application/json`")
+	}
+	parameterNames := make([]string, 0)
+	for _, parameter := range create.Parameters {
+		parameterNames = append(parameterNames, parameter.Name)
+	}
+	if expected := []string{"subscriptionId", "thingName", "api-version"}; !reflect.DeepEqual(parameterNames, expected) {
+		t.Fatalf("expected the parameters %v but got %v", expected, parameterNames)
+	}
+	if _, ok := document.XMSPaths[path+"?operationId=Things_CreateOrUpdate"]["put"]; !ok {
+		t.Fatalf("expected the duplicate PUT operation to be output within `x-ms-paths`")
+	}
+
+	list := document.Paths[path+"/list"]["get"]
+	if list.XMSPageable == nil || list.XMSPageable.NextLinkName != "nextLink" {
+		t.Fatalf("expected `Things_List` to be pageable but got %+v", list.XMSPageable)
+	}
+	if items := list.Responses["200"].Content["application/json"].Schema.Properties["value"].Items; items == nil || items.Ref != "#/components/schemas/Thing" {
+		t.Fatalf("expected each page to contain a list of `Thing` but got %+v", items)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+// Document is an OpenAPI 3.1 Document, containing the subset of the OpenAPI Specification needed to describe
+// the API Definitions.
+type Document struct {
+	// OpenAPI specifies the version of the OpenAPI Specification used by this Document.
+	OpenAPI string `json:"openapi"`
+
+	// Info specifies metadata about the API described by this Document.
+	Info Info `json:"info"`
+
+	// Paths is a map of Path (key) to the PathItem (value) describing the Operations available at that Path.
+	Paths map[string]PathItem `json:"paths"`
+
+	// XMSPaths is a map of Path (key) to the PathItem (value) for Operations whose Path and HTTP Method are the
+	// same as another Operation. Since OpenAPI requires that these are unique, each Path here is suffixed with a
+	// query string containing the Operation ID to disambiguate it.
+	XMSPaths map[string]PathItem `json:"x-ms-paths,omitempty"`
+
+	// Components contains the reusable Schemas referenced from within this Document.
+	Components Components `json:"components"`
+}
+
+type Info struct {
+	// Title is the title of the API, which is the Service Name.
+	Title string `json:"title"`
+
+	// Version is the version of the API, which is the API Version.
+	Version string `json:"version"`
+}
+
+// PathItem is a map of HTTP Method in lower-case (key) to the Operation (value).
+type PathItem map[string]Operation
+
+type Operation struct {
+	OperationID string       `json:"operationId"`
+	Tags        []string     `json:"tags,omitempty"`
+	Parameters  []Parameter  `json:"parameters,omitempty"`
+	RequestBody *RequestBody `json:"requestBody,omitempty"`
+
+	// Responses is a map of HTTP Status Code (key) to the Response (value).
+	Responses map[string]Response `json:"responses"`
+
+	// XMSLongRunningOperation specifies that this is a Long Running Operation.
+	XMSLongRunningOperation bool `json:"x-ms-long-running-operation,omitempty"`
+
+	// XMSPageable specifies that this Operation is paginated, and how to retrieve the next page.
+	XMSPageable *Pageable `json:"x-ms-pageable,omitempty"`
+}
+
+type Pageable struct {
+	// ItemName is the name of the property containing the items within each page.
+	ItemName string `json:"itemName"`
+
+	// NextLinkName is the name of the property containing the link to the next page.
+	NextLinkName string `json:"nextLinkName"`
+}
+
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required"`
+	Schema   *Schema `json:"schema"`
+
+	// XMSSkipURLEncoding specifies that the value of this (path) Parameter must not be URL Encoded, which is the
+	// case for Scopes which contain a Resource ID.
+	XMSSkipURLEncoding bool `json:"x-ms-skip-url-encoding,omitempty"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	// Schemas is a map of Schema Name (key) to Schema (value) for each Constant and Model within this Document.
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+type Schema struct {
+	Ref         string        `json:"$ref,omitempty"`
+	Type        string        `json:"type,omitempty"`
+	Format      string        `json:"format,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Const       interface{}   `json:"const,omitempty"`
+	Enum        []interface{} `json:"enum,omitempty"`
+	ReadOnly    bool          `json:"readOnly,omitempty"`
+
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+
+	OneOf         []*Schema      `json:"oneOf,omitempty"`
+	Discriminator *Discriminator `json:"discriminator,omitempty"`
+
+	// XMSEnum describes the names of each value within an enum.
+	XMSEnum *Enum `json:"x-ms-enum,omitempty"`
+
+	// XMSSecret specifies that the value of this property is sensitive.
+	XMSSecret bool `json:"x-ms-secret,omitempty"`
+
+	// XPandoraCSV specifies that this array is serialized as a comma-separated string.
+	XPandoraCSV bool `json:"x-pandora-csv,omitempty"`
+
+	// XPandoraType specifies the type used within the API Definitions, for types (such as a Location or Tags)
+	// which have special handling in the SDK.
+	XPandoraType string `json:"x-pandora-type,omitempty"`
+}
+
+type Discriminator struct {
+	// PropertyName is the (JSON) name of the property containing the Discriminated Value.
+	PropertyName string `json:"propertyName"`
+
+	// Mapping is a map of Discriminated Value (key) to the Reference to the Schema (value) for that implementation.
+	Mapping map[string]string `json:"mapping"`
+}
+
+type Enum struct {
+	Name          string      `json:"name"`
+	ModelAsString bool        `json:"modelAsString"`
+	Values        []EnumValue `json:"values"`
+}
+
+type EnumValue struct {
+	Name  string      `json:"name"`
+	Value interface{} `json:"value"`
+}
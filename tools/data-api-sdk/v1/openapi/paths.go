@@ -0,0 +1,205 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// operation returns the Path, HTTP Method (in lower-case) and OpenAPI Operation for the specified SDKOperation.
+func (b *builder) operation(s *scope, operationName string, input models.SDKOperation, sourceDataType models.SourceDataType, apiVersion string) (string, string, *Operation, error) {
+	output := Operation{
+		OperationID: fmt.Sprintf("%s_%s", s.name, operationName),
+		Tags:        []string{s.name},
+		Parameters:  make([]Parameter, 0),
+		Responses:   map[string]Response{},
+
+		XMSLongRunningOperation: input.LongRunning,
+	}
+
+	path := ""
+	if input.ResourceIDName != nil {
+		resourceID, ok := s.resourceIDs[*input.ResourceIDName]
+		if !ok {
+			return "", "", nil, fmt.Errorf("the Resource ID %q was not found", *input.ResourceIDName)
+		}
+
+		resourceIDPath, parameters, err := b.pathForResourceID(s, resourceID)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("building path for Resource ID %q: %+v", *input.ResourceIDName, err)
+		}
+		path = resourceIDPath
+		output.Parameters = append(output.Parameters, parameters...)
+	}
+	if input.URISuffix != nil {
+		path += *input.URISuffix
+	}
+	if path == "" {
+		path = "/"
+	}
+
+	if sourceDataType == models.ResourceManagerSourceDataType {
+		output.Parameters = append(output.Parameters, Parameter{
+			Name:     "api-version",
+			In:       "query",
+			Required: true,
+			Schema: &Schema{
+				Type:  "string",
+				Const: apiVersion,
+			},
+		})
+	}
+
+	for _, optionName := range sortedKeys(input.Options) {
+		option := input.Options[optionName]
+		schema, err := b.schemaForOptionObjectDefinition(s, option.ObjectDefinition)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("building schema for Option %q: %+v", optionName, err)
+		}
+
+		parameter := Parameter{
+			Required: option.Required,
+			Schema:   schema,
+		}
+		switch {
+		case option.HeaderName != nil:
+			parameter.Name = *option.HeaderName
+			parameter.In = "header"
+		case option.QueryStringName != nil:
+			parameter.Name = *option.QueryStringName
+			parameter.In = "query"
+		default:
+			return "", "", nil, fmt.Errorf("the Option %q has neither a Header Name nor a Query String Name", optionName)
+		}
+		output.Parameters = append(output.Parameters, parameter)
+	}
+
+	contentType := mediaTypeForContentType(input.ContentType)
+	if input.RequestObject != nil {
+		schema, err := b.schemaForObjectDefinition(s, *input.RequestObject)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("building schema for the Request Object: %+v", err)
+		}
+		output.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]MediaType{
+				contentType: {
+					Schema: schema,
+				},
+			},
+		}
+	}
+
+	var responseSchema *Schema
+	if input.ResponseObject != nil {
+		schema, err := b.schemaForObjectDefinition(s, *input.ResponseObject)
+		if err != nil {
+			return "", "", nil, fmt.Errorf("building schema for the Response Object: %+v", err)
+		}
+		responseSchema = schema
+
+		if input.FieldContainingPaginationDetails != nil {
+			// for paginated Operations the Response Object describes each item, which is returned within a page
+			output.XMSPageable = &Pageable{
+				ItemName:     "value",
+				NextLinkName: *input.FieldContainingPaginationDetails,
+			}
+			responseSchema = &Schema{
+				Type: "object",
+				Properties: map[string]*Schema{
+					"value": {
+						Type:  "array",
+						Items: schema,
+					},
+					*input.FieldContainingPaginationDetails: {
+						Type: "string",
+					},
+				},
+			}
+		}
+	}
+
+	for _, statusCode := range input.ExpectedStatusCodes {
+		response := Response{
+			Description: http.StatusText(statusCode),
+		}
+		if responseSchema != nil && statusCode != http.StatusNoContent {
+			response.Content = map[string]MediaType{
+				contentType: {
+					Schema: responseSchema,
+				},
+			}
+		}
+		output.Responses[strconv.Itoa(statusCode)] = response
+	}
+
+	return path, strings.ToLower(input.Method), &output, nil
+}
+
+// pathForResourceID returns the (templated) Path for the specified ResourceID, along with the Path Parameters
+// for each of the user-specified segments within it.
+func (b *builder) pathForResourceID(s *scope, input models.ResourceID) (string, []Parameter, error) {
+	segments := make([]string, 0)
+	parameters := make([]Parameter, 0)
+	for _, segment := range input.Segments {
+		switch segment.Type {
+		case models.ResourceProviderResourceIDSegmentType, models.StaticResourceIDSegmentType:
+			if segment.FixedValue == nil {
+				return "", nil, fmt.Errorf("a Fixed Value is required for the %q segment %q", string(segment.Type), segment.Name)
+			}
+			segments = append(segments, *segment.FixedValue)
+			continue
+		}
+
+		parameter := Parameter{
+			Name:     segment.Name,
+			In:       "path",
+			Required: true,
+			Schema: &Schema{
+				Type: "string",
+			},
+		}
+
+		switch segment.Type {
+		case models.ConstantResourceIDSegmentType:
+			if segment.ConstantReference == nil {
+				return "", nil, fmt.Errorf("a Constant Reference is required for the Constant segment %q", segment.Name)
+			}
+			componentName, err := b.resolveReference(s, *segment.ConstantReference)
+			if err != nil {
+				return "", nil, fmt.Errorf("resolving the Constant for segment %q: %+v", segment.Name, err)
+			}
+			parameter.Schema = &Schema{
+				Ref: fmt.Sprintf("#/components/schemas/%s", componentName),
+			}
+
+		case models.ScopeResourceIDSegmentType:
+			// a Scope is itself a Resource ID, so mustn't be URL Encoded
+			parameter.XMSSkipURLEncoding = true
+		}
+
+		segments = append(segments, fmt.Sprintf("{%s}", segment.Name))
+		parameters = append(parameters, parameter)
+	}
+
+	return fmt.Sprintf("/%s", strings.Join(segments, "/")), parameters, nil
+}
+
+// mediaTypeForContentType returns the Media Type for the Content Type used by an Operation, which omits any
+// parameters (e.g. `application/json; charset=utf-8` is `application/json`).
+func mediaTypeForContentType(input string) string {
+	if input == "" {
+		return "application/json"
+	}
+	if mediaType, _, err := mime.ParseMediaType(input); err == nil {
+		return mediaType
+	}
+	return input
+}
@@ -0,0 +1,270 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func schemaForConstant(constantName string, constant models.SDKConstant) *Schema {
+	schema := Schema{
+		Type: "string",
+		XMSEnum: &Enum{
+			Name:          constantName,
+			ModelAsString: true,
+			Values:        make([]EnumValue, 0),
+		},
+	}
+	parseValue := func(input string) interface{} {
+		return input
+	}
+
+	switch constant.Type {
+	case models.FloatSDKConstantType:
+		schema.Type = "number"
+		parseValue = func(input string) interface{} {
+			if v, err := strconv.ParseFloat(input, 64); err == nil {
+				return v
+			}
+			return input
+		}
+
+	case models.IntegerSDKConstantType:
+		schema.Type = "integer"
+		parseValue = func(input string) interface{} {
+			if v, err := strconv.ParseInt(input, 10, 64); err == nil {
+				return v
+			}
+			return input
+		}
+	}
+
+	for _, key := range sortedKeys(constant.Values) {
+		value := parseValue(constant.Values[key])
+		schema.Enum = append(schema.Enum, value)
+		schema.XMSEnum.Values = append(schema.XMSEnum.Values, EnumValue{
+			Name:  key,
+			Value: value,
+		})
+	}
+
+	return &schema
+}
+
+// schemaForModel returns the Schema for the Model modelName within s. Discriminated Parent Types are output
+// including a `oneOf` and `discriminator` for each Discriminated Implementation - and Discriminated Implementations
+// are output including the Fields from their Parent Type(s), with the Discriminated Value as a `const`.
+func (b *builder) schemaForModel(s *scope, modelName string, model models.SDKModel) (*Schema, error) {
+	schema := Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{},
+	}
+
+	// Discriminated Implementations contain only their own Fields, so the Fields from the Parent Type(s) are included
+	fields := make(map[string]models.SDKField)
+	seenParentTypes := map[string]struct{}{}
+	for parentTypeName := model.ParentTypeName; parentTypeName != nil; {
+		if _, seen := seenParentTypes[*parentTypeName]; seen {
+			return nil, fmt.Errorf("parent type %q is circular", *parentTypeName)
+		}
+		seenParentTypes[*parentTypeName] = struct{}{}
+
+		parent, ok := b.lookupModel(s, *parentTypeName)
+		if !ok {
+			return nil, fmt.Errorf("parent type %q was not found", *parentTypeName)
+		}
+		for fieldName, field := range parent.Fields {
+			if _, exists := fields[fieldName]; !exists {
+				fields[fieldName] = field
+			}
+		}
+		parentTypeName = parent.ParentTypeName
+	}
+	for fieldName, field := range model.Fields {
+		fields[fieldName] = field
+	}
+
+	for _, fieldName := range sortedKeys(fields) {
+		field := fields[fieldName]
+		property, err := b.schemaForObjectDefinition(s, field.ObjectDefinition)
+		if err != nil {
+			return nil, fmt.Errorf("building schema for Field %q: %+v", fieldName, err)
+		}
+		property.Description = field.Description
+		property.ReadOnly = field.ReadOnly
+		property.XMSSecret = field.Sensitive
+		if field.ContainsDiscriminatedValue && model.DiscriminatedValue != nil {
+			property.Const = *model.DiscriminatedValue
+		}
+
+		schema.Properties[field.JsonName] = property
+		if field.Required {
+			schema.Required = append(schema.Required, field.JsonName)
+		}
+	}
+
+	if model.FieldNameContainingDiscriminatedValue != nil && model.ParentTypeName == nil {
+		field, ok := model.Fields[*model.FieldNameContainingDiscriminatedValue]
+		if !ok {
+			return nil, fmt.Errorf("the Field %q containing the Discriminated Value was not found", *model.FieldNameContainingDiscriminatedValue)
+		}
+		schema.Discriminator = &Discriminator{
+			PropertyName: field.JsonName,
+			Mapping:      map[string]string{},
+		}
+
+		for _, implementationName := range sortedKeys(s.models) {
+			implementation := s.models[implementationName]
+			if implementation.ParentTypeName == nil || *implementation.ParentTypeName != modelName || implementation.DiscriminatedValue == nil {
+				continue
+			}
+
+			componentName, err := b.resolveReference(s, implementationName)
+			if err != nil {
+				return nil, fmt.Errorf("resolving Discriminated Implementation %q: %+v", implementationName, err)
+			}
+			ref := fmt.Sprintf("#/components/schemas/%s", componentName)
+			schema.OneOf = append(schema.OneOf, &Schema{Ref: ref})
+			schema.Discriminator.Mapping[*implementation.DiscriminatedValue] = ref
+		}
+	}
+
+	return &schema, nil
+}
+
+// lookupModel returns the Model modelName from either s or the Common Types.
+func (b *builder) lookupModel(s *scope, modelName string) (*models.SDKModel, bool) {
+	if model, ok := s.models[modelName]; ok {
+		return &model, true
+	}
+	if model, ok := b.commonTypes.models[modelName]; ok {
+		return &model, true
+	}
+	return nil, false
+}
+
+func (b *builder) schemaForObjectDefinition(s *scope, input models.SDKObjectDefinition) (*Schema, error) {
+	nestedItem := func() (*Schema, error) {
+		if input.NestedItem == nil {
+			return nil, fmt.Errorf("a Nested Item is required for a %q type", string(input.Type))
+		}
+		return b.schemaForObjectDefinition(s, *input.NestedItem)
+	}
+
+	switch input.Type {
+	case models.BooleanSDKObjectDefinitionType:
+		return &Schema{Type: "boolean"}, nil
+	case models.DateTimeSDKObjectDefinitionType:
+		return &Schema{Type: "string", Format: "date-time"}, nil
+	case models.FloatSDKObjectDefinitionType:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case models.IntegerSDKObjectDefinitionType:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case models.StringSDKObjectDefinitionType:
+		return &Schema{Type: "string"}, nil
+	case models.RawFileSDKObjectDefinitionType:
+		return &Schema{Type: "string", Format: "binary"}, nil
+	case models.RawObjectSDKObjectDefinitionType:
+		return &Schema{}, nil
+
+	case models.CSVSDKObjectDefinitionType:
+		items, err := nestedItem()
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items, XPandoraCSV: true}, nil
+	case models.DictionarySDKObjectDefinitionType:
+		items, err := nestedItem()
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: items}, nil
+	case models.ListSDKObjectDefinitionType:
+		items, err := nestedItem()
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+
+	case models.ReferenceSDKObjectDefinitionType:
+		if input.ReferenceName == nil {
+			return nil, fmt.Errorf("a Reference Name is required for a Reference type")
+		}
+		componentName, err := b.resolveReference(s, *input.ReferenceName)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Ref: fmt.Sprintf("#/components/schemas/%s", componentName)}, nil
+
+	// the remaining types have special handling within the SDK, so include the type as an extension
+	case models.LocationSDKObjectDefinitionType, models.EdgeZoneSDKObjectDefinitionType, models.ZoneSDKObjectDefinitionType:
+		return &Schema{Type: "string", XPandoraType: string(input.Type)}, nil
+	case models.ZonesSDKObjectDefinitionType:
+		return &Schema{Type: "array", Items: &Schema{Type: "string"}, XPandoraType: string(input.Type)}, nil
+	case models.TagsSDKObjectDefinitionType:
+		return &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}, XPandoraType: string(input.Type)}, nil
+	case models.SystemDataSDKObjectDefinitionType:
+		return &Schema{Type: "object", ReadOnly: true, XPandoraType: string(input.Type)}, nil
+	case models.LegacySystemAndUserAssignedIdentityListSDKObjectDefinitionType,
+		models.LegacySystemAndUserAssignedIdentityMapSDKObjectDefinitionType,
+		models.SystemAssignedIdentitySDKObjectDefinitionType,
+		models.SystemAndUserAssignedIdentityListSDKObjectDefinitionType,
+		models.SystemAndUserAssignedIdentityMapSDKObjectDefinitionType,
+		models.SystemOrUserAssignedIdentityListSDKObjectDefinitionType,
+		models.SystemOrUserAssignedIdentityMapSDKObjectDefinitionType,
+		models.UserAssignedIdentityListSDKObjectDefinitionType,
+		models.UserAssignedIdentityMapSDKObjectDefinitionType:
+		return &Schema{Type: "object", XPandoraType: string(input.Type)}, nil
+	}
+
+	return nil, fmt.Errorf("internal-error: unimplemented Object Definition Type %q", string(input.Type))
+}
+
+func (b *builder) schemaForOptionObjectDefinition(s *scope, input models.SDKOperationOptionObjectDefinition) (*Schema, error) {
+	nestedItem := func() (*Schema, error) {
+		if input.NestedItem == nil {
+			return nil, fmt.Errorf("a Nested Item is required for a %q type", string(input.Type))
+		}
+		return b.schemaForOptionObjectDefinition(s, *input.NestedItem)
+	}
+
+	switch input.Type {
+	case models.BooleanSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "boolean"}, nil
+	case models.FloatSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "number", Format: "double"}, nil
+	case models.IntegerSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "integer", Format: "int64"}, nil
+	case models.StringSDKOperationOptionObjectDefinitionType:
+		return &Schema{Type: "string"}, nil
+
+	case models.CSVSDKOperationOptionObjectDefinitionType:
+		items, err := nestedItem()
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items, XPandoraCSV: true}, nil
+	case models.ListSDKOperationOptionObjectDefinitionType:
+		items, err := nestedItem()
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+
+	case models.ReferenceSDKOperationOptionObjectDefinitionType:
+		if input.ReferenceName == nil {
+			return nil, fmt.Errorf("a Reference Name is required for a Reference type")
+		}
+		componentName, err := b.resolveReference(s, *input.ReferenceName)
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Ref: fmt.Sprintf("#/components/schemas/%s", componentName)}, nil
+	}
+
+	return nil, fmt.Errorf("internal-error: unimplemented Option Object Definition Type %q", string(input.Type))
+}
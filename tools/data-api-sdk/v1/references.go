@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+type GetReferencesResponse struct {
+	// HttpResponse is the raw HTTP Response.
+	HttpResponse *http.Response
+
+	// Model contains the items which reference the specified type.
+	Model *GetReferences
+}
+
+type GetReferences struct {
+	// References is a list of the items which reference the specified type, either directly or transitively.
+	// Direct references are returned first, followed by transitive references in order of increasing distance.
+	References []models.SDKReference `json:"references"`
+}
+
+// GetReferencesWithinAPIResource returns every item within the specified API Resource (within a given API Version/Service)
+// which references the SDKConstant, SDKModel or ResourceID named name - either directly or transitively.
+func (c *Client) GetReferencesWithinAPIResource(ctx context.Context, serviceName, apiVersion, apiResource, name string) (*GetReferencesResponse, error) {
+	uri := fmt.Sprintf("%s/v1/%s/services/%s/%s/%s/references/%s", c.endpoint, string(c.sourceDataType), url.PathEscape(serviceName), url.PathEscape(apiVersion), url.PathEscape(apiResource), url.PathEscape(name))
+	return c.getReferences(ctx, uri)
+}
+
+// GetReferencesToCommonType returns every item within the Common Types and within each API Resource in this
+// Source Data Type which references the Common Type named name - either directly or transitively.
+func (c *Client) GetReferencesToCommonType(ctx context.Context, name string) (*GetReferencesResponse, error) {
+	uri := fmt.Sprintf("%s/v1/%s/commonTypes/references/%s", c.endpoint, string(c.sourceDataType), url.PathEscape(name))
+	return c.getReferences(ctx, uri)
+}
+
+func (c *Client) getReferences(ctx context.Context, uri string) (*GetReferencesResponse, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request to the %q endpoint: %+v", uri, err)
+	}
+
+	out := GetReferencesResponse{}
+	out.HttpResponse, err = c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request to %q: %+v", uri, err)
+	}
+
+	if out.HttpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected a 200 OK but got %d %s for %q", out.HttpResponse.StatusCode, out.HttpResponse.Status, uri)
+	}
+
+	if err := json.NewDecoder(out.HttpResponse.Body).Decode(&out.Model); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
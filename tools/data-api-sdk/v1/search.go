@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type SearchResultKind string
+
+const (
+	APIResourceSearchResultKind SearchResultKind = "Resource"
+	APIVersionSearchResultKind  SearchResultKind = "ApiVersion"
+	ConstantSearchResultKind    SearchResultKind = "Constant"
+	FieldSearchResultKind       SearchResultKind = "Field"
+	ModelSearchResultKind       SearchResultKind = "Model"
+	OperationSearchResultKind   SearchResultKind = "Operation"
+	ResourceIDSearchResultKind  SearchResultKind = "ResourceId"
+	ServiceSearchResultKind     SearchResultKind = "Service"
+)
+
+type SearchResponse struct {
+	// HttpResponse is the raw HTTP Response.
+	HttpResponse *http.Response
+
+	// Model contains the items matching the search.
+	Model *SearchResults
+}
+
+type SearchResults struct {
+	// Results is a list of the items matching the search, where items whose name exactly matches the
+	// query are returned first.
+	Results []SearchResult `json:"results"`
+}
+
+type SearchResult struct {
+	// Kind specifies the kind of item which was matched, e.g. a Model or an Operation.
+	Kind SearchResultKind `json:"kind"`
+
+	// Name is the name of the item which was matched.
+	Name string `json:"name"`
+
+	// Path is the full path to the matched item, e.g. `Compute/2023-03-01/VirtualMachines/Model/VirtualMachine`.
+	Path string `json:"path"`
+
+	// ServiceName is the name of the Service containing the matched item.
+	ServiceName string `json:"serviceName"`
+
+	// APIVersion is the API Version containing the matched item, if any.
+	APIVersion *string `json:"apiVersion,omitempty"`
+
+	// APIResource is the name of the API Resource containing the matched item, if any.
+	APIResource *string `json:"apiResource,omitempty"`
+
+	// ModelName is the name of the Model containing the matched item, which is only set for Fields.
+	ModelName *string `json:"modelName,omitempty"`
+
+	// URI is the URI of the Data API endpoint which returns the matched item.
+	URI string `json:"uri"`
+}
+
+type SearchOptions struct {
+	// Query is the (case-insensitive) text which the name of each result must contain.
+	Query string
+
+	// Kinds optionally limits the results to the specified kinds, when empty results of every kind are returned.
+	Kinds []SearchResultKind
+
+	// ServiceName optionally limits the results to those within the specified Service.
+	ServiceName *string
+}
+
+// Search returns the Services, API Versions, API Resources, Operations, Models, Fields, Constants and Resource IDs
+// within this Source Data Type whose name contains the specified query.
+//
+// Note that this is only supported when using the Data API, rather than when reading from a directory.
+func (c *Client) Search(ctx context.Context, options SearchOptions) (*SearchResponse, error) {
+	values := url.Values{}
+	values.Set("q", options.Query)
+	if len(options.Kinds) > 0 {
+		kinds := make([]string, 0)
+		for _, kind := range options.Kinds {
+			kinds = append(kinds, string(kind))
+		}
+		values.Set("kind", strings.Join(kinds, ","))
+	}
+	if options.ServiceName != nil {
+		values.Set("service", *options.ServiceName)
+	}
+
+	uri := fmt.Sprintf("%s/v1/%s/search?%s", c.endpoint, string(c.sourceDataType), values.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request to the %q endpoint: %+v", uri, err)
+	}
+
+	out := SearchResponse{}
+	out.HttpResponse, err = c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request to %q: %+v", uri, err)
+	}
+
+	if out.HttpResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected a 200 OK but got %d %s for %q", out.HttpResponse.StatusCode, out.HttpResponse.Status, uri)
+	}
+
+	if err := json.NewDecoder(out.HttpResponse.Body).Decode(&out.Model); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+type GetStatisticsForServiceResponse struct {
+	// HttpResponse is the raw HTTP Response.
+	HttpResponse *http.Response
+
+	// Model contains the Statistics for each API Version within the Service.
+	Model *models.ServiceStatistics
+}
+
+// GetStatisticsForService returns the Statistics (the number of Operations, Models, Constants and Resource IDs, and
+// the Terraform coverage) for each API Version within the specified Service.
+func (c *Client) GetStatisticsForService(ctx context.Context, serviceName string) (*GetStatisticsForServiceResponse, error) {
+	uri := fmt.Sprintf("%s/v1/%s/services/%s/stats", c.endpoint, string(c.sourceDataType), url.PathEscape(serviceName))
+	out := GetStatisticsForServiceResponse{}
+	var err error
+	out.HttpResponse, err = c.getStatistics(ctx, uri, &out.Model)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+type GetStatisticsResponse struct {
+	// HttpResponse is the raw HTTP Response.
+	HttpResponse *http.Response
+
+	// Model contains the Statistics for each Service within this Source Data Type.
+	Model *models.AggregateStatistics
+}
+
+// GetStatistics returns the Statistics (the number of Operations, Models, Constants and Resource IDs, and
+// the Terraform coverage) for each Service within this Source Data Type, along with the sum of these.
+func (c *Client) GetStatistics(ctx context.Context) (*GetStatisticsResponse, error) {
+	uri := fmt.Sprintf("%s/v1/%s/stats", c.endpoint, string(c.sourceDataType))
+	out := GetStatisticsResponse{}
+	var err error
+	out.HttpResponse, err = c.getStatistics(ctx, uri, &out.Model)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *Client) getStatistics(ctx context.Context, uri string, model interface{}) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request to the %q endpoint: %+v", uri, err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("performing request to %q: %+v", uri, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("expected a 200 OK but got %d %s for %q", resp.StatusCode, resp.Status, uri)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(model); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package inprocess allows the Data API to be used without launching it, by serving the requests made by a
+// Data API SDK Client in-process, using the same Repositories and Endpoints as the Data API.
+package inprocess
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/go-chi/chi/v5"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// NewClientForDirectory returns a Data API SDK Client for the sourceDataType which reads the API Definitions
+// from the specified directory (e.g. the `api-definitions` directory within this repository).
+func NewClientForDirectory(directory string, sourceDataType models.SourceDataType) (*v1.Client, error) {
+	info, err := os.Stat(directory)
+	if err != nil {
+		return nil, fmt.Errorf("checking the API Definitions directory %q: %+v", directory, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("expected %q to be a directory containing the API Definitions", directory)
+	}
+
+	return NewClientForFileSystem(os.DirFS(directory), sourceDataType)
+}
+
+// NewClientForFileSystem returns a Data API SDK Client for the sourceDataType which reads the API Definitions
+// from fileSystem, where the root of fileSystem is the directory containing the API Definitions.
+func NewClientForFileSystem(fileSystem fs.FS, sourceDataType models.SourceDataType) (*v1.Client, error) {
+	serviceRepositories, err := repositories.NewServicesRepositories(fileSystem, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building Services Repositories: %+v", err)
+	}
+
+	router := chi.NewRouter()
+	router.Route("/", endpoints.Router(serviceRepositories))
+	return v1.NewClientForHandler(router, sourceDataType), nil
+}
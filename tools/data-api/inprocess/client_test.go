@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inprocess
+
+import (
+	"context"
+	"testing"
+	"testing/fstest"
+
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestNewClientForFileSystem(t *testing.T) {
+	fileSystem := fstest.MapFS{
+		"notes/README.md":                                                 {Data: []byte("no metadata.json, so this is ignored")},
+		"resource-manager/metadata.json":                                  {Data: []byte(`{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)},
+		"resource-manager/Example/ServiceDefinition.json":                 {Data: []byte(`{"name": "Example", "resourceProvider": "Microsoft.Example", "terraformPackageName": "example", "generate": true}`)},
+		"resource-manager/Example/2020-01-01/ApiVersionDefinition.json":   {Data: []byte(`{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)},
+		"resource-manager/Example/2020-01-01/Things/Constant-Colour.json": {Data: []byte(`{"name": "Colour", "type": "String", "values": [{"key": "Red", "value": "red"}, {"key": "Blue", "value": "blue"}]}`)},
+		"resource-manager/Example/2020-01-01/Things/Model-Thing.json":     {Data: []byte(`{"name": "Thing", "fields": [{"name": "Colour", "jsonName": "colour", "objectDefinition": {"type": "Reference", "referenceName": "Colour"}, "optional": true}, {"name": "Created", "jsonName": "created", "objectDefinition": {"type": "DateTime", "dateFormat": "RFC3339"}, "readOnly": true}]}`)},
+		"resource-manager/Example/2020-01-01/Things/ResourceId-ThingId.json": {Data: []byte(`{"name": "ThingId", "id": "/subscriptions/{subscriptionId}/providers/Microsoft.Example/things/{thingName}", "segments": [
+			{"name": "staticSubscriptions", "type": "Static", "value": "subscriptions"},
+			{"name": "subscriptionId", "type": "SubscriptionId"},
+			{"name": "staticProviders", "type": "Static", "value": "providers"},
+			{"name": "staticMicrosoftExample", "type": "ResourceProvider", "value": "Microsoft.Example"},
+			{"name": "staticThings", "type": "Static", "value": "things"},
+			{"name": "thingName", "type": "UserSpecified"}
+		]}`)},
+		"resource-manager/Example/2020-01-01/Things/Operation-Get.json":                   {Data: []byte(`{"name": "Get", "contentType": "application/json", "expectedStatusCodes": [200], "httpMethod": "GET", "resourceIdName": "ThingId", "responseObject": {"type": "Reference", "referenceName": "Thing"}, "options": [{"field": "Expand", "queryString": "$expand", "optionsObjectDefinition": {"type": "String"}}]}`)},
+		"resource-manager/Example/Terraform/Thing-Resource.json":                          {Data: []byte(`{"apiVersion": "2020-01-01", "category": "Example", "createMethod": {"generate": true, "name": "Get", "timeoutInMinutes": 30}, "deleteMethod": {"generate": true, "name": "Get", "timeoutInMinutes": 30}, "readMethod": {"generate": true, "name": "Get", "timeoutInMinutes": 5}, "displayName": "Thing", "exampleUsage": "\nresource \"example_thing\" \"example\" {}\n", "generate": true, "label": "thing", "resource": "Things", "resourceIdName": "ThingId", "schemaModelName": "ThingResourceSchema"}`)},
+		"resource-manager/Example/Terraform/Thing-Resource-Mappings.json":                 {Data: []byte(`{"fieldMappings": [{"type": "DirectAssignment", "directAssignment": {"schemaModelName": "ThingResourceSchema", "schemaFieldPath": "Colour", "sdkModelName": "Thing", "sdkFieldPath": "Colour"}}], "resourceIdMappings": [{"schemaFieldName": "Name", "segmentName": "thingName"}]}`)},
+		"resource-manager/Example/Terraform/Thing-Resource-Schema.json":                   {Data: []byte(`{"name": "ThingResourceSchema", "fields": [{"name": "Colour", "hclName": "colour", "objectDefinition": {"type": "String"}, "optional": true, "validation": {"type": "PossibleValues", "possibleValues": {"type": "String", "values": ["blue", "red"]}}}]}`)},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Basic-Test.hcl":          {Data: []byte("basic")},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Other-Update-1-Test.hcl": {Data: []byte("update-1")},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Other-Update-2-Test.hcl": {Data: []byte("update-2")},
+		"resource-manager/Other/ServiceDefinition.json":                                   {Data: []byte(`{"name": "Other", "generate": false}`)},
+	}
+
+	ctx := context.TODO()
+	client, err := NewClientForFileSystem(fileSystem, models.ResourceManagerSourceDataType)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	health, err := client.Health(ctx)
+	if err != nil {
+		t.Fatalf("checking health: %+v", err)
+	}
+	if !health.Available {
+		t.Fatalf("expected the client to be available")
+	}
+
+	result, err := client.LoadAllData(ctx, []string{"Example"})
+	if err != nil {
+		t.Fatalf("loading all data: %+v", err)
+	}
+	if len(result.Services) != 1 {
+		t.Fatalf("expected 1 Service but got %d", len(result.Services))
+	}
+
+	service := result.Services["Example"]
+	if !service.Generate || service.ResourceProvider == nil || *service.ResourceProvider != "Microsoft.Example" {
+		t.Fatalf("unexpected Service details: %+v", service)
+	}
+
+	resource := service.APIVersions["2020-01-01"].Resources["Things"]
+	if len(resource.Constants) != 1 || len(resource.Models) != 1 || len(resource.Operations) != 1 || len(resource.ResourceIDs) != 1 {
+		t.Fatalf("unexpected API Resource: %+v", resource)
+	}
+	if dateFormat := resource.Models["Thing"].Fields["Created"].DateFormat; dateFormat == nil || *dateFormat != models.RFC3339SDKDateFormat {
+		t.Fatalf("expected the DateFormat to be RFC3339 but got %+v", dateFormat)
+	}
+	if queryString := resource.Operations["Get"].Options["Expand"].QueryStringName; queryString == nil || *queryString != "$expand" {
+		t.Fatalf("expected the QueryStringName to be `$expand` but got %+v", queryString)
+	}
+	if segments := resource.ResourceIDs["ThingId"].Segments; segments[5].ExampleValue != "thingValue" {
+		t.Fatalf("expected the Example Value for the last segment to be `thingValue` but got %q", segments[5].ExampleValue)
+	}
+
+	if service.TerraformDefinition == nil {
+		t.Fatalf("expected a Terraform Definition")
+	}
+	if service.TerraformDefinition.TerraformPackageName != "example" {
+		t.Fatalf("expected the Terraform Package Name to be `example` but got %q", service.TerraformDefinition.TerraformPackageName)
+	}
+	terraformResource, ok := service.TerraformDefinition.Resources["thing"]
+	if !ok {
+		t.Fatalf("expected the Terraform Resource to be keyed by the label `thing`")
+	}
+	if terraformResource.ResourceName != "Thing" {
+		t.Fatalf("expected the Resource Name to be `Thing` but got %q", terraformResource.ResourceName)
+	}
+	if terraformResource.Documentation.ExampleUsageHCL != `resource "example_thing" "example" {}` {
+		t.Fatalf("unexpected Example Usage %q", terraformResource.Documentation.ExampleUsageHCL)
+	}
+	if len(terraformResource.Mappings.Fields) != 1 || len(terraformResource.Mappings.ResourceID) != 1 {
+		t.Fatalf("unexpected Mappings: %+v", terraformResource.Mappings)
+	}
+	if terraformResource.SchemaModels["ThingResourceSchema"].Fields["Colour"].Validation == nil {
+		t.Fatalf("expected the Colour field to have Validation")
+	}
+	if !terraformResource.Tests.Generate || terraformResource.Tests.BasicConfiguration != "basic" {
+		t.Fatalf("unexpected Tests: %+v", terraformResource.Tests)
+	}
+	otherTests := *terraformResource.Tests.OtherTests
+	if len(otherTests["Update"]) != 2 || otherTests["Update"][0] != "update-1" || otherTests["Update"][1] != "update-2" {
+		t.Fatalf("unexpected Other Tests: %+v", otherTests["Update"])
+	}
+
+	services, err := client.GetAvailableServices(ctx)
+	if err != nil {
+		t.Fatalf("retrieving available services: %+v", err)
+	}
+	if len(services.Model.Services) != 2 || services.Model.Services["Other"].Generate {
+		t.Fatalf("unexpected available services: %+v", services.Model.Services)
+	}
+
+	other, err := client.GetDetailsForServiceResponse(ctx, services.Model.Services["Other"])
+	if err != nil {
+		t.Fatalf("retrieving details for Other: %+v", err)
+	}
+	terraform, err := client.GetTerraformDetailsForService(ctx, *other.Model)
+	if err != nil {
+		t.Fatalf("retrieving terraform details for Other: %+v", err)
+	}
+	if terraform.Model != nil {
+		t.Fatalf("expected no Terraform Definition for Other but got %+v", terraform.Model)
+	}
+
+	statistics, err := client.GetStatistics(ctx)
+	if err != nil {
+		t.Fatalf("retrieving statistics: %+v", err)
+	}
+	if total := statistics.Model.Total; total.Operations.Total != 1 || total.ResourceIDs.WithTerraformResource != 1 || total.ResourceIDs.TerraformCoveragePercentage != 100 {
+		t.Fatalf("unexpected statistics: %+v", total)
+	}
+	if _, err := client.GetStatisticsForService(ctx, "Missing"); err == nil {
+		t.Fatalf("expected an error retrieving the statistics for a Service which doesn't exist")
+	}
+
+	if _, err := client.GetDetailsForServiceResponse(ctx, v1.AvailableServiceSummary{Uri: "/v1/resource-manager/services/Missing"}); err == nil {
+		t.Fatalf("expected an error retrieving a Service which doesn't exist")
+	}
+}
+
+func TestNewClientForDirectory_ResourceManager(t *testing.T) {
+	client, err := NewClientForDirectory("../../../api-definitions", models.ResourceManagerSourceDataType)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	if _, err := client.LoadAllData(context.TODO(), []string{"ChaosStudio"}); err != nil {
+		t.Fatalf("loading all data: %+v", err)
+	}
+}
+
+func TestNewClientForFileSystem_ExportOnlyLoadsTheRequestedServices(t *testing.T) {
+	fileSystem := fstest.MapFS{
+		"resource-manager/metadata.json":                                  {Data: []byte(`{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)},
+		"resource-manager/Example/ServiceDefinition.json":                 {Data: []byte(`{"name": "Example", "generate": true}`)},
+		"resource-manager/Example/2020-01-01/ApiVersionDefinition.json":   {Data: []byte(`{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)},
+		"resource-manager/Example/2020-01-01/Things/Constant-Colour.json": {Data: []byte(`{"name": "Colour", "type": "String", "values": [{"key": "Red", "value": "red"}]}`)},
+		// since Broken isn't requested it shouldn't be loaded, so the invalid definition shouldn't be an issue
+		"resource-manager/Broken/ServiceDefinition.json":                 {Data: []byte(`{"name": "Broken", "generate": true}`)},
+		"resource-manager/Broken/2020-01-01/ApiVersionDefinition.json":   {Data: []byte(`{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)},
+		"resource-manager/Broken/2020-01-01/Things/Constant-Colour.json": {Data: []byte(`{"name": "Colour", `)},
+	}
+
+	client, err := NewClientForFileSystem(fileSystem, models.ResourceManagerSourceDataType)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	result, err := client.Export(context.TODO(), []string{"Example", "Missing"})
+	if err != nil {
+		t.Fatalf("exporting: %+v", err)
+	}
+	if len(result.Model.Services) != 1 {
+		t.Fatalf("expected 1 Service but got %d", len(result.Model.Services))
+	}
+	if _, ok := result.Model.Services["Example"].APIVersions["2020-01-01"].Resources["Things"].Constants["Colour"]; !ok {
+		t.Fatalf("expected the Constant `Colour` to be exported")
+	}
+
+	if _, err := client.Export(context.TODO(), nil); err == nil {
+		t.Fatalf("expected an error exporting every Service, since Broken contains an invalid definition")
+	}
+}
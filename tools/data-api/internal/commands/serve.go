@@ -4,18 +4,25 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/datasource"
 	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints"
 	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
 	"github.com/mitchellh/cli"
 )
 
@@ -37,11 +44,13 @@ func (c ServeCommand) Run(args []string) int {
 	var portVar int
 	var serviceNamesRaw string
 	var dataDirectoryRaw string
+	var dataSourceRaw string
 
 	f := flag.NewFlagSet("serve", flag.ExitOnError)
 	f.StringVar(&serviceNamesRaw, "services", "", "A list of comma separated Service names to load")
 	f.IntVar(&portVar, "port", 8080, "The Port the Data API Endpoint will run on (e.g. --port=8080")
 	f.StringVar(&dataDirectoryRaw, "data-directory", "../../api-definitions/", "The path to the directory the data will be read from")
+	f.StringVar(&dataSourceRaw, "data-source", "", dataSourceFlagDescription)
 	f.Parse(args)
 
 	var serviceNames *[]string
@@ -64,20 +73,81 @@ func (c ServeCommand) Run(args []string) int {
 		}
 	}
 
-	var dataDirectory string
-	if dataDirectoryRaw != "" {
-		dataDirectory = dataDirectoryRaw
+	dataSource, fileSystem, err := openDataSource(dataSourceRaw, dataDirectoryRaw)
+	if err != nil {
+		logging.Errorf("opening the Data Source: %+v", err)
+		return 1
+	}
+	defer fileSystem.Close()
+	logging.Debugf("Reading the API Definitions from %s", dataSource.String())
+
+	serviceRepositories, err := repositories.NewServicesRepositories(fileSystem, serviceNames)
+	if err != nil {
+		logging.Errorf("building Services Repositories: %+v", err)
+		return 1
 	}
 
 	logging.Debugf("Launching Server on port %d", port)
 	r := chi.NewRouter()
 	r.Use(middleware.Logger)
-	r.Route("/", endpoints.Router(dataDirectory, serviceNames))
+	r.Route("/", endpoints.Router(serviceRepositories))
 	logging.Infof("Data API launched at http://localhost:%d", port)
-	http.ListenAndServe(fmt.Sprintf(":%d", port), r)
+	if err := listenAndServe(port, r); err != nil {
+		logging.Errorf("running the Server: %+v", err)
+		return 1
+	}
 	return 0
 }
 
 func (ServeCommand) Synopsis() string {
 	return "Launches the Server"
 }
+
+// listenAndServe serves handler on the specified port until the process is interrupted or terminated, at which point
+// the server is shut down - allowing any deferred clean up (e.g. of the temporary files used to read a Data Source)
+// to run.
+func listenAndServe(port int, handler http.Handler) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	server := &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: handler,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+const dataSourceFlagDescription = "The Data Source the data will be read from, either a directory, a `.tar.gz`/`.zip` archive or a directory within a git commit (e.g. `git:main:api-definitions`) - when specified this is used instead of `--data-directory`"
+
+// openDataSource opens the Data Source specified using `--data-source` when set, otherwise the directory specified
+// using `--data-directory`
+func openDataSource(dataSourceRaw, dataDirectory string) (*datasource.DataSource, datasource.FileSystem, error) {
+	dataSource := &datasource.DataSource{
+		Type: datasource.DirectoryType,
+		Path: dataDirectory,
+	}
+	if dataSourceRaw != "" {
+		var err error
+		dataSource, err = datasource.Parse(dataSourceRaw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing the Data Source: %+v", err)
+		}
+	}
+
+	fileSystem, err := dataSource.Open()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return dataSource, fileSystem, nil
+}
@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"flag"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints"
+	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = ServeWatchCommand{}
+
+func NewServeWatchCommand() func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return ServeWatchCommand{}, nil
+	}
+}
+
+type ServeWatchCommand struct{}
+
+func (ServeWatchCommand) Help() string {
+	return "Launches the Server, reloading any Services whose API Definitions change on disk"
+}
+
+func (c ServeWatchCommand) Run(args []string) int {
+	var portVar int
+	var serviceNamesRaw string
+	var dataDirectoryRaw string
+	var pollInterval time.Duration
+
+	f := flag.NewFlagSet("serve-watch", flag.ExitOnError)
+	f.StringVar(&serviceNamesRaw, "services", "", "A list of comma separated Service names to load")
+	f.IntVar(&portVar, "port", 8080, "The Port the Data API Endpoint will run on (e.g. --port=8080")
+	f.StringVar(&dataDirectoryRaw, "data-directory", "../../api-definitions/", "The path to the directory the data will be read from")
+	f.DurationVar(&pollInterval, "poll-interval", 2*time.Second, "How often the data directory should be checked for changes (e.g. --poll-interval=5s)")
+	f.Parse(args)
+
+	var serviceNames *[]string
+	if serviceNamesRaw != "" {
+		serviceNames = pointer.To(strings.Split(serviceNamesRaw, ","))
+	}
+
+	var port int
+	if portVar != 0 {
+		port = portVar
+	}
+
+	portEnv := os.Getenv("PANDORA_API_PORT")
+	if portEnv != "" {
+		var err error
+		port, err = strconv.Atoi(portEnv)
+		if err != nil {
+			logging.Errorf("expected PANDORA_API_PORT to be an int: %+v", err)
+			return 1
+		}
+	}
+
+	if pollInterval <= 0 {
+		logging.Errorf("expected `--poll-interval` to be greater than zero but got %s", pollInterval)
+		return 1
+	}
+
+	// only a directory is watched for changes, since the contents of an archive/git commit are fixed
+	_, fileSystem, err := openDataSource("", dataDirectoryRaw)
+	if err != nil {
+		logging.Errorf("opening the Data Directory: %+v", err)
+		return 1
+	}
+	defer fileSystem.Close()
+
+	serviceRepositories, err := repositories.NewServicesRepositories(fileSystem, serviceNames)
+	if err != nil {
+		logging.Errorf("building Services Repositories: %+v", err)
+		return 1
+	}
+
+	// record the current state of the data directory, so that only changes made from this point are reloaded
+	for serviceType, repo := range serviceRepositories {
+		if err := repo.ReloadChangedServices(); err != nil {
+			logging.Errorf("checking %q for changes: %+v", string(serviceType), err)
+			return 1
+		}
+	}
+	go watchForChanges(serviceRepositories, pollInterval)
+
+	logging.Debugf("Launching Server on port %d", port)
+	r := chi.NewRouter()
+	r.Use(middleware.Logger)
+	r.Route("/", endpoints.Router(serviceRepositories))
+	logging.Infof("Data API launched at http://localhost:%d - watching %q for changes", port, dataDirectoryRaw)
+	if err := listenAndServe(port, r); err != nil {
+		logging.Errorf("running the Server: %+v", err)
+		return 1
+	}
+	return 0
+}
+
+func (ServeWatchCommand) Synopsis() string {
+	return "Launches the Server, reloading any Services whose API Definitions change on disk"
+}
+
+func watchForChanges(serviceRepositories map[repositories.ServiceType]repositories.ServicesRepository, pollInterval time.Duration) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for serviceType, repo := range serviceRepositories {
+			if err := repo.ReloadChangedServices(); err != nil {
+				logging.Errorf("checking %q for changes: %+v", string(serviceType), err)
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package commands
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+	"github.com/mitchellh/cli"
+)
+
+var _ cli.Command = ValidateCommand{}
+
+func NewValidateCommand() func() (cli.Command, error) {
+	return func() (cli.Command, error) {
+		return ValidateCommand{}, nil
+	}
+}
+
+type ValidateCommand struct{}
+
+func (ValidateCommand) Help() string {
+	return `Validates the API Definitions within the Data Directory (or Data Source), reporting every problem found.
+
+Usage: data-api validate [--data-directory=../../api-definitions/ | --data-source=git:main:api-definitions] [--service-type=resource-manager] [--services=Compute,Network] [--output-format=text|json]
+
+Exits with a non-zero exit code when any problems are found.`
+}
+
+func (c ValidateCommand) Run(args []string) int {
+	var serviceNamesRaw string
+	var serviceTypeRaw string
+	var dataDirectory string
+	var dataSourceRaw string
+	var outputFormat string
+
+	f := flag.NewFlagSet("validate", flag.ExitOnError)
+	f.StringVar(&serviceNamesRaw, "services", "", "A list of comma separated Service names to validate")
+	f.StringVar(&serviceTypeRaw, "service-type", "", "The type of Services to validate (e.g. `resource-manager`), defaults to all types")
+	f.StringVar(&dataDirectory, "data-directory", "../../api-definitions/", "The path to the directory the data will be read from")
+	f.StringVar(&dataSourceRaw, "data-source", "", dataSourceFlagDescription)
+	f.StringVar(&outputFormat, "output-format", "text", "The format the problems should be output in, either `text` or `json`")
+	f.Parse(args)
+
+	if outputFormat != "text" && outputFormat != "json" {
+		logging.Errorf("unsupported output format %q - supported values are `text` and `json`", outputFormat)
+		return 1
+	}
+
+	var serviceNames *[]string
+	if serviceNamesRaw != "" {
+		serviceNames = pointer.To(strings.Split(serviceNamesRaw, ","))
+	}
+
+	// Common Types are only supported for Microsoft Graph, matching the endpoints
+	usesCommonTypes := map[repositories.ServiceType]bool{
+		repositories.MicrosoftGraphServiceType:  true,
+		repositories.ResourceManagerServiceType: false,
+	}
+	serviceTypes := []repositories.ServiceType{
+		repositories.MicrosoftGraphServiceType,
+		repositories.ResourceManagerServiceType,
+	}
+	if serviceTypeRaw != "" {
+		serviceType := repositories.ServiceType(serviceTypeRaw)
+		if _, ok := usesCommonTypes[serviceType]; !ok {
+			logging.Errorf("unsupported service type %q", serviceTypeRaw)
+			return 1
+		}
+		serviceTypes = []repositories.ServiceType{serviceType}
+	}
+
+	dataSource, fileSystem, err := openDataSource(dataSourceRaw, dataDirectory)
+	if err != nil {
+		logging.Errorf("opening the Data Source: %+v", err)
+		return 1
+	}
+	defer fileSystem.Close()
+	logging.Debugf("Reading the API Definitions from %s", dataSource.String())
+
+	problems := make([]repositories.ValidationProblem, 0)
+	for _, serviceType := range serviceTypes {
+		repo, err := repositories.NewServicesRepository(fileSystem, serviceType, serviceNames)
+		if err != nil {
+			logging.Errorf("initialising Services Repository for %q: %+v", string(serviceType), err)
+			return 1
+		}
+
+		logging.Infof("Validating the API Definitions for %q", string(serviceType))
+		result, err := repo.ValidateDefinitions(usesCommonTypes[serviceType])
+		if err != nil {
+			logging.Errorf("validating the API Definitions for %q: %+v", string(serviceType), err)
+			return 1
+		}
+		problems = append(problems, *result...)
+	}
+
+	if outputFormat == "json" {
+		output, err := json.MarshalIndent(validateOutput{Problems: problems}, "", "  ")
+		if err != nil {
+			logging.Errorf("marshaling problems: %+v", err)
+			return 1
+		}
+		fmt.Println(string(output))
+	} else {
+		for _, problem := range problems {
+			fmt.Printf("%s: [%s] %s\n", problem.FilePath, string(problem.Type), problem.Message)
+		}
+		fmt.Printf("%d problem(s) found\n", len(problems))
+	}
+
+	if len(problems) > 0 {
+		return 1
+	}
+	return 0
+}
+
+func (ValidateCommand) Synopsis() string {
+	return "Validates the API Definitions, reporting every problem found"
+}
+
+type validateOutput struct {
+	Problems []repositories.ValidationProblem `json:"problems"`
+}
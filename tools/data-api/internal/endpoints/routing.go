@@ -6,38 +6,49 @@ package endpoints
 import (
 	"github.com/go-chi/chi/v5"
 	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/infrastructure"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/ui"
 	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1"
 	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
 	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
 )
 
-func Router(directory string, serviceNames *[]string) func(chi.Router) {
+func Router(serviceRepositories map[repositories.ServiceType]repositories.ServicesRepository) func(chi.Router) {
 	return func(router chi.Router) {
-		router.Route("/v1", infrastructure.Router)
-		router.Route("/v1/microsoft-graph", func(r chi.Router) {
-			opts := v1.Options{
-				ServiceType:     repositories.MicrosoftGraphServiceType,
-				UriPrefix:       "/v1/microsoft-graph",
-				UsesCommonTypes: true,
-			}
-			serviceRepo, err := repositories.NewServicesRepository(directory, opts.ServiceType, serviceNames)
-			if err != nil {
-				logging.Fatalf("Error: %+v", err)
-			}
-			v1.Router(r, opts, serviceRepo)
-		})
-		router.Route("/v1/resource-manager", func(r chi.Router) {
-			opts := v1.Options{
-				ServiceType:     repositories.ResourceManagerServiceType,
-				UriPrefix:       "/v1/resource-manager",
-				UsesCommonTypes: false,
+		sourceDataTypes := []ui.SourceDataType{
+			{
+				Name: "microsoft-graph",
+				Options: v1.Options{
+					ServiceType:     repositories.MicrosoftGraphServiceType,
+					UriPrefix:       "/v1/microsoft-graph",
+					UsesCommonTypes: true,
+				},
+			},
+			{
+				Name: "resource-manager",
+				Options: v1.Options{
+					ServiceType:     repositories.ResourceManagerServiceType,
+					UriPrefix:       "/v1/resource-manager",
+					UsesCommonTypes: false,
+				},
+			},
+		}
+		for i, sourceDataType := range sourceDataTypes {
+			serviceRepo, ok := serviceRepositories[sourceDataType.Options.ServiceType]
+			if !ok {
+				logging.Fatalf("Error: no Services Repository was configured for %q", string(sourceDataType.Options.ServiceType))
 			}
-			serviceRepo, err := repositories.NewServicesRepository(directory, opts.ServiceType, serviceNames)
-			if err != nil {
-				logging.Fatalf("Error: %+v", err)
-			}
-			v1.Router(r, opts, serviceRepo)
-		})
+			sourceDataTypes[i].Repository = serviceRepo
+		}
+
+		router.Route("/v1", infrastructure.Router)
+		for _, sourceDataType := range sourceDataTypes {
+			opts := sourceDataType.Options
+			serviceRepo := sourceDataType.Repository
+			router.Route(opts.UriPrefix, func(r chi.Router) {
+				v1.Router(r, opts, serviceRepo)
+			})
+		}
+		router.Route("/ui", ui.Router(sourceDataTypes))
 		router.Get("/", HomePage(router))
 	}
 }
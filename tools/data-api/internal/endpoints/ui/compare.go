@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ui
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+type comparisonStatus string
+
+const (
+	addedComparisonStatus     comparisonStatus = "added"
+	changedComparisonStatus   comparisonStatus = "changed"
+	removedComparisonStatus   comparisonStatus = "removed"
+	unchangedComparisonStatus comparisonStatus = "unchanged"
+)
+
+// comparisonRow contains the view of an item within both the initial and updated API Versions, either of which
+// is nil when the item doesn't exist within that API Version.
+type comparisonRow[T any] struct {
+	Name    string
+	Status  comparisonStatus
+	Initial *T
+	Updated *T
+}
+
+type comparisonView struct {
+	Constants   []comparisonRow[constantView]
+	Models      []comparisonRow[modelView]
+	Operations  []comparisonRow[operationView]
+	ResourceIDs []comparisonRow[resourceIDView]
+}
+
+// compareAPIResources returns the side-by-side comparison of the same API Resource within two API Versions, where
+// changesOnly specifies whether items which are the same in both API Versions should be omitted.
+func compareAPIResources(initial, updated linker, changesOnly bool) comparisonView {
+	return comparisonView{
+		Constants: compareItems(initial.resource.Constants, updated.resource.Constants, changesOnly, func(l linker, name string, input models.SDKConstant) constantView {
+			return l.constantView(name, input)
+		}, initial, updated),
+		Models: compareItems(initial.resource.Models, updated.resource.Models, changesOnly, func(l linker, name string, input models.SDKModel) modelView {
+			return l.modelView(name, input, l.resource.Models)
+		}, initial, updated),
+		Operations: compareItems(initial.resource.Operations, updated.resource.Operations, changesOnly, func(l linker, name string, input models.SDKOperation) operationView {
+			return l.operationView(name, input)
+		}, initial, updated),
+		ResourceIDs: compareItems(initial.resource.ResourceIDs, updated.resource.ResourceIDs, changesOnly, func(l linker, name string, input models.ResourceID) resourceIDView {
+			return l.resourceIDView(name, input)
+		}, initial, updated),
+	}
+}
+
+func compareItems[T any, V any](initial, updated map[string]T, changesOnly bool, viewFunc func(linker, string, T) V, initialLinker, updatedLinker linker) []comparisonRow[V] {
+	names := make(map[string]struct{})
+	for name := range initial {
+		names[name] = struct{}{}
+	}
+	for name := range updated {
+		names[name] = struct{}{}
+	}
+
+	output := make([]comparisonRow[V], 0)
+	for _, name := range sortedKeys(names) {
+		initialItem, inInitial := initial[name]
+		updatedItem, inUpdated := updated[name]
+
+		row := comparisonRow[V]{
+			Name: name,
+		}
+		switch {
+		case !inInitial:
+			row.Status = addedComparisonStatus
+		case !inUpdated:
+			row.Status = removedComparisonStatus
+		case reflect.DeepEqual(initialItem, updatedItem):
+			row.Status = unchangedComparisonStatus
+		default:
+			row.Status = changedComparisonStatus
+		}
+		if changesOnly && row.Status == unchangedComparisonStatus {
+			continue
+		}
+
+		if inInitial {
+			view := viewFunc(initialLinker, name, initialItem)
+			row.Initial = &view
+		}
+		if inUpdated {
+			view := viewFunc(updatedLinker, name, updatedItem)
+			row.Updated = &view
+		}
+		output = append(output, row)
+	}
+	return output
+}
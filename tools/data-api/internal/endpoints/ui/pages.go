@@ -0,0 +1,450 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1/transforms"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+type servicesPage struct {
+	CommonTypes *link
+	Services    []serviceSummary
+}
+
+type serviceSummary struct {
+	Name        string
+	URI         string
+	Generate    bool
+	APIVersions int
+}
+
+type servicePage struct {
+	APIVersions        []apiVersionSummary
+	TerraformResources []terraformResourceSummary
+	ResourceNames      []string
+	APIVersionNames    []string
+	CompareURI         string
+}
+
+type apiVersionSummary struct {
+	Name      string
+	URI       string
+	Generate  bool
+	Source    string
+	Resources int
+}
+
+type terraformResourceSummary struct {
+	Label        string
+	ResourceName string
+	APIResource  link
+	APIVersion   string
+	Generate     bool
+}
+
+type apiVersionPage struct {
+	Resources []apiResourceSummary
+}
+
+type apiResourceSummary struct {
+	Name        string
+	URI         string
+	Constants   int
+	Models      int
+	Operations  int
+	ResourceIDs int
+}
+
+type apiResourcePage struct {
+	CompareWith []link
+	Constants   []constantView
+	Models      []modelView
+	Operations  []operationView
+	ResourceIDs []resourceIDView
+}
+
+type comparePage struct {
+	ResourceName      string
+	InitialAPIVersion link
+	UpdatedAPIVersion link
+	ChangesOnly       bool
+	ToggleURI         string
+	Comparison        comparisonView
+}
+
+func (u ui) index(w http.ResponseWriter, r *http.Request) {
+	sourceDataTypes := make([]link, 0)
+	for _, sourceDataType := range u.sourceDataTypes {
+		sourceDataTypes = append(sourceDataTypes, link{
+			Name: sourceDataType.Name,
+			URI:  sourceDataTypeURI(sourceDataType),
+		})
+	}
+
+	renderPage(w, "index.html", page{
+		Title:   "API Definitions",
+		Content: sourceDataTypes,
+	})
+}
+
+func (u ui) services(w http.ResponseWriter, r *http.Request) {
+	sourceDataType, ok := u.sourceDataType(r)
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	services, err := sourceDataType.Repository.GetAll(sourceDataType.Options.ServiceType)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("loading services: %+v", err))
+		return
+	}
+
+	content := servicesPage{
+		Services: make([]serviceSummary, 0),
+	}
+	if sourceDataType.Options.UsesCommonTypes {
+		content.CommonTypes = &link{
+			Name: "Common Types",
+			URI:  commonTypesURI(*sourceDataType),
+		}
+	}
+	for _, service := range *services {
+		content.Services = append(content.Services, serviceSummary{
+			Name:        service.Name,
+			URI:         serviceURI(*sourceDataType, service.Name),
+			Generate:    service.Generate,
+			APIVersions: len(service.ApiVersions),
+		})
+	}
+	sort.Slice(content.Services, func(i, j int) bool {
+		return content.Services[i].Name < content.Services[j].Name
+	})
+
+	renderPage(w, "services.html", page{
+		Title:       sourceDataType.Name,
+		Breadcrumbs: breadcrumbs(*sourceDataType),
+		JSONURI:     fmt.Sprintf("%s/services", sourceDataType.Options.UriPrefix),
+		Content:     content,
+	})
+}
+
+func (u ui) commonTypes(w http.ResponseWriter, r *http.Request) {
+	sourceDataType, ok := u.sourceDataType(r)
+	if !ok || !sourceDataType.Options.UsesCommonTypes {
+		notFound(w)
+		return
+	}
+
+	commonTypes, err := u.loadCommonTypes(*sourceDataType)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+
+	l := linker{
+		resource: models.APIResource{
+			Constants: commonTypes.Constants,
+			Models:    commonTypes.Models,
+		},
+	}
+	renderPage(w, "api_resource.html", page{
+		Title:       "Common Types",
+		Breadcrumbs: breadcrumbs(*sourceDataType),
+		JSONURI:     fmt.Sprintf("%s/commonTypes", sourceDataType.Options.UriPrefix),
+		Content: apiResourcePage{
+			Constants: l.constantViews(commonTypes.Constants),
+			Models:    l.modelViews(commonTypes.Models),
+		},
+	})
+}
+
+func (u ui) service(w http.ResponseWriter, r *http.Request) {
+	sourceDataType, service, ok := u.loadService(w, r)
+	if !ok {
+		return
+	}
+
+	content := servicePage{
+		APIVersions:        make([]apiVersionSummary, 0),
+		TerraformResources: make([]terraformResourceSummary, 0),
+		CompareURI:         fmt.Sprintf("%s/compare", serviceURI(*sourceDataType, service.Name)),
+	}
+	resourceNames := make(map[string]struct{})
+	for _, apiVersionName := range sortedKeys(service.ApiVersions) {
+		apiVersion := service.ApiVersions[apiVersionName]
+		if apiVersion == nil {
+			continue
+		}
+		content.APIVersions = append(content.APIVersions, apiVersionSummary{
+			Name:      apiVersionName,
+			URI:       apiVersionURI(*sourceDataType, service.Name, apiVersionName),
+			Generate:  apiVersion.Generate,
+			Source:    string(apiVersion.Source),
+			Resources: len(apiVersion.Resources),
+		})
+		content.APIVersionNames = append(content.APIVersionNames, apiVersionName)
+		for resourceName := range apiVersion.Resources {
+			resourceNames[resourceName] = struct{}{}
+		}
+	}
+	content.ResourceNames = sortedKeys(resourceNames)
+
+	for _, label := range sortedKeys(service.TerraformDetails.Resources) {
+		resource := service.TerraformDetails.Resources[label]
+		content.TerraformResources = append(content.TerraformResources, terraformResourceSummary{
+			Label:        label,
+			ResourceName: resource.ResourceName,
+			APIResource: link{
+				Name: resource.Resource,
+				URI:  apiResourceURI(*sourceDataType, service.Name, resource.ApiVersion, resource.Resource),
+			},
+			APIVersion: resource.ApiVersion,
+			Generate:   resource.Generate,
+		})
+	}
+
+	renderPage(w, "service.html", page{
+		Title:       service.Name,
+		Breadcrumbs: breadcrumbs(*sourceDataType),
+		JSONURI:     fmt.Sprintf("%s/services/%s", sourceDataType.Options.UriPrefix, service.Name),
+		Content:     content,
+	})
+}
+
+func (u ui) apiVersion(w http.ResponseWriter, r *http.Request) {
+	sourceDataType, service, ok := u.loadService(w, r)
+	if !ok {
+		return
+	}
+
+	apiVersionName := chi.URLParam(r, "apiVersion")
+	apiVersion, ok := service.ApiVersions[apiVersionName]
+	if !ok || apiVersion == nil {
+		notFound(w)
+		return
+	}
+
+	content := apiVersionPage{
+		Resources: make([]apiResourceSummary, 0),
+	}
+	for _, resourceName := range sortedKeys(apiVersion.Resources) {
+		resource := apiVersion.Resources[resourceName]
+		if resource == nil {
+			continue
+		}
+		content.Resources = append(content.Resources, apiResourceSummary{
+			Name:        resourceName,
+			URI:         apiResourceURI(*sourceDataType, service.Name, apiVersionName, resourceName),
+			Constants:   len(resource.Schema.Constants),
+			Models:      len(resource.Schema.Models),
+			Operations:  len(resource.Operations),
+			ResourceIDs: len(resource.Schema.ResourceIds),
+		})
+	}
+
+	renderPage(w, "api_version.html", page{
+		Title:       fmt.Sprintf("%s %s", service.Name, apiVersionName),
+		Breadcrumbs: breadcrumbs(*sourceDataType, serviceLink(*sourceDataType, service.Name)),
+		JSONURI:     fmt.Sprintf("%s/services/%s/%s", sourceDataType.Options.UriPrefix, service.Name, apiVersionName),
+		Content:     content,
+	})
+}
+
+func (u ui) apiResource(w http.ResponseWriter, r *http.Request) {
+	sourceDataType, service, ok := u.loadService(w, r)
+	if !ok {
+		return
+	}
+
+	apiVersionName := chi.URLParam(r, "apiVersion")
+	resourceName := chi.URLParam(r, "resourceName")
+	l, ok, err := u.linkerForAPIResource(*sourceDataType, *service, apiVersionName, resourceName, "")
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+	if !ok {
+		notFound(w)
+		return
+	}
+
+	content := apiResourcePage{
+		CompareWith: make([]link, 0),
+		Constants:   l.constantViews(l.resource.Constants),
+		Models:      l.modelViews(l.resource.Models),
+		Operations:  l.operationViews(l.resource.Operations),
+		ResourceIDs: l.resourceIDViews(l.resource.ResourceIDs),
+	}
+	for _, otherAPIVersionName := range sortedKeys(service.ApiVersions) {
+		otherAPIVersion := service.ApiVersions[otherAPIVersionName]
+		if otherAPIVersion == nil || otherAPIVersion.Resources[resourceName] == nil || otherAPIVersionName == apiVersionName {
+			continue
+		}
+		content.CompareWith = append(content.CompareWith, link{
+			Name: otherAPIVersionName,
+			URI:  compareURI(*sourceDataType, service.Name, resourceName, apiVersionName, otherAPIVersionName),
+		})
+	}
+
+	renderPage(w, "api_resource.html", page{
+		Title: fmt.Sprintf("%s %s %s", service.Name, apiVersionName, resourceName),
+		Breadcrumbs: breadcrumbs(*sourceDataType, serviceLink(*sourceDataType, service.Name), link{
+			Name: apiVersionName,
+			URI:  apiVersionURI(*sourceDataType, service.Name, apiVersionName),
+		}),
+		JSONURI: fmt.Sprintf("%s/services/%s/%s/%s/schema", sourceDataType.Options.UriPrefix, service.Name, apiVersionName, resourceName),
+		Content: content,
+	})
+}
+
+func (u ui) compare(w http.ResponseWriter, r *http.Request) {
+	sourceDataType, service, ok := u.loadService(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	resourceName := query.Get("resource")
+	initialAPIVersionName := query.Get("initial")
+	updatedAPIVersionName := query.Get("updated")
+	changesOnly := query.Get("changesOnly") == "true"
+	if resourceName == "" || initialAPIVersionName == "" || updatedAPIVersionName == "" {
+		http.Error(w, "the `resource`, `initial` and `updated` query strings must be specified", http.StatusBadRequest)
+		return
+	}
+
+	initialURI := apiResourceURI(*sourceDataType, service.Name, initialAPIVersionName, resourceName)
+	initial, initialExists, err := u.linkerForAPIResource(*sourceDataType, *service, initialAPIVersionName, resourceName, initialURI)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+	updatedURI := apiResourceURI(*sourceDataType, service.Name, updatedAPIVersionName, resourceName)
+	updated, updatedExists, err := u.linkerForAPIResource(*sourceDataType, *service, updatedAPIVersionName, resourceName, updatedURI)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+	if !initialExists && !updatedExists {
+		notFound(w)
+		return
+	}
+
+	toggle := query
+	toggle.Set("changesOnly", fmt.Sprintf("%t", !changesOnly))
+	content := comparePage{
+		ResourceName: resourceName,
+		InitialAPIVersion: link{
+			Name: initialAPIVersionName,
+		},
+		UpdatedAPIVersion: link{
+			Name: updatedAPIVersionName,
+		},
+		ChangesOnly: changesOnly,
+		ToggleURI:   fmt.Sprintf("%s/compare?%s", serviceURI(*sourceDataType, service.Name), toggle.Encode()),
+		Comparison:  compareAPIResources(*initial, *updated, changesOnly),
+	}
+	if initialExists {
+		content.InitialAPIVersion.URI = initialURI
+	}
+	if updatedExists {
+		content.UpdatedAPIVersion.URI = updatedURI
+	}
+
+	renderPage(w, "compare.html", page{
+		Title:       fmt.Sprintf("%s %s: %s vs %s", service.Name, resourceName, initialAPIVersionName, updatedAPIVersionName),
+		Breadcrumbs: breadcrumbs(*sourceDataType, serviceLink(*sourceDataType, service.Name)),
+		Content:     content,
+	})
+}
+
+// loadService loads the Service specified in the URL parameters, writing an error response when this fails.
+func (u ui) loadService(w http.ResponseWriter, r *http.Request) (*SourceDataType, *repositories.ServiceDetails, bool) {
+	sourceDataType, ok := u.sourceDataType(r)
+	if !ok {
+		notFound(w)
+		return nil, nil, false
+	}
+
+	serviceName := chi.URLParam(r, "serviceName")
+	service, err := sourceDataType.Repository.GetByName(serviceName, sourceDataType.Options.ServiceType)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("retrieving service %q: %+v", serviceName, err))
+		return nil, nil, false
+	}
+
+	return sourceDataType, service, true
+}
+
+func (u ui) loadCommonTypes(sourceDataType SourceDataType) (*models.CommonTypes, error) {
+	services, err := sourceDataType.Repository.GetAll(sourceDataType.Options.ServiceType)
+	if err != nil {
+		return nil, fmt.Errorf("loading services: %+v", err)
+	}
+
+	return v1.BuildCommonTypes(sourceDataType.Options, services)
+}
+
+// linkerForAPIResource returns a linker for the API Resource resourceName within the API Version apiVersionName,
+// which links to the page at resourceURI. An empty linker is returned when the API Resource doesn't exist.
+func (u ui) linkerForAPIResource(sourceDataType SourceDataType, service repositories.ServiceDetails, apiVersionName, resourceName, resourceURI string) (*linker, bool, error) {
+	output := linker{
+		resourceURI: resourceURI,
+	}
+	if sourceDataType.Options.UsesCommonTypes {
+		commonTypes, err := u.loadCommonTypes(sourceDataType)
+		if err != nil {
+			return nil, false, err
+		}
+		output.commonTypes = commonTypes
+		output.commonTypesURI = commonTypesURI(sourceDataType)
+	}
+
+	apiVersion, ok := service.ApiVersions[apiVersionName]
+	if !ok || apiVersion == nil {
+		return &output, false, nil
+	}
+	resource, ok := apiVersion.Resources[resourceName]
+	if !ok || resource == nil {
+		return &output, false, nil
+	}
+
+	mapped, err := transforms.MapAPIResource(*resource)
+	if err != nil {
+		return nil, false, fmt.Errorf("mapping API Resource %q: %+v", resourceName, err)
+	}
+	output.resource = *mapped
+	return &output, true, nil
+}
+
+func serviceLink(sourceDataType SourceDataType, serviceName string) link {
+	return link{
+		Name: serviceName,
+		URI:  serviceURI(sourceDataType, serviceName),
+	}
+}
+
+// breadcrumbs returns the links to the parent pages of the current page, starting from the index.
+func breadcrumbs(sourceDataType SourceDataType, others ...link) []link {
+	output := []link{
+		{
+			Name: "API Definitions",
+			URI:  indexURI(),
+		},
+		{
+			Name: sourceDataType.Name,
+			URI:  sourceDataTypeURI(sourceDataType),
+		},
+	}
+	return append(output, others...)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1"
+	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// SourceDataType defines a Source Data Type which can be browsed using the UI.
+type SourceDataType struct {
+	// Name is the name of this Source Data Type used within the URI, e.g. `resource-manager`.
+	Name string
+
+	// Options specifies the Options used for the Data API endpoints for this Source Data Type.
+	Options v1.Options
+
+	// Repository is the Services Repository containing the API Definitions for this Source Data Type.
+	Repository repositories.ServicesRepository
+}
+
+type ui struct {
+	sourceDataTypes []SourceDataType
+}
+
+// Router returns the routes for the HTML explorer, which allows browsing the API Definitions for each
+// Source Data Type by Service, API Version and API Resource.
+func Router(sourceDataTypes []SourceDataType) func(chi.Router) {
+	return func(router chi.Router) {
+		u := ui{
+			sourceDataTypes: sourceDataTypes,
+		}
+
+		router.Use(middleware.StripSlashes)
+		router.Get("/", u.index)
+		router.Route("/{sourceDataType}", func(r chi.Router) {
+			r.Get("/", u.services)
+			r.Get("/commonTypes", u.commonTypes)
+			r.Get("/services/{serviceName}", u.service)
+			r.Get("/services/{serviceName}/compare", u.compare)
+			r.Get("/services/{serviceName}/{apiVersion}", u.apiVersion)
+			r.Get("/services/{serviceName}/{apiVersion}/{resourceName}", u.apiResource)
+		})
+	}
+}
+
+// sourceDataType returns the Source Data Type specified in the `sourceDataType` URL parameter, if it exists.
+func (u ui) sourceDataType(r *http.Request) (*SourceDataType, bool) {
+	name := chi.URLParam(r, "sourceDataType")
+	for _, sourceDataType := range u.sourceDataTypes {
+		if sourceDataType.Name == name {
+			return &sourceDataType, true
+		}
+	}
+	return nil, false
+}
+
+func indexURI() string {
+	return "/ui"
+}
+
+func sourceDataTypeURI(sourceDataType SourceDataType) string {
+	return fmt.Sprintf("/ui/%s", url.PathEscape(sourceDataType.Name))
+}
+
+func commonTypesURI(sourceDataType SourceDataType) string {
+	return fmt.Sprintf("%s/commonTypes", sourceDataTypeURI(sourceDataType))
+}
+
+func serviceURI(sourceDataType SourceDataType, serviceName string) string {
+	return fmt.Sprintf("%s/services/%s", sourceDataTypeURI(sourceDataType), url.PathEscape(serviceName))
+}
+
+func apiVersionURI(sourceDataType SourceDataType, serviceName, apiVersion string) string {
+	return fmt.Sprintf("%s/%s", serviceURI(sourceDataType, serviceName), url.PathEscape(apiVersion))
+}
+
+func apiResourceURI(sourceDataType SourceDataType, serviceName, apiVersion, resourceName string) string {
+	return fmt.Sprintf("%s/%s", apiVersionURI(sourceDataType, serviceName, apiVersion), url.PathEscape(resourceName))
+}
+
+func compareURI(sourceDataType SourceDataType, serviceName, resourceName, initialAPIVersion, updatedAPIVersion string) string {
+	query := url.Values{}
+	query.Set("resource", resourceName)
+	query.Set("initial", initialAPIVersion)
+	query.Set("updated", updatedAPIVersion)
+	return fmt.Sprintf("%s/compare?%s", serviceURI(sourceDataType, serviceName), query.Encode())
+}
+
+func internalServerError(w http.ResponseWriter, err error) {
+	logging.Errorf("%+v", err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+func notFound(w http.ResponseWriter) {
+	http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+}
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ui
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"net/http"
+)
+
+//go:embed templates/*.html
+var templateFiles embed.FS
+
+var templates = template.Must(template.ParseFS(templateFiles, "templates/*.html"))
+
+// page contains the data common to every page, where Content contains the data specific to the template.
+type page struct {
+	Title       string
+	Breadcrumbs []link
+
+	// JSONURI optionally specifies the URI of the Data API endpoint containing the same information as this page.
+	JSONURI string
+
+	Content interface{}
+}
+
+// renderPage renders the template named templateName using data, which is rendered in full before being
+// written so that an error can be returned if the template fails to render.
+func renderPage(w http.ResponseWriter, templateName string, data page) {
+	var buffer bytes.Buffer
+	if err := templates.ExecuteTemplate(&buffer, templateName, data); err != nil {
+		internalServerError(w, fmt.Errorf("rendering the template %q: %+v", templateName, err))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(buffer.Bytes())
+}
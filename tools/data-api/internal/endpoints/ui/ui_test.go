@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ui
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+func TestUI(t *testing.T) {
+	repo, err := repositories.NewServicesRepository(os.DirFS("../../../../../api-definitions/"), repositories.ResourceManagerServiceType, &[]string{"ChaosStudio"})
+	if err != nil {
+		t.Fatalf("building repository: %+v", err)
+	}
+
+	router := chi.NewRouter()
+	router.Route("/ui", Router([]SourceDataType{
+		{
+			Name: "resource-manager",
+			Options: v1.Options{
+				ServiceType: repositories.ResourceManagerServiceType,
+				UriPrefix:   "/v1/resource-manager",
+			},
+			Repository: repo,
+		},
+	}))
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	testData := []struct {
+		uri                string
+		expectedStatusCode int
+		expectedContents   []string
+	}{
+		{
+			uri:                "/ui/",
+			expectedStatusCode: http.StatusOK,
+			expectedContents:   []string{`href="/ui/resource-manager"`},
+		},
+		{
+			uri:                "/ui/resource-manager",
+			expectedStatusCode: http.StatusOK,
+			expectedContents:   []string{`href="/ui/resource-manager/services/ChaosStudio"`},
+		},
+		{
+			uri:                "/ui/resource-manager/services/ChaosStudio",
+			expectedStatusCode: http.StatusOK,
+			expectedContents:   []string{`href="/ui/resource-manager/services/ChaosStudio/2023-11-01"`},
+		},
+		{
+			uri:                "/ui/resource-manager/services/ChaosStudio/2023-11-01",
+			expectedStatusCode: http.StatusOK,
+			expectedContents:   []string{`href="/ui/resource-manager/services/ChaosStudio/2023-11-01/Experiments"`},
+		},
+		{
+			uri:                "/ui/resource-manager/services/ChaosStudio/2023-11-01/Experiments",
+			expectedStatusCode: http.StatusOK,
+			expectedContents: []string{
+				// operations link to their Resource ID and Models
+				`<a href="#resource-id-ExperimentId">ExperimentId</a>`,
+				`<a href="#model-Experiment">Experiment</a>`,
+				// discriminated implementations link to their parent and vice versa
+				`implementation of <a href="#model-Action">Action</a> where <code>delay</code>`,
+				`<a href="#model-DelayAction">DelayAction</a>`,
+				// resource id segments are readable
+				`/providers/Microsoft.Chaos/experiments/<span class="parameter" title="UserSpecified">{experimentName}</span>`,
+				// and it's possible to compare this with other API Versions
+				`href="/ui/resource-manager/services/ChaosStudio/compare?initial=2023-11-01&amp;resource=Experiments&amp;updated=2024-01-01"`,
+			},
+		},
+		{
+			uri:                "/ui/resource-manager/services/ChaosStudio/compare?resource=Experiments&initial=2023-11-01&updated=2024-01-01",
+			expectedStatusCode: http.StatusOK,
+			expectedContents: []string{
+				`href="/ui/resource-manager/services/ChaosStudio/2023-11-01/Experiments#model-Experiment"`,
+				`href="/ui/resource-manager/services/ChaosStudio/2024-01-01/Experiments#model-Experiment"`,
+				`(unchanged)`,
+			},
+		},
+		{
+			uri:                "/ui/resource-manager/services/ChaosStudio/compare?resource=Experiments",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			uri:                "/ui/resource-manager/services/ChaosStudio/2023-11-01/DoesNotExist",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			uri:                "/ui/resource-manager/commonTypes",
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.uri)
+
+		resp, err := http.Get(server.URL + v.uri)
+		if err != nil {
+			t.Fatalf("retrieving %q: %+v", v.uri, err)
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatalf("reading %q: %+v", v.uri, err)
+		}
+
+		if resp.StatusCode != v.expectedStatusCode {
+			t.Fatalf("expected a %d but got %d for %q", v.expectedStatusCode, resp.StatusCode, v.uri)
+		}
+		for _, expected := range v.expectedContents {
+			if !strings.Contains(string(body), expected) {
+				t.Fatalf("expected %q to contain %q", v.uri, expected)
+			}
+		}
+	}
+}
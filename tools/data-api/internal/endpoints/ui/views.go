@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// link is a hyperlink to another page (or a section within a page).
+type link struct {
+	Name string
+	URI  string
+}
+
+// typeReference describes an SDKObjectDefinition in a readable form (e.g. `List[Dictionary[Thing]]`), where
+// the Constant or Model being referenced (if any) is linked to.
+type typeReference struct {
+	Prefix        string
+	ReferenceName string
+	ReferenceURI  string
+	Suffix        string
+}
+
+type constantView struct {
+	Name   string
+	Type   string
+	Values []constantValueView
+}
+
+type constantValueView struct {
+	Key   string
+	Value string
+}
+
+type modelView struct {
+	Name                                  string
+	DiscriminatedValue                    string
+	FieldNameContainingDiscriminatedValue string
+	Parent                                *link
+	Implementations                       []link
+	Fields                                []fieldView
+}
+
+type fieldView struct {
+	Name                       string
+	JsonName                   string
+	Type                       typeReference
+	Required                   bool
+	ReadOnly                   bool
+	Sensitive                  bool
+	ContainsDiscriminatedValue bool
+	DateFormat                 string
+	Description                string
+}
+
+type operationView struct {
+	Name                             string
+	Method                           string
+	Path                             string
+	ResourceID                       *link
+	ContentType                      string
+	ExpectedStatusCodes              string
+	LongRunning                      bool
+	FieldContainingPaginationDetails string
+	Request                          *typeReference
+	Response                         *typeReference
+	Options                          []optionView
+}
+
+type optionView struct {
+	Name     string
+	In       string
+	Key      string
+	Type     typeReference
+	Required bool
+}
+
+type resourceIDView struct {
+	Name        string
+	CommonAlias string
+	Segments    []segmentView
+}
+
+type segmentView struct {
+	Name        string
+	Type        string
+	Value       string
+	IsParameter bool
+	Constant    *link
+}
+
+// linker determines the URI for the Constants, Models and Resource IDs referenced within an API Resource.
+type linker struct {
+	// resource is the API Resource containing the items being linked to.
+	resource models.APIResource
+
+	// resourceURI is the URI of the page for resource, which is empty when linking within the same page.
+	resourceURI string
+
+	// commonTypes optionally contains the Common Types, which are used when a reference isn't found within resource.
+	commonTypes *models.CommonTypes
+
+	// commonTypesURI is the URI of the page containing the Common Types.
+	commonTypesURI string
+}
+
+func (l linker) constantURI(name string) string {
+	if _, ok := l.resource.Constants[name]; ok {
+		return fmt.Sprintf("%s#constant-%s", l.resourceURI, name)
+	}
+	if l.commonTypes != nil {
+		if _, ok := l.commonTypes.Constants[name]; ok {
+			return fmt.Sprintf("%s#constant-%s", l.commonTypesURI, name)
+		}
+	}
+	return ""
+}
+
+func (l linker) modelURI(name string) string {
+	if _, ok := l.resource.Models[name]; ok {
+		return fmt.Sprintf("%s#model-%s", l.resourceURI, name)
+	}
+	if l.commonTypes != nil {
+		if _, ok := l.commonTypes.Models[name]; ok {
+			return fmt.Sprintf("%s#model-%s", l.commonTypesURI, name)
+		}
+	}
+	return ""
+}
+
+func (l linker) referenceURI(name string) string {
+	if uri := l.constantURI(name); uri != "" {
+		return uri
+	}
+	return l.modelURI(name)
+}
+
+func (l linker) resourceIDURI(name string) string {
+	if _, ok := l.resource.ResourceIDs[name]; ok {
+		return fmt.Sprintf("%s#resource-id-%s", l.resourceURI, name)
+	}
+	return ""
+}
+
+func (l linker) typeReferenceForObjectDefinition(input models.SDKObjectDefinition) typeReference {
+	prefix := ""
+	suffix := ""
+	current := input
+	for current.NestedItem != nil {
+		prefix += fmt.Sprintf("%s[", current.Type)
+		suffix += "]"
+		current = *current.NestedItem
+	}
+
+	output := typeReference{
+		Prefix: prefix,
+		Suffix: suffix,
+	}
+	if current.ReferenceName != nil {
+		output.ReferenceName = *current.ReferenceName
+		output.ReferenceURI = l.referenceURI(*current.ReferenceName)
+	} else {
+		output.Prefix += string(current.Type)
+	}
+	return output
+}
+
+func (l linker) typeReferenceForOptionObjectDefinition(input models.SDKOperationOptionObjectDefinition) typeReference {
+	prefix := ""
+	suffix := ""
+	current := input
+	for current.NestedItem != nil {
+		prefix += fmt.Sprintf("%s[", current.Type)
+		suffix += "]"
+		current = *current.NestedItem
+	}
+
+	output := typeReference{
+		Prefix: prefix,
+		Suffix: suffix,
+	}
+	if current.ReferenceName != nil {
+		output.ReferenceName = *current.ReferenceName
+		output.ReferenceURI = l.referenceURI(*current.ReferenceName)
+	} else {
+		output.Prefix += string(current.Type)
+	}
+	return output
+}
+
+func (l linker) constantViews(input map[string]models.SDKConstant) []constantView {
+	output := make([]constantView, 0)
+	for _, name := range sortedKeys(input) {
+		output = append(output, l.constantView(name, input[name]))
+	}
+	return output
+}
+
+func (l linker) constantView(name string, input models.SDKConstant) constantView {
+	output := constantView{
+		Name:   name,
+		Type:   string(input.Type),
+		Values: make([]constantValueView, 0),
+	}
+	for _, key := range sortedKeys(input.Values) {
+		output.Values = append(output.Values, constantValueView{
+			Key:   key,
+			Value: input.Values[key],
+		})
+	}
+	return output
+}
+
+func (l linker) modelViews(input map[string]models.SDKModel) []modelView {
+	output := make([]modelView, 0)
+	for _, name := range sortedKeys(input) {
+		output = append(output, l.modelView(name, input[name], input))
+	}
+	return output
+}
+
+// modelView returns the modelView for the SDKModel input, where allModels is used to find any implementations
+// of this SDKModel when it's the parent of a Discriminated Type.
+func (l linker) modelView(name string, input models.SDKModel, allModels map[string]models.SDKModel) modelView {
+	output := modelView{
+		Name:                                  name,
+		DiscriminatedValue:                    pointer.From(input.DiscriminatedValue),
+		FieldNameContainingDiscriminatedValue: pointer.From(input.FieldNameContainingDiscriminatedValue),
+		Implementations:                       make([]link, 0),
+		Fields:                                make([]fieldView, 0),
+	}
+	if input.ParentTypeName != nil {
+		output.Parent = &link{
+			Name: *input.ParentTypeName,
+			URI:  l.modelURI(*input.ParentTypeName),
+		}
+	}
+	for _, modelName := range sortedKeys(allModels) {
+		if parent := allModels[modelName].ParentTypeName; parent != nil && *parent == name {
+			output.Implementations = append(output.Implementations, link{
+				Name: modelName,
+				URI:  l.modelURI(modelName),
+			})
+		}
+	}
+	for _, fieldName := range sortedKeys(input.Fields) {
+		field := input.Fields[fieldName]
+		dateFormat := ""
+		if field.DateFormat != nil {
+			dateFormat = string(*field.DateFormat)
+		}
+		output.Fields = append(output.Fields, fieldView{
+			Name:                       fieldName,
+			JsonName:                   field.JsonName,
+			Type:                       l.typeReferenceForObjectDefinition(field.ObjectDefinition),
+			Required:                   field.Required,
+			ReadOnly:                   field.ReadOnly,
+			Sensitive:                  field.Sensitive,
+			ContainsDiscriminatedValue: field.ContainsDiscriminatedValue,
+			DateFormat:                 dateFormat,
+			Description:                field.Description,
+		})
+	}
+	return output
+}
+
+func (l linker) operationViews(input map[string]models.SDKOperation) []operationView {
+	output := make([]operationView, 0)
+	for _, name := range sortedKeys(input) {
+		output = append(output, l.operationView(name, input[name]))
+	}
+	return output
+}
+
+func (l linker) operationView(name string, input models.SDKOperation) operationView {
+	statusCodes := make([]string, 0)
+	for _, statusCode := range input.ExpectedStatusCodes {
+		statusCodes = append(statusCodes, strconv.Itoa(statusCode))
+	}
+
+	output := operationView{
+		Name:                             name,
+		Method:                           strings.ToUpper(input.Method),
+		ContentType:                      input.ContentType,
+		ExpectedStatusCodes:              strings.Join(statusCodes, ", "),
+		LongRunning:                      input.LongRunning,
+		FieldContainingPaginationDetails: pointer.From(input.FieldContainingPaginationDetails),
+		Options:                          make([]optionView, 0),
+	}
+	if input.ResourceIDName != nil {
+		output.ResourceID = &link{
+			Name: *input.ResourceIDName,
+			URI:  l.resourceIDURI(*input.ResourceIDName),
+		}
+		if resourceID, ok := l.resource.ResourceIDs[*input.ResourceIDName]; ok {
+			output.Path = readableResourceID(resourceID)
+		} else {
+			output.Path = fmt.Sprintf("{%s}", *input.ResourceIDName)
+		}
+	}
+	output.Path += pointer.From(input.URISuffix)
+	if input.RequestObject != nil {
+		output.Request = pointer.To(l.typeReferenceForObjectDefinition(*input.RequestObject))
+	}
+	if input.ResponseObject != nil {
+		output.Response = pointer.To(l.typeReferenceForObjectDefinition(*input.ResponseObject))
+	}
+	for _, optionName := range sortedKeys(input.Options) {
+		option := input.Options[optionName]
+		view := optionView{
+			Name:     optionName,
+			Type:     l.typeReferenceForOptionObjectDefinition(option.ObjectDefinition),
+			Required: option.Required,
+		}
+		if option.HeaderName != nil {
+			view.In = "Header"
+			view.Key = *option.HeaderName
+		}
+		if option.QueryStringName != nil {
+			view.In = "Query String"
+			view.Key = *option.QueryStringName
+		}
+		output.Options = append(output.Options, view)
+	}
+	return output
+}
+
+func (l linker) resourceIDViews(input map[string]models.ResourceID) []resourceIDView {
+	output := make([]resourceIDView, 0)
+	for _, name := range sortedKeys(input) {
+		output = append(output, l.resourceIDView(name, input[name]))
+	}
+	return output
+}
+
+func (l linker) resourceIDView(name string, input models.ResourceID) resourceIDView {
+	output := resourceIDView{
+		Name:        name,
+		CommonAlias: pointer.From(input.CommonIDAlias),
+		Segments:    make([]segmentView, 0),
+	}
+	for _, segment := range input.Segments {
+		view := segmentView{
+			Name:        segment.Name,
+			Type:        string(segment.Type),
+			Value:       segmentValue(segment),
+			IsParameter: isParameterSegment(segment),
+		}
+		if segment.ConstantReference != nil {
+			view.Constant = &link{
+				Name: *segment.ConstantReference,
+				URI:  l.constantURI(*segment.ConstantReference),
+			}
+		}
+		output.Segments = append(output.Segments, view)
+	}
+	return output
+}
+
+// readableResourceID returns the Resource ID in a readable form, where each user-specified segment is output
+// as `{name}` - for example `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}`.
+func readableResourceID(input models.ResourceID) string {
+	output := ""
+	for _, segment := range input.Segments {
+		output += fmt.Sprintf("/%s", segmentValue(segment))
+	}
+	return output
+}
+
+func segmentValue(input models.ResourceIDSegment) string {
+	if !isParameterSegment(input) {
+		return pointer.From(input.FixedValue)
+	}
+	return fmt.Sprintf("{%s}", input.Name)
+}
+
+func isParameterSegment(input models.ResourceIDSegment) bool {
+	return input.Type != models.StaticResourceIDSegmentType && input.Type != models.ResourceProviderResourceIDSegmentType
+}
+
+func sortedKeys[T any](input map[string]T) []string {
+	output := make([]string, 0, len(input))
+	for key := range input {
+		output = append(output, key)
+	}
+	sort.Strings(output)
+	return output
+}
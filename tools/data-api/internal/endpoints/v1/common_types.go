@@ -10,6 +10,7 @@ import (
 	"github.com/go-chi/render"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
 	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1/transforms"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
 )
 
 func (api Api) commonTypes(w http.ResponseWriter, r *http.Request) {
@@ -27,13 +28,24 @@ func (api Api) commonTypes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	payload, err := BuildCommonTypes(opts, services)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+
+	render.JSON(w, r, *payload)
+}
+
+// BuildCommonTypes returns the Common Types defined across the specified services, which is only populated when
+// Common Types are supported by this endpoint.
+func BuildCommonTypes(opts Options, services *[]repositories.ServiceDetails) (*models.CommonTypes, error) {
 	payload := models.CommonTypes{
 		Constants: map[string]models.SDKConstant{},
 		Models:    map[string]models.SDKModel{},
 	}
 	if !opts.UsesCommonTypes || services == nil {
-		render.JSON(w, r, payload)
-		return
+		return &payload, nil
 	}
 
 	for _, service := range *services {
@@ -49,33 +61,28 @@ func (api Api) commonTypes(w http.ResponseWriter, r *http.Request) {
 
 				mappedConstants, err := transforms.MapConstants(resource.Schema.Constants)
 				if err != nil {
-					internalServerError(w, fmt.Errorf("mapping constants for API Resource %q: %+v", resourceName, err))
-					return
+					return nil, fmt.Errorf("mapping constants for API Resource %q: %+v", resourceName, err)
 				}
 				for k, v := range *mappedConstants {
 					if _, ok := payload.Constants[k]; ok {
-						internalServerError(w, fmt.Errorf("constant %q already exists in common types, there is a duplicated definition in the source data for service: %s, version: %s, resource: %s", k, service.Name, version, resourceName))
-						return
+						return nil, fmt.Errorf("constant %q already exists in common types, there is a duplicated definition in the source data for service: %s, version: %s, resource: %s", k, service.Name, version, resourceName)
 					}
 					payload.Constants[k] = v
 				}
 
 				mappedModels, err := transforms.MapSDKModels(resource.Schema.Models)
 				if err != nil {
-					internalServerError(w, fmt.Errorf("mapping models for API Resource %q: %+v", resourceName, err))
-					return
+					return nil, fmt.Errorf("mapping models for API Resource %q: %+v", resourceName, err)
 				}
 				for k, v := range *mappedModels {
 					if _, ok := payload.Models[k]; ok {
-						internalServerError(w, fmt.Errorf("model %q already exists in common types, there is a duplicated definition in the source data for service: %s, version: %s, resource: %s", k, service.Name, version, resourceName))
-						return
+						return nil, fmt.Errorf("model %q already exists in common types, there is a duplicated definition in the source data for service: %s, version: %s, resource: %s", k, service.Name, version, resourceName)
 					}
 					payload.Models[k] = v
 				}
 			}
-
 		}
 	}
 
-	render.JSON(w, r, payload)
+	return &payload, nil
 }
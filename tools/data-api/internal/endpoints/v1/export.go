@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1/transforms"
+	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// export returns the Common Types and every Service (optionally filtered to the comma-separated list of Service
+// Names in the `services` query string) in a single response, matching the shape of `v1.LoadAllDataResult`.
+//
+// Only the Services being exported are loaded (unless every Service is required to build the Common Types), with
+// each Service being loaded, mapped and written to the response in turn - as such once the response has been
+// started any error can only be surfaced by terminating the response early.
+func (api Api) export(w http.ResponseWriter, r *http.Request) {
+	opts, ok := r.Context().Value("options").(Options)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing options"))
+		return
+	}
+
+	serviceNames, err := api.servicesRepository.GetServiceNames(opts.ServiceType)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("retrieving service names: %+v", err))
+		return
+	}
+	serviceNamesToExport := filterServiceNamesForExport(*serviceNames, r.URL.Query().Get("services"))
+
+	// the Common Types are defined across every Service, so all Services only need to be loaded when these are used
+	var allServices *[]repositories.ServiceDetails
+	if opts.UsesCommonTypes {
+		allServices, err = api.servicesRepository.GetAll(opts.ServiceType)
+		if err != nil {
+			internalServerError(w, fmt.Errorf("loading services: %+v", err))
+			return
+		}
+	}
+	commonTypes, err := BuildCommonTypes(opts, allServices)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flusher, _ := w.(http.Flusher)
+
+	encoder := json.NewEncoder(w)
+	fmt.Fprint(w, `{"CommonTypes":`)
+	if err := encoder.Encode(commonTypes); err != nil {
+		logging.Errorf("writing Common Types: %+v", err)
+		return
+	}
+	fmt.Fprint(w, `,"Services":{`)
+
+	for i, serviceName := range serviceNamesToExport {
+		service, err := api.servicesRepository.GetByName(serviceName, opts.ServiceType)
+		if err != nil {
+			logging.Errorf("loading Service %q: %+v", serviceName, err)
+			return
+		}
+
+		mapped, err := transforms.MapService(*service)
+		if err != nil {
+			logging.Errorf("mapping Service %q: %+v", serviceName, err)
+			return
+		}
+
+		if i > 0 {
+			fmt.Fprint(w, ",")
+		}
+		if err := encoder.Encode(serviceName); err != nil {
+			logging.Errorf("writing the name for Service %q: %+v", serviceName, err)
+			return
+		}
+		fmt.Fprint(w, ":")
+		if err := encoder.Encode(mapped); err != nil {
+			logging.Errorf("writing Service %q: %+v", serviceName, err)
+			return
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	fmt.Fprint(w, "}}")
+}
+
+// filterServiceNamesForExport returns the (sorted) serviceNames, limited to those named in the comma-separated
+// namesToExport - or all serviceNames when namesToExport is empty. Service Names which don't exist are ignored.
+func filterServiceNamesForExport(serviceNames []string, namesToExport string) []string {
+	namesToInclude := make(map[string]struct{})
+	for _, name := range strings.Split(namesToExport, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			namesToInclude[name] = struct{}{}
+		}
+	}
+
+	output := make([]string, 0)
+	for _, serviceName := range serviceNames {
+		if len(namesToInclude) > 0 {
+			if _, ok := namesToInclude[serviceName]; !ok {
+				continue
+			}
+		}
+		output = append(output, serviceName)
+	}
+
+	sort.Strings(output)
+
+	return output
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/openapi"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1/transforms"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// openApiForApiVersion returns a normalized OpenAPI 3.1 Document describing the API Version.
+func (api Api) openApiForApiVersion(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	opts, ok := ctx.Value("options").(Options)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing options"))
+		return
+	}
+
+	service, ok := ctx.Value("service").(*repositories.ServiceDetails)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing service"))
+		return
+	}
+	apiVersion, ok := ctx.Value("serviceApiVersion").(*repositories.ServiceApiVersionDetails)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing serviceApiVersion"))
+		return
+	}
+
+	mappedService, err := transforms.MapService(*service)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("mapping Service %q: %+v", service.Name, err))
+		return
+	}
+	mappedApiVersion, ok := mappedService.APIVersions[apiVersion.Name]
+	if !ok {
+		internalServerError(w, fmt.Errorf("mapped API Version %q was not found", apiVersion.Name))
+		return
+	}
+
+	// Common Types are defined across all Services, so these are only loaded when supported by this endpoint
+	var services *[]repositories.ServiceDetails
+	if opts.UsesCommonTypes {
+		services, err = api.servicesRepository.GetAll(opts.ServiceType)
+		if err != nil {
+			internalServerError(w, fmt.Errorf("loading services: %+v", err))
+			return
+		}
+	}
+	commonTypes, err := BuildCommonTypes(opts, services)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+
+	sourceDataType := models.SourceDataType(opts.ServiceType)
+	document, err := openapi.DocumentForAPIVersion(sourceDataType, service.Name, apiVersion.Name, mappedApiVersion, *commonTypes)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("building OpenAPI Document for %q API Version %q: %+v", service.Name, apiVersion.Name, err))
+		return
+	}
+
+	render.JSON(w, r, document)
+}
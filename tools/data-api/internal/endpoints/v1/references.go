@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/render"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1/transforms"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// referencesWithinApiResource returns every item within the API Resource which references the Constant, Model
+// or Resource ID specified in the `typeName` URL parameter - either directly or transitively.
+func (api Api) referencesWithinApiResource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	resource, ok := ctx.Value("resourceName").(*repositories.ServiceApiVersionResourceDetails)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing resourceName"))
+		return
+	}
+
+	typeName := chi.URLParam(r, "typeName")
+	_, isConstant := resource.Schema.Constants[typeName]
+	_, isModel := resource.Schema.Models[typeName]
+	_, isResourceId := resource.Schema.ResourceIds[typeName]
+	if !isConstant && !isModel && !isResourceId {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	mapped, err := transforms.MapAPIResource(*resource)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("mapping API Resource: %+v", err))
+		return
+	}
+
+	references, err := helpers.FindReferencesWithinAPIResource(*mapped, typeName)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("finding references to %q: %+v", typeName, err))
+		return
+	}
+
+	payload := v1.GetReferences{
+		References: *references,
+	}
+	render.JSON(w, r, payload)
+}
+
+// referencesToCommonType returns every item within the Common Types and within each API Resource which references
+// the Common Type specified in the `typeName` URL parameter - either directly or transitively.
+func (api Api) referencesToCommonType(w http.ResponseWriter, r *http.Request) {
+	opts, ok := r.Context().Value("options").(Options)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing options"))
+		return
+	}
+
+	services, err := api.servicesRepository.GetAll(opts.ServiceType)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("loading services: %+v", err))
+		return
+	}
+
+	commonTypes, err := BuildCommonTypes(opts, services)
+	if err != nil {
+		internalServerError(w, err)
+		return
+	}
+
+	typeName := chi.URLParam(r, "typeName")
+	_, isConstant := commonTypes.Constants[typeName]
+	_, isModel := commonTypes.Models[typeName]
+	if !isConstant && !isModel {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	mappedServices := make(map[string]models.Service)
+	for _, service := range *services {
+		mapped, err := transforms.MapService(service)
+		if err != nil {
+			internalServerError(w, fmt.Errorf("mapping Service %q: %+v", service.Name, err))
+			return
+		}
+		mappedServices[service.Name] = *mapped
+	}
+
+	references, err := helpers.FindReferencesToCommonType(*commonTypes, mappedServices, typeName)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("finding references to %q: %+v", typeName, err))
+		return
+	}
+
+	payload := v1.GetReferences{
+		References: *references,
+	}
+	render.JSON(w, r, payload)
+}
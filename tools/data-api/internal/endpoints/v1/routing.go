@@ -36,22 +36,29 @@ func Router(router chi.Router, options Options, servicesRepository repositories.
 	router.Use(optionsContext(options))
 
 	router.Get("/commonTypes", api.commonTypes)
+	router.Get("/commonTypes/references/{typeName}", api.referencesToCommonType)
+	router.Get("/_export", api.export)
+	router.Get("/search", api.search)
+	router.Get("/stats", api.statistics)
 
 	router.Route("/services", func(r chi.Router) {
 		r.Route("/{serviceName}", func(r chi.Router) {
 			r.Use(api.serviceRouteContext)
 
 			r.Get("/", api.serviceDetails)
+			r.Get("/stats", api.serviceStatistics)
 
 			r.Route("/{serviceApiVersion}", func(r chi.Router) {
 				r.Use(serviceApiVersionRouteContext)
 				r.Get("/", api.detailsForApiVersion)
+				r.Get("/openapi.json", api.openApiForApiVersion)
 
 				r.Route("/{resourceName}", func(r chi.Router) {
 					r.Use(apiResourceNameRouteContext)
 
 					r.Get("/operations", api.operationsForApiResource)
 					r.Get("/schema", api.schemaForApiResource)
+					r.Get("/references/{typeName}", api.referencesWithinApiResource)
 				})
 			})
 
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/render"
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+var searchResultKinds = map[repositories.SearchResultKind]v1.SearchResultKind{
+	repositories.ApiVersionSearchResultKind: v1.APIVersionSearchResultKind,
+	repositories.ConstantSearchResultKind:   v1.ConstantSearchResultKind,
+	repositories.FieldSearchResultKind:      v1.FieldSearchResultKind,
+	repositories.ModelSearchResultKind:      v1.ModelSearchResultKind,
+	repositories.OperationSearchResultKind:  v1.OperationSearchResultKind,
+	repositories.ResourceSearchResultKind:   v1.APIResourceSearchResultKind,
+	repositories.ResourceIdSearchResultKind: v1.ResourceIDSearchResultKind,
+	repositories.ServiceSearchResultKind:    v1.ServiceSearchResultKind,
+}
+
+// search returns the items whose name contains the `q` query string, optionally filtered to the comma-separated
+// list of kinds in the `kind` query string and/or to the Service in the `service` query string.
+func (api Api) search(w http.ResponseWriter, r *http.Request) {
+	opts, ok := r.Context().Value("options").(Options)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing options"))
+		return
+	}
+
+	query := repositories.SearchQuery{
+		Query: strings.TrimSpace(r.URL.Query().Get("q")),
+	}
+	if query.Query == "" {
+		http.Error(w, "the `q` query string must be specified", http.StatusBadRequest)
+		return
+	}
+	if kinds := r.URL.Query().Get("kind"); kinds != "" {
+		for _, kind := range strings.Split(kinds, ",") {
+			value, ok := parseSearchResultKind(strings.TrimSpace(kind))
+			if !ok {
+				http.Error(w, fmt.Sprintf("unsupported kind %q", kind), http.StatusBadRequest)
+				return
+			}
+			query.Kinds = append(query.Kinds, value)
+		}
+	}
+	if serviceName := r.URL.Query().Get("service"); serviceName != "" {
+		query.ServiceName = &serviceName
+	}
+
+	results, err := api.servicesRepository.Search(opts.ServiceType, query)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("searching for %q: %+v", query.Query, err))
+		return
+	}
+
+	payload := v1.SearchResults{
+		Results: make([]v1.SearchResult, 0),
+	}
+	for _, result := range *results {
+		payload.Results = append(payload.Results, v1.SearchResult{
+			Kind:        searchResultKinds[result.Kind],
+			Name:        result.Name,
+			Path:        result.Path,
+			ServiceName: result.ServiceName,
+			APIVersion:  result.ApiVersion,
+			APIResource: result.ResourceName,
+			ModelName:   result.ModelName,
+			URI:         uriForSearchResult(opts, result),
+		})
+	}
+	render.JSON(w, r, payload)
+}
+
+// parseSearchResultKind parses the (case-insensitive) kind used in the Data API into the SearchResultKind used
+// within the repository.
+func parseSearchResultKind(input string) (repositories.SearchResultKind, bool) {
+	for repositoryKind, kind := range searchResultKinds {
+		if strings.EqualFold(string(kind), input) {
+			return repositoryKind, true
+		}
+	}
+
+	return "", false
+}
+
+// uriForSearchResult returns the URI for the Data API endpoint which returns the item matched in result.
+func uriForSearchResult(opts Options, result repositories.SearchResult) string {
+	uri := fmt.Sprintf("%s/services/%s", opts.UriPrefix, result.ServiceName)
+	if result.ApiVersion == nil {
+		return uri
+	}
+
+	uri = fmt.Sprintf("%s/%s", uri, *result.ApiVersion)
+	if result.ResourceName == nil || result.Kind == repositories.ResourceSearchResultKind {
+		return uri
+	}
+
+	if result.Kind == repositories.OperationSearchResultKind {
+		return fmt.Sprintf("%s/%s/operations", uri, *result.ResourceName)
+	}
+
+	return fmt.Sprintf("%s/%s/schema", uri, *result.ResourceName)
+}
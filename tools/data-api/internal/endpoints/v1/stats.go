@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package v1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-chi/render"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/internal/endpoints/v1/transforms"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// serviceStatistics returns the number of Operations, Models, Constants and Resource IDs within each API Version
+// of this Service, along with how many of those Resource IDs have a Terraform Resource defined for them.
+func (api Api) serviceStatistics(w http.ResponseWriter, r *http.Request) {
+	service, ok := r.Context().Value("service").(*repositories.ServiceDetails)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing service"))
+		return
+	}
+
+	mapped, err := transforms.MapService(*service)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("mapping Service %q: %+v", service.Name, err))
+		return
+	}
+
+	payload := helpers.StatisticsForService(*mapped)
+	render.JSON(w, r, payload)
+}
+
+// statistics returns the statistics for each Service within this Source Data Type, along with the sum of these.
+func (api Api) statistics(w http.ResponseWriter, r *http.Request) {
+	opts, ok := r.Context().Value("options").(Options)
+	if !ok {
+		internalServerError(w, fmt.Errorf("missing options"))
+		return
+	}
+
+	services, err := api.servicesRepository.GetAll(opts.ServiceType)
+	if err != nil {
+		internalServerError(w, fmt.Errorf("loading services: %+v", err))
+		return
+	}
+
+	statistics := make(map[string]models.ServiceStatistics)
+	for _, service := range *services {
+		mapped, err := transforms.MapService(service)
+		if err != nil {
+			internalServerError(w, fmt.Errorf("mapping Service %q: %+v", service.Name, err))
+			return
+		}
+		statistics[service.Name] = helpers.StatisticsForService(*mapped)
+	}
+
+	payload := helpers.AggregateStatisticsForServices(statistics)
+	render.JSON(w, r, payload)
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package transforms
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/internal/repositories"
+)
+
+// MapService maps the ServiceDetails type from the repositories package into the V1 API Response Model,
+// including all of the API Versions, API Resources and Terraform Definitions contained within it.
+func MapService(input repositories.ServiceDetails) (*models.Service, error) {
+	output := models.Service{
+		APIVersions:      make(map[string]models.APIVersion),
+		Generate:         input.Generate,
+		ResourceProvider: input.ResourceProvider,
+	}
+
+	for key, value := range input.ApiVersions {
+		if value == nil {
+			continue
+		}
+
+		mappedAPIVersion, err := mapAPIVersion(*value)
+		if err != nil {
+			return nil, fmt.Errorf("mapping API Version %q: %+v", key, err)
+		}
+		output.APIVersions[value.Name] = *mappedAPIVersion
+	}
+
+	// NOTE: the Terraform endpoint returns no content when there are no Terraform Resources, so we do the same
+	if len(input.TerraformDetails.Resources) > 0 {
+		resources, err := MapTerraformResourceDefinitions(input.TerraformDetails.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("mapping Terraform Resources: %+v", err)
+		}
+		output.TerraformDefinition = &models.TerraformDefinition{
+			Resources:            *resources,
+			TerraformPackageName: pointer.From(input.TerraformPackageName),
+		}
+	}
+
+	return &output, nil
+}
+
+func mapAPIVersion(input repositories.ServiceApiVersionDetails) (*models.APIVersion, error) {
+	source, err := MapSourceDataOrigin(input.Source)
+	if err != nil {
+		return nil, fmt.Errorf("mapping SourceDataOrigin: %+v", err)
+	}
+
+	output := models.APIVersion{
+		Generate:  input.Generate,
+		Resources: make(map[string]models.APIResource),
+		Source:    *source,
+	}
+	for key, value := range input.Resources {
+		if value == nil {
+			continue
+		}
+
+		mappedAPIResource, err := MapAPIResource(*value)
+		if err != nil {
+			return nil, fmt.Errorf("mapping API Resource %q: %+v", key, err)
+		}
+		output.Resources[key] = *mappedAPIResource
+	}
+
+	return &output, nil
+}
+
+// MapAPIResource maps the ServiceApiVersionResourceDetails type from the repositories package into the V1 API Response Model.
+func MapAPIResource(input repositories.ServiceApiVersionResourceDetails) (*models.APIResource, error) {
+	constants, err := MapConstants(input.Schema.Constants)
+	if err != nil {
+		return nil, fmt.Errorf("mapping Constants: %+v", err)
+	}
+	sdkModels, err := MapSDKModels(input.Schema.Models)
+	if err != nil {
+		return nil, fmt.Errorf("mapping Models: %+v", err)
+	}
+	operations, err := MapSDKOperations(input.Operations)
+	if err != nil {
+		return nil, fmt.Errorf("mapping SDK Operations: %+v", err)
+	}
+	resourceIds, err := MapResourceIDs(input.Schema.ResourceIds, *constants)
+	if err != nil {
+		return nil, fmt.Errorf("mapping ResourceIDs: %+v", err)
+	}
+
+	return &models.APIResource{
+		Constants:   *constants,
+		Models:      *sdkModels,
+		Operations:  *operations,
+		ResourceIDs: *resourceIds,
+	}, nil
+}
@@ -7,7 +7,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
+	"io/fs"
 	"path"
 
 	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
@@ -15,28 +15,27 @@ import (
 )
 
 func (s *ServicesRepositoryImpl) discoverServiceTypeDirectories() (*[]string, error) {
-	// discoverServiceTypeDirectories finds all directories under the root directory that contain api definitions for a given
+	// discoverServiceTypeDirectories finds all directories under the root of the filesystem that contain api definitions for a given
 	// service type by checking for a metadata.json and comparing the Data Source value defined within it to the Data Source
 	// value we're expecting for the Services Repository
-	dirs, err := listSubDirectories(s.rootDirectory)
+	dirs, err := listSubDirectories(s.fileSystem, ".")
 	if err != nil {
-		return nil, fmt.Errorf("listing directories under %q: %+v", s.rootDirectory, err)
+		return nil, fmt.Errorf("listing directories under the root directory: %+v", err)
 	}
 
 	serviceTypeDirectories := make([]string, 0)
 
-	for _, d := range *dirs {
-		serviceTypeDir := path.Join(s.rootDirectory, d)
-
+	for _, serviceTypeDir := range *dirs {
 		// check whether directory contains a metadata.json
+		metadataPath := path.Join(serviceTypeDir, "metadata.json")
+		if _, err := fs.Stat(s.fileSystem, metadataPath); errors.Is(err, fs.ErrNotExist) {
+			// this folder has no metadata.json, so we skip it
+			continue
+		}
+
 		var metadata dataapimodels.MetaData
-		contents, err := loadJson(path.Join(serviceTypeDir, "metadata.json"))
+		contents, err := loadJson(s.fileSystem, metadataPath)
 		if err != nil {
-			var pathError *os.PathError
-			if errors.As(err, &pathError) {
-				// this folder has no metadata.json, so we skip it
-				continue
-			}
 			return nil, fmt.Errorf("loading metadata.json: %+v", err)
 		}
 
@@ -54,13 +53,31 @@ func (s *ServicesRepositoryImpl) discoverServiceTypeDirectories() (*[]string, er
 	return &serviceTypeDirectories, nil
 }
 
-func (s *ServicesRepositoryImpl) discoverSubsetOfServices() error {
-	// discoverSubsetOfServices populates the serviceNamesToDirectory attribute of the ServicesRepositoryImpl.
+func (s *ServicesRepositoryImpl) discoverServices() (*map[string]string, error) {
+	// discoverServices returns a map of the Service Names available for this service type to the directory containing
+	// their definitions, limited to the subset of services specified when the Services Repository was initialised
+	if s.serviceNames != nil {
+		services, err := s.discoverSubsetOfServices()
+		if err != nil {
+			return nil, fmt.Errorf("discovering subset of services for %q: %+v", string(s.serviceType), err)
+		}
+		return services, nil
+	}
+
+	services, err := s.discoverAllServices()
+	if err != nil {
+		return nil, fmt.Errorf("discovering all services for %q: %+v", string(s.serviceType), err)
+	}
+	return services, nil
+}
+
+func (s *ServicesRepositoryImpl) discoverSubsetOfServices() (*map[string]string, error) {
+	// discoverSubsetOfServices builds the map of Service Names to directories for the ServicesRepositoryImpl.
 	// This function is called if we're spinning up the data API for a subset of services and avoids iterating over
 	// all available services.
 	dirs, err := s.discoverServiceTypeDirectories()
 	if err != nil {
-		return fmt.Errorf("discovering service type directories for service type %q: %+v", s.serviceType, err)
+		return nil, fmt.Errorf("discovering service type directories for service type %q: %+v", s.serviceType, err)
 	}
 
 	services := make(map[string]string, 0)
@@ -68,12 +85,12 @@ func (s *ServicesRepositoryImpl) discoverSubsetOfServices() error {
 		for _, service := range *s.serviceNames {
 			logging.Debugf("Finding service %q", service)
 			serviceDir := path.Join(d, service)
-			if _, err := os.Stat(serviceDir); os.IsNotExist(err) {
+			if _, err := fs.Stat(s.fileSystem, serviceDir); errors.Is(err, fs.ErrNotExist) {
 				// we continue here since the service we're looking for could exist in another source directory e.g. under handwritten definitions
 				continue
 			}
 			if _, ok := services[service]; ok {
-				return fmt.Errorf("duplicate definitions for service %q", service)
+				return nil, fmt.Errorf("duplicate definitions for service %q", service)
 			}
 			services[service] = serviceDir
 			logging.Debugf("Found service %q", service)
@@ -83,36 +100,34 @@ func (s *ServicesRepositoryImpl) discoverSubsetOfServices() error {
 	// this checks if all services have been found if we're running the data API for a subset
 	for _, service := range *s.serviceNames {
 		if _, ok := services[service]; !ok {
-			return fmt.Errorf("service %q was not found", service)
+			return nil, fmt.Errorf("service %q was not found", service)
 		}
 	}
 
-	s.serviceNamesToDirectory = &services
-
-	return nil
+	return &services, nil
 }
 
-func (s *ServicesRepositoryImpl) discoverAllServices() error {
-	// discoverAllServices populates the serviceNamesToDirectory attribute of the ServicesRepositoryImpl.
+func (s *ServicesRepositoryImpl) discoverAllServices() (*map[string]string, error) {
+	// discoverAllServices builds the map of Service Names to directories for the ServicesRepositoryImpl.
 	// It iterates through all available services to build a complete list of available services for a given
 	// service type and checks if there are duplicate definitions for a service.
 	dirs, err := s.discoverServiceTypeDirectories()
 	if err != nil {
-		return fmt.Errorf("discovering service type directories for service type %q: %+v", s.serviceType, err)
+		return nil, fmt.Errorf("discovering service type directories for service type %q: %+v", s.serviceType, err)
 	}
 
 	logging.Debugf("Finding all services")
 	allServices := make(map[string]string, 0)
 	for _, d := range *dirs {
-		files, err := os.ReadDir(d)
+		files, err := fs.ReadDir(s.fileSystem, d)
 		if err != nil {
-			return fmt.Errorf("getting all services: %+v", err)
+			return nil, fmt.Errorf("getting all services: %+v", err)
 		}
 
 		for _, f := range files {
 			if f.IsDir() {
 				if _, ok := allServices[f.Name()]; ok {
-					return fmt.Errorf("duplicate definitions for service %q", f.Name())
+					return nil, fmt.Errorf("duplicate definitions for service %q", f.Name())
 				}
 				allServices[f.Name()] = path.Join(d, f.Name())
 				logging.Debugf("Found service %q", f.Name())
@@ -120,7 +135,5 @@ func (s *ServicesRepositoryImpl) discoverAllServices() error {
 		}
 	}
 
-	s.serviceNamesToDirectory = &allServices
-
-	return nil
+	return &allServices, nil
 }
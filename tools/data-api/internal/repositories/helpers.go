@@ -5,18 +5,17 @@ package repositories
 
 import (
 	"fmt"
-	"io"
-	"os"
+	"io/fs"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
 )
 
-func listSubDirectories(path string) (*[]string, error) {
+func listSubDirectories(fileSystem fs.FS, path string) (*[]string, error) {
 	directories := make([]string, 0)
 
-	contents, err := os.ReadDir(path)
+	contents, err := fs.ReadDir(fileSystem, path)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving list of sub directories under %q: %+v", path, err)
 	}
@@ -30,32 +29,20 @@ func listSubDirectories(path string) (*[]string, error) {
 	return &directories, nil
 }
 
-func loadJson(path string) (*[]byte, error) {
-	contents, err := os.Open(path)
+func loadJson(fileSystem fs.FS, path string) (*[]byte, error) {
+	byteValue, err := fs.ReadFile(fileSystem, path)
 	if err != nil {
 		return nil, fmt.Errorf("loading %q: %+v", path, err)
 	}
-	defer contents.Close()
-
-	byteValue, err := io.ReadAll(contents)
-	if err != nil {
-		return nil, fmt.Errorf("reading contents of %q: %+v", path, err)
-	}
 
 	return &byteValue, nil
 }
 
-func loadHcl(path string) (string, error) {
-	contents, err := os.Open(path)
+func loadHcl(fileSystem fs.FS, path string) (string, error) {
+	byteValue, err := fs.ReadFile(fileSystem, path)
 	if err != nil {
 		return "", fmt.Errorf("loading %q: %+v", path, err)
 	}
-	defer contents.Close()
-
-	byteValue, err := io.ReadAll(contents)
-	if err != nil {
-		return "", fmt.Errorf("reading contents of %q: %+v", path, err)
-	}
 
 	return string(byteValue), nil
 }
@@ -0,0 +1,659 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/sdk/dataapimodels"
+)
+
+type ValidationProblemType string
+
+const (
+	// DuplicateDiscriminatedValueValidationProblemType specifies that multiple implementations of the same
+	// Discriminated Parent Type use the same Discriminated Value.
+	DuplicateDiscriminatedValueValidationProblemType ValidationProblemType = "DuplicateDiscriminatedValue"
+
+	// InvalidDefinitionValidationProblemType specifies that a definition couldn't be parsed, or is otherwise invalid
+	// (for example a List type without a Nested Item).
+	InvalidDefinitionValidationProblemType ValidationProblemType = "InvalidDefinition"
+
+	// InvalidTerraformMappingValidationProblemType specifies that a Terraform Resource Definition (or the Mappings
+	// for it) references a Schema Model, SDK Model, Field or Resource ID which doesn't exist.
+	InvalidTerraformMappingValidationProblemType ValidationProblemType = "InvalidTerraformMapping"
+
+	// MissingResourceIdValidationProblemType specifies that an Operation references a Resource ID which doesn't exist.
+	MissingResourceIdValidationProblemType ValidationProblemType = "MissingResourceId"
+
+	// OrphanedDefinitionValidationProblemType specifies that a Constant or Model isn't referenced (directly or
+	// transitively) by any Operation or Resource ID.
+	OrphanedDefinitionValidationProblemType ValidationProblemType = "OrphanedDefinition"
+
+	// UnknownReferenceValidationProblemType specifies that a Reference can't be found as a Constant or Model.
+	UnknownReferenceValidationProblemType ValidationProblemType = "UnknownReference"
+)
+
+// ValidationProblem describes a single problem found within the API Definitions.
+type ValidationProblem struct {
+	// Type specifies the kind of problem that was found.
+	Type ValidationProblemType `json:"type"`
+
+	// ServiceType specifies the type of Service containing this problem.
+	ServiceType ServiceType `json:"serviceType"`
+
+	// ServiceName specifies the name of the Service containing this problem.
+	ServiceName string `json:"serviceName"`
+
+	// FilePath specifies the path to the file (or directory) containing this problem.
+	FilePath string `json:"filePath"`
+
+	// Message is a human-readable description of this problem.
+	Message string `json:"message"`
+}
+
+// ValidateDefinitions parses the API Definitions for every Service available to this Services Repository and
+// returns all of the problems found within them. Unlike GetByName (which stops at the first problem, since it's
+// loading the Service to serve it) this continues after finding a problem, so that every problem is reported at once.
+//
+// When usesCommonTypes is true, references which aren't found within an API Resource are resolved against the
+// Constants and Models defined across all Services - and orphaned Constants/Models aren't reported, since these
+// can be referenced from other Services.
+//
+// An error is only returned when the API Definitions can't be read, a file which can't be parsed is reported as a problem.
+func (s *ServicesRepositoryImpl) ValidateDefinitions(usesCommonTypes bool) (*[]ValidationProblem, error) {
+	s.Lock()
+	serviceNamesToDirectory := s.serviceNamesToDirectory
+	s.Unlock()
+
+	v := definitionsValidator{
+		fileSystem:  s.fileSystem,
+		problems:    make([]ValidationProblem, 0),
+		serviceType: s.serviceType,
+	}
+	if serviceNamesToDirectory == nil {
+		return &v.problems, nil
+	}
+
+	serviceNames := make([]string, 0)
+	for serviceName := range *serviceNamesToDirectory {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	services := make([]serviceDefinitionFiles, 0)
+	for _, serviceName := range serviceNames {
+		service, err := v.loadService(serviceName, (*serviceNamesToDirectory)[serviceName])
+		if err != nil {
+			return nil, fmt.Errorf("loading the definitions for Service %q: %+v", serviceName, err)
+		}
+		services = append(services, *service)
+	}
+
+	var commonTypeNames map[string]struct{}
+	if usesCommonTypes {
+		// Common Types can be defined within any Service, so when this Services Repository is limited to a subset
+		// of Services the Common Types are determined from every Service (but only the subset are validated)
+		commonTypeServices := services
+		if s.serviceNames != nil {
+			allServices, err := s.loadAllServicesForCommonTypes()
+			if err != nil {
+				return nil, fmt.Errorf("loading the definitions for every Service to determine the Common Types: %+v", err)
+			}
+			commonTypeServices = *allServices
+		}
+
+		commonTypeNames = make(map[string]struct{})
+		for _, service := range commonTypeServices {
+			for _, resources := range service.apiVersions {
+				for _, resource := range resources {
+					for name := range resource.constants {
+						commonTypeNames[name] = struct{}{}
+					}
+					for name := range resource.models {
+						commonTypeNames[name] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	for _, service := range services {
+		for _, resources := range service.apiVersions {
+			for _, resource := range resources {
+				v.validateResource(service.name, resource, commonTypeNames)
+				if !usesCommonTypes {
+					v.validateNoOrphanedDefinitions(service.name, resource)
+				}
+			}
+		}
+		v.validateTerraformResources(service)
+	}
+
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		if v.problems[i].FilePath != v.problems[j].FilePath {
+			return v.problems[i].FilePath < v.problems[j].FilePath
+		}
+		return v.problems[i].Message < v.problems[j].Message
+	})
+	return &v.problems, nil
+}
+
+// loadAllServicesForCommonTypes loads the definitions for every Service of this Service Type (including those which
+// this Services Repository isn't limited to) - any problems found within these aren't reported, since these
+// Services aren't being validated.
+func (s *ServicesRepositoryImpl) loadAllServicesForCommonTypes() (*[]serviceDefinitionFiles, error) {
+	serviceNamesToDirectory, err := s.discoverAllServices()
+	if err != nil {
+		return nil, fmt.Errorf("discovering all services for %q: %+v", string(s.serviceType), err)
+	}
+
+	v := definitionsValidator{
+		fileSystem:  s.fileSystem,
+		problems:    make([]ValidationProblem, 0),
+		serviceType: s.serviceType,
+	}
+	output := make([]serviceDefinitionFiles, 0)
+	for serviceName, directory := range *serviceNamesToDirectory {
+		service, err := v.loadService(serviceName, directory)
+		if err != nil {
+			return nil, fmt.Errorf("loading the definitions for Service %q: %+v", serviceName, err)
+		}
+		output = append(output, *service)
+	}
+	return &output, nil
+}
+
+type definitionsValidator struct {
+	fileSystem  fs.FS
+	problems    []ValidationProblem
+	serviceType ServiceType
+}
+
+func (v *definitionsValidator) addProblem(problemType ValidationProblemType, serviceName, filePath, format string, args ...interface{}) {
+	v.problems = append(v.problems, ValidationProblem{
+		Type:        problemType,
+		ServiceType: v.serviceType,
+		ServiceName: serviceName,
+		FilePath:    filePath,
+		Message:     fmt.Sprintf(format, args...),
+	})
+}
+
+// definitionFile is a definition parsed from disk, along with the path to the file containing it.
+type definitionFile[T any] struct {
+	filePath   string
+	definition T
+}
+
+func parseDefinitionFile[T any](fileSystem fs.FS, filePath string) (*definitionFile[T], error) {
+	contents, err := loadJson(fileSystem, filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition T
+	if err := json.Unmarshal(*contents, &definition); err != nil {
+		return nil, fmt.Errorf("unmarshaling %q: %+v", filePath, err)
+	}
+
+	return &definitionFile[T]{
+		filePath:   filePath,
+		definition: definition,
+	}, nil
+}
+
+type serviceDefinitionFiles struct {
+	name string
+
+	// apiVersions is a map of API Version (key) to a map of API Resource Name (key) to the definitions
+	// within that API Resource (value).
+	apiVersions map[string]map[string]*resourceDefinitionFiles
+
+	// terraformResources is a map of Terraform Resource Name (key) to the definitions for it (value).
+	terraformResources map[string]*terraformResourceDefinitionFiles
+}
+
+type resourceDefinitionFiles struct {
+	directory   string
+	constants   map[string]definitionFile[dataapimodels.Constant]
+	models      map[string]definitionFile[dataapimodels.Model]
+	operations  map[string]definitionFile[dataapimodels.Operation]
+	resourceIds map[string]definitionFile[dataapimodels.ResourceId]
+}
+
+func (v *definitionsValidator) loadService(serviceName, servicePath string) (*serviceDefinitionFiles, error) {
+	output := serviceDefinitionFiles{
+		name:               serviceName,
+		apiVersions:        make(map[string]map[string]*resourceDefinitionFiles),
+		terraformResources: make(map[string]*terraformResourceDefinitionFiles),
+	}
+
+	serviceDefinitionPath := path.Join(servicePath, "ServiceDefinition.json")
+	if _, err := parseDefinitionFile[dataapimodels.ServiceDefinition](v.fileSystem, serviceDefinitionPath); err != nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, serviceDefinitionPath, "parsing Service Definition: %+v", err)
+	}
+
+	versions, err := listSubDirectories(v.fileSystem, servicePath)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving versions: %+v", err)
+	}
+	for _, version := range *versions {
+		if version == "Terraform" {
+			terraformResources, err := v.loadTerraformResources(serviceName, path.Join(servicePath, version))
+			if err != nil {
+				return nil, fmt.Errorf("loading Terraform definitions: %+v", err)
+			}
+			output.terraformResources = terraformResources
+			continue
+		}
+
+		versionPath := path.Join(servicePath, version)
+		apiVersionDefinitionPath := path.Join(versionPath, "ApiVersionDefinition.json")
+		if _, err := parseDefinitionFile[dataapimodels.ApiVersionDefinition](v.fileSystem, apiVersionDefinitionPath); err != nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, apiVersionDefinitionPath, "parsing API Version Definition: %+v", err)
+		}
+
+		resourceNames, err := listSubDirectories(v.fileSystem, versionPath)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving resources for %s: %+v", version, err)
+		}
+		resources := make(map[string]*resourceDefinitionFiles)
+		for _, resourceName := range *resourceNames {
+			resource, err := v.loadResource(serviceName, path.Join(versionPath, resourceName))
+			if err != nil {
+				return nil, fmt.Errorf("loading resource %s in %s: %+v", resourceName, version, err)
+			}
+			resources[resourceName] = resource
+		}
+		output.apiVersions[version] = resources
+	}
+
+	return &output, nil
+}
+
+func (v *definitionsValidator) loadResource(serviceName, resourcePath string) (*resourceDefinitionFiles, error) {
+	output := resourceDefinitionFiles{
+		directory:   resourcePath,
+		constants:   make(map[string]definitionFile[dataapimodels.Constant]),
+		models:      make(map[string]definitionFile[dataapimodels.Model]),
+		operations:  make(map[string]definitionFile[dataapimodels.Operation]),
+		resourceIds: make(map[string]definitionFile[dataapimodels.ResourceId]),
+	}
+
+	files, err := fs.ReadDir(v.fileSystem, resourcePath)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving definitions under %s: %+v", resourcePath, err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		filePath := path.Join(resourcePath, file.Name())
+		if !strings.HasSuffix(file.Name(), ".json") || !strings.Contains(file.Name(), "-") {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "expected the file name to be in the format `{Type}-{Name}.json`")
+			continue
+		}
+		definitionType, definitionName, err := getDefinitionInfo(file.Name())
+		if err != nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%+v", err)
+			continue
+		}
+
+		// we lower case this comparison so that it's compatible with other OS e.g. Windows
+		switch strings.ToLower(definitionType) {
+		case "constant":
+			if constant, err := parseDefinitionFile[dataapimodels.Constant](v.fileSystem, filePath); err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Constant: %+v", err)
+			} else {
+				output.constants[definitionName] = *constant
+			}
+
+		case "model":
+			if model, err := parseDefinitionFile[dataapimodels.Model](v.fileSystem, filePath); err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Model: %+v", err)
+			} else {
+				output.models[definitionName] = *model
+			}
+
+		case "operation":
+			if operation, err := parseDefinitionFile[dataapimodels.Operation](v.fileSystem, filePath); err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Operation: %+v", err)
+			} else {
+				output.operations[definitionName] = *operation
+			}
+
+		case "resourceid":
+			if resourceId, err := parseDefinitionFile[dataapimodels.ResourceId](v.fileSystem, filePath); err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Resource ID: %+v", err)
+			} else {
+				output.resourceIds[definitionName] = *resourceId
+			}
+
+		default:
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "unsupported definition type %q", definitionType)
+		}
+	}
+
+	return &output, nil
+}
+
+// validateResource validates the Constants, Models, Operations and Resource IDs within an API Resource, including
+// that each Reference can be found (either within the API Resource or within commonTypeNames, when specified).
+func (v *definitionsValidator) validateResource(serviceName string, resource *resourceDefinitionFiles, commonTypeNames map[string]struct{}) {
+	referenceExists := func(name string) bool {
+		_, isConstant := resource.constants[name]
+		_, isModel := resource.models[name]
+		_, isCommonType := commonTypeNames[name]
+		return isConstant || isModel || isCommonType
+	}
+
+	for _, modelName := range sortedDefinitionNames(resource.models) {
+		model := resource.models[modelName]
+		for _, field := range model.definition.Fields {
+			location := fmt.Sprintf("Model %q Field %q", modelName, field.Name)
+			v.validateObjectDefinition(serviceName, model.filePath, location, field.ObjectDefinition, referenceExists)
+		}
+	}
+	v.validateDiscriminatedTypes(serviceName, resource, referenceExists)
+
+	for _, operationName := range sortedDefinitionNames(resource.operations) {
+		operation := resource.operations[operationName]
+		if operation.definition.ResourceIdName != nil {
+			if _, ok := resource.resourceIds[*operation.definition.ResourceIdName]; !ok {
+				v.addProblem(MissingResourceIdValidationProblemType, serviceName, operation.filePath, "Operation %q: the Resource ID %q was not found", operationName, *operation.definition.ResourceIdName)
+			}
+		}
+		if operation.definition.RequestObject != nil {
+			location := fmt.Sprintf("Operation %q Request Object", operationName)
+			v.validateObjectDefinition(serviceName, operation.filePath, location, *operation.definition.RequestObject, referenceExists)
+		}
+		if operation.definition.ResponseObject != nil {
+			location := fmt.Sprintf("Operation %q Response Object", operationName)
+			v.validateObjectDefinition(serviceName, operation.filePath, location, *operation.definition.ResponseObject, referenceExists)
+		}
+		if operation.definition.Options != nil {
+			for _, option := range *operation.definition.Options {
+				if option.ObjectDefinition == nil {
+					continue
+				}
+				location := fmt.Sprintf("Operation %q Option %q", operationName, option.Field)
+				v.validateOptionObjectDefinition(serviceName, operation.filePath, location, *option.ObjectDefinition, referenceExists)
+			}
+		}
+	}
+
+	for _, resourceIdName := range sortedDefinitionNames(resource.resourceIds) {
+		resourceId := resource.resourceIds[resourceIdName]
+		for _, segment := range resourceId.definition.Segments {
+			if segment.Type != dataapimodels.ConstantResourceIdSegmentType {
+				continue
+			}
+			if segment.ConstantName == nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, resourceId.filePath, "Resource ID %q Segment %q: a Constant Name must be specified for a Constant segment", resourceIdName, segment.Name)
+				continue
+			}
+			if _, ok := resource.constants[*segment.ConstantName]; !ok {
+				if _, isCommonType := commonTypeNames[*segment.ConstantName]; !isCommonType {
+					v.addProblem(UnknownReferenceValidationProblemType, serviceName, resourceId.filePath, "Resource ID %q Segment %q: the Constant %q was not found", resourceIdName, segment.Name, *segment.ConstantName)
+				}
+			}
+		}
+	}
+}
+
+func (v *definitionsValidator) validateObjectDefinition(serviceName, filePath, location string, input dataapimodels.ObjectDefinition, referenceExists func(string) bool) {
+	requiresNestedItem := input.Type == dataapimodels.CsvObjectDefinitionType ||
+		input.Type == dataapimodels.DictionaryObjectDefinitionType ||
+		input.Type == dataapimodels.ListObjectDefinitionType
+	if requiresNestedItem && input.NestedItem == nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Nested Item must be specified for a %q type", location, string(input.Type))
+	}
+	if !requiresNestedItem && input.NestedItem != nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Nested Item must not be specified for a %q type", location, string(input.Type))
+	}
+	if input.NestedItem != nil {
+		v.validateObjectDefinition(serviceName, filePath, location, *input.NestedItem, referenceExists)
+	}
+
+	if input.Type == dataapimodels.ReferenceObjectDefinitionType {
+		if input.ReferenceName == nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Reference Name must be specified for a Reference type", location)
+		} else if !referenceExists(*input.ReferenceName) {
+			v.addProblem(UnknownReferenceValidationProblemType, serviceName, filePath, "%s: the Reference %q was not found as a Constant or Model", location, *input.ReferenceName)
+		}
+	} else if input.ReferenceName != nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Reference Name must not be specified for a %q type", location, string(input.Type))
+	}
+}
+
+func (v *definitionsValidator) validateOptionObjectDefinition(serviceName, filePath, location string, input dataapimodels.OptionObjectDefinition, referenceExists func(string) bool) {
+	requiresNestedItem := input.Type == dataapimodels.CsvOptionObjectDefinitionType ||
+		input.Type == dataapimodels.ListOptionObjectDefinitionType
+	if requiresNestedItem && input.NestedItem == nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Nested Item must be specified for a %q type", location, string(input.Type))
+	}
+	if !requiresNestedItem && input.NestedItem != nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Nested Item must not be specified for a %q type", location, string(input.Type))
+	}
+	if input.NestedItem != nil {
+		v.validateOptionObjectDefinition(serviceName, filePath, location, *input.NestedItem, referenceExists)
+	}
+
+	if input.Type == dataapimodels.ReferenceOptionObjectDefinitionType {
+		if input.ReferenceName == nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Reference Name must be specified for a Reference type", location)
+		} else if !referenceExists(*input.ReferenceName) {
+			v.addProblem(UnknownReferenceValidationProblemType, serviceName, filePath, "%s: the Reference %q was not found as a Constant or Model", location, *input.ReferenceName)
+		}
+	} else if input.ReferenceName != nil {
+		v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%s: a Reference Name must not be specified for a %q type", location, string(input.Type))
+	}
+}
+
+// validateDiscriminatedTypes validates that each Discriminated Implementation references a Parent Type containing
+// the Discriminated Value, and that each Discriminated Value is only used once per Parent Type.
+func (v *definitionsValidator) validateDiscriminatedTypes(serviceName string, resource *resourceDefinitionFiles, referenceExists func(string) bool) {
+	// implementationsForParentType is a map of Parent Type (key) to a map of Discriminated Value (key) to the
+	// names of the Discriminated Implementations using it (value)
+	implementationsForParentType := make(map[string]map[string][]string)
+
+	for _, modelName := range sortedDefinitionNames(resource.models) {
+		model := resource.models[modelName]
+		discriminatedFields := make([]string, 0)
+		for _, field := range model.definition.Fields {
+			if field.ContainsDiscriminatedTypeValue {
+				discriminatedFields = append(discriminatedFields, field.Name)
+			}
+		}
+		if len(discriminatedFields) > 1 {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, model.filePath, "Model %q: only one Field can contain the Discriminated Value but got %s", modelName, strings.Join(discriminatedFields, ", "))
+		}
+
+		parentTypeName := model.definition.DiscriminatedParentModelName
+		discriminatedValue := model.definition.DiscriminatedTypeValue
+		if parentTypeName == nil && discriminatedValue == nil {
+			continue
+		}
+		if parentTypeName == nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, model.filePath, "Model %q: the model implements a discriminated type but `discriminatedParentModelName` is unset", modelName)
+			continue
+		}
+		if discriminatedValue == nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, model.filePath, "Model %q: the model implements a discriminated type but `discriminatedTypeValue` is unset", modelName)
+			continue
+		}
+		if model.definition.TypeHintIn == nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, model.filePath, "Model %q: the model implements a discriminated type but `typeHintIn` is unset", modelName)
+		}
+
+		if _, ok := resource.models[*parentTypeName]; !ok {
+			if !referenceExists(*parentTypeName) {
+				v.addProblem(UnknownReferenceValidationProblemType, serviceName, model.filePath, "Model %q: the discriminated parent type %q was not found", modelName, *parentTypeName)
+			}
+		} else if model.definition.TypeHintIn != nil && !parentTypeContainsDiscriminatedField(resource, *parentTypeName, *model.definition.TypeHintIn) {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, model.filePath, "Model %q: the discriminated parent type %q doesn't contain the Field %q containing the Discriminated Value", modelName, *parentTypeName, *model.definition.TypeHintIn)
+		}
+
+		if _, ok := implementationsForParentType[*parentTypeName]; !ok {
+			implementationsForParentType[*parentTypeName] = make(map[string][]string)
+		}
+		implementationsForParentType[*parentTypeName][*discriminatedValue] = append(implementationsForParentType[*parentTypeName][*discriminatedValue], modelName)
+	}
+
+	for parentTypeName, implementations := range implementationsForParentType {
+		for discriminatedValue, modelNames := range implementations {
+			for _, modelName := range modelNames[1:] {
+				v.addProblem(DuplicateDiscriminatedValueValidationProblemType, serviceName, resource.models[modelName].filePath, "Model %q: the Discriminated Value %q for the parent type %q is already used by the Model %q", modelName, discriminatedValue, parentTypeName, modelNames[0])
+			}
+		}
+	}
+}
+
+// parentTypeContainsDiscriminatedField returns whether the Parent Type (or one of its Parent Types) contains the
+// Field fieldName, which contains the Discriminated Value.
+func parentTypeContainsDiscriminatedField(resource *resourceDefinitionFiles, parentTypeName, fieldName string) bool {
+	seen := make(map[string]struct{})
+	for modelName := &parentTypeName; modelName != nil; {
+		if _, ok := seen[*modelName]; ok {
+			return false
+		}
+		seen[*modelName] = struct{}{}
+
+		model, ok := resource.models[*modelName]
+		if !ok {
+			return false
+		}
+		for _, field := range model.definition.Fields {
+			if field.Name == fieldName && field.ContainsDiscriminatedTypeValue {
+				return true
+			}
+		}
+		modelName = model.definition.DiscriminatedParentModelName
+	}
+	return false
+}
+
+// validateNoOrphanedDefinitions validates that each Constant and Model within the API Resource is referenced, either
+// directly or transitively, by an Operation, a Resource ID or a Discriminated Type. API Resources containing no Operations
+// are skipped, since these only exist to contain Constants and Models.
+func (v *definitionsValidator) validateNoOrphanedDefinitions(serviceName string, resource *resourceDefinitionFiles) {
+	if len(resource.operations) == 0 {
+		return
+	}
+
+	implementationsForParentType := make(map[string][]string)
+	for modelName, model := range resource.models {
+		if parentTypeName := model.definition.DiscriminatedParentModelName; parentTypeName != nil {
+			implementationsForParentType[*parentTypeName] = append(implementationsForParentType[*parentTypeName], modelName)
+		}
+	}
+
+	referenced := make(map[string]struct{})
+	pending := make([]string, 0)
+	markAsReferenced := func(names ...string) {
+		for _, name := range names {
+			if _, ok := referenced[name]; !ok {
+				referenced[name] = struct{}{}
+				pending = append(pending, name)
+			}
+		}
+	}
+
+	for _, operation := range resource.operations {
+		if operation.definition.RequestObject != nil {
+			markAsReferenced(referencesWithinObjectDefinition(*operation.definition.RequestObject)...)
+		}
+		if operation.definition.ResponseObject != nil {
+			markAsReferenced(referencesWithinObjectDefinition(*operation.definition.ResponseObject)...)
+		}
+		if operation.definition.Options != nil {
+			for _, option := range *operation.definition.Options {
+				if option.ObjectDefinition != nil {
+					markAsReferenced(referencesWithinOptionObjectDefinition(*option.ObjectDefinition)...)
+				}
+			}
+		}
+	}
+	for _, resourceId := range resource.resourceIds {
+		for _, segment := range resourceId.definition.Segments {
+			if segment.ConstantName != nil {
+				markAsReferenced(*segment.ConstantName)
+			}
+		}
+	}
+
+	// the importer retains every Discriminated Type (and its Implementations) even when these aren't referenced by
+	// anything else within the API Resource (see `removeUnusedItems`), so these are treated as referenced
+	for parentTypeName := range implementationsForParentType {
+		markAsReferenced(parentTypeName)
+	}
+
+	for len(pending) > 0 {
+		name := pending[0]
+		pending = pending[1:]
+
+		model, ok := resource.models[name]
+		if !ok {
+			continue
+		}
+		for _, field := range model.definition.Fields {
+			markAsReferenced(referencesWithinObjectDefinition(field.ObjectDefinition)...)
+		}
+		// Discriminated Implementations are used via their Parent Type (and vice versa)
+		if model.definition.DiscriminatedParentModelName != nil {
+			markAsReferenced(*model.definition.DiscriminatedParentModelName)
+		}
+		markAsReferenced(implementationsForParentType[name]...)
+	}
+
+	for _, constantName := range sortedDefinitionNames(resource.constants) {
+		if _, ok := referenced[constantName]; !ok {
+			v.addProblem(OrphanedDefinitionValidationProblemType, serviceName, resource.constants[constantName].filePath, "Constant %q is not referenced by any Operation, Model or Resource ID", constantName)
+		}
+	}
+	for _, modelName := range sortedDefinitionNames(resource.models) {
+		if _, ok := referenced[modelName]; !ok {
+			v.addProblem(OrphanedDefinitionValidationProblemType, serviceName, resource.models[modelName].filePath, "Model %q is not referenced by any Operation or Model", modelName)
+		}
+	}
+}
+
+func referencesWithinObjectDefinition(input dataapimodels.ObjectDefinition) []string {
+	output := make([]string, 0)
+	if input.ReferenceName != nil {
+		output = append(output, *input.ReferenceName)
+	}
+	if input.NestedItem != nil {
+		output = append(output, referencesWithinObjectDefinition(*input.NestedItem)...)
+	}
+	return output
+}
+
+func referencesWithinOptionObjectDefinition(input dataapimodels.OptionObjectDefinition) []string {
+	output := make([]string, 0)
+	if input.ReferenceName != nil {
+		output = append(output, *input.ReferenceName)
+	}
+	if input.NestedItem != nil {
+		output = append(output, referencesWithinOptionObjectDefinition(*input.NestedItem)...)
+	}
+	return output
+}
+
+func sortedDefinitionNames[T any](input map[string]T) []string {
+	output := make([]string, 0, len(input))
+	for name := range input {
+		output = append(output, name)
+	}
+	sort.Strings(output)
+	return output
+}
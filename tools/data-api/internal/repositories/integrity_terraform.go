@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/sdk/dataapimodels"
+)
+
+type terraformResourceDefinitionFiles struct {
+	resource *definitionFile[dataapimodels.TerraformResourceDefinition]
+	mappings *definitionFile[dataapimodels.TerraformMappingDefinition]
+
+	// schemaModels is a map of Schema Model Name (key) to the Schema Model (value).
+	schemaModels map[string]definitionFile[dataapimodels.TerraformSchemaModel]
+}
+
+func (v *definitionsValidator) loadTerraformResources(serviceName, terraformDefinitionsPath string) (map[string]*terraformResourceDefinitionFiles, error) {
+	output := make(map[string]*terraformResourceDefinitionFiles)
+
+	files, err := fs.ReadDir(v.fileSystem, terraformDefinitionsPath)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving definitions under %s: %+v", terraformDefinitionsPath, err)
+	}
+
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+
+		filePath := path.Join(terraformDefinitionsPath, file.Name())
+		if !strings.HasSuffix(file.Name(), ".json") || !strings.Contains(file.Name(), "-") {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "expected the file name to be in the format `{Name}-{Type}.json`")
+			continue
+		}
+		definitionName, definitionType, err := getTerraformDefinitionInfo(file.Name())
+		if err != nil {
+			v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "%+v", err)
+			continue
+		}
+
+		if _, ok := output[definitionName]; !ok {
+			output[definitionName] = &terraformResourceDefinitionFiles{
+				schemaModels: make(map[string]definitionFile[dataapimodels.TerraformSchemaModel]),
+			}
+		}
+		resource := output[definitionName]
+
+		// we lower case these so that it's compatible with other OS e.g. Windows
+		switch strings.ToLower(definitionType) {
+		case "resource":
+			if resource.resource, err = parseDefinitionFile[dataapimodels.TerraformResourceDefinition](v.fileSystem, filePath); err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Terraform Resource Definition: %+v", err)
+			}
+
+		case "resource-mappings":
+			if resource.mappings, err = parseDefinitionFile[dataapimodels.TerraformMappingDefinition](v.fileSystem, filePath); err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Terraform Resource Mappings: %+v", err)
+			}
+
+		case "resource-schema":
+			schemaModel, err := parseDefinitionFile[dataapimodels.TerraformSchemaModel](v.fileSystem, filePath)
+			if err != nil {
+				v.addProblem(InvalidDefinitionValidationProblemType, serviceName, filePath, "parsing Terraform Resource Schema: %+v", err)
+				continue
+			}
+			resource.schemaModels[schemaModel.definition.Name] = *schemaModel
+		}
+	}
+
+	return output, nil
+}
+
+// validateTerraformResources validates that each Terraform Resource (and the Mappings for it) references an
+// API Resource, Resource ID, SDK Models/Fields and Schema Models/Fields which exist.
+func (v *definitionsValidator) validateTerraformResources(service serviceDefinitionFiles) {
+	for _, resourceName := range sortedDefinitionNames(service.terraformResources) {
+		terraformResource := service.terraformResources[resourceName]
+		if terraformResource.resource == nil {
+			if terraformResource.mappings != nil {
+				v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, terraformResource.mappings.filePath, "Terraform Resource %q: the Resource Definition was not found", resourceName)
+			}
+			continue
+		}
+
+		filePath := terraformResource.resource.filePath
+		definition := terraformResource.resource.definition
+		if _, ok := terraformResource.schemaModels[definition.SchemaModelName]; !ok {
+			v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, filePath, "Terraform Resource %q: the Schema Model %q was not found", resourceName, definition.SchemaModelName)
+		}
+
+		var apiResource *resourceDefinitionFiles
+		if resources, ok := service.apiVersions[definition.ApiVersion]; !ok {
+			v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, filePath, "Terraform Resource %q: the API Version %q was not found", resourceName, definition.ApiVersion)
+		} else if apiResource, ok = resources[definition.Resource]; !ok {
+			v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, filePath, "Terraform Resource %q: the API Resource %q was not found in API Version %q", resourceName, definition.Resource, definition.ApiVersion)
+		}
+
+		var resourceId *dataapimodels.ResourceId
+		if apiResource != nil {
+			if id, ok := apiResource.resourceIds[definition.ResourceIdName]; ok {
+				resourceId = &id.definition
+			} else {
+				v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, filePath, "Terraform Resource %q: the Resource ID %q was not found", resourceName, definition.ResourceIdName)
+			}
+			for _, method := range []dataapimodels.TerraformMethodDefinition{definition.CreateMethod, definition.DeleteMethod, definition.ReadMethod} {
+				if _, ok := apiResource.operations[method.Name]; !ok {
+					v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, filePath, "Terraform Resource %q: the Operation %q was not found", resourceName, method.Name)
+				}
+			}
+			if definition.UpdateMethod != nil {
+				if _, ok := apiResource.operations[definition.UpdateMethod.Name]; !ok {
+					v.addProblem(InvalidTerraformMappingValidationProblemType, service.name, filePath, "Terraform Resource %q: the Operation %q was not found", resourceName, definition.UpdateMethod.Name)
+				}
+			}
+		}
+
+		if terraformResource.mappings != nil {
+			v.validateTerraformMappings(service.name, resourceName, *terraformResource, apiResource, resourceId)
+		}
+	}
+}
+
+func (v *definitionsValidator) validateTerraformMappings(serviceName, resourceName string, terraformResource terraformResourceDefinitionFiles, apiResource *resourceDefinitionFiles, resourceId *dataapimodels.ResourceId) {
+	filePath := terraformResource.mappings.filePath
+	mappings := terraformResource.mappings.definition
+
+	validateSchemaField := func(modelName string, fieldName *string) {
+		model, ok := terraformResource.schemaModels[modelName]
+		if !ok {
+			v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: the Schema Model %q was not found", resourceName, modelName)
+			return
+		}
+		if fieldName == nil {
+			return
+		}
+		for _, field := range model.definition.Fields {
+			if field.Name == *fieldName {
+				return
+			}
+		}
+		v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: the Field %q was not found in the Schema Model %q", resourceName, *fieldName, modelName)
+	}
+	validateSdkField := func(modelName string, fieldName *string) {
+		// when the API Resource doesn't exist this has already been reported
+		if apiResource == nil {
+			return
+		}
+		if _, ok := apiResource.models[modelName]; !ok {
+			v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: the SDK Model %q was not found", resourceName, modelName)
+			return
+		}
+		if fieldName != nil && !sdkModelContainsField(apiResource, modelName, *fieldName) {
+			v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: the Field %q was not found in the SDK Model %q", resourceName, *fieldName, modelName)
+		}
+	}
+
+	if mappings.FieldMappings != nil {
+		for _, mapping := range *mappings.FieldMappings {
+			switch mapping.Type {
+			case dataapimodels.DirectAssignmentTerraformFieldMappingDefinitionType:
+				if mapping.DirectAssignment == nil {
+					v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: a %q mapping must contain `directAssignment`", resourceName, string(mapping.Type))
+					continue
+				}
+				validateSchemaField(mapping.DirectAssignment.SchemaModelName, &mapping.DirectAssignment.SchemaFieldPath)
+				validateSdkField(mapping.DirectAssignment.SdkModelName, &mapping.DirectAssignment.SdkFieldPath)
+
+			case dataapimodels.ModelToModelTerraformFieldMappingDefinitionType:
+				if mapping.ModelToModel == nil {
+					v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: a %q mapping must contain `modelToModel`", resourceName, string(mapping.Type))
+					continue
+				}
+				validateSchemaField(mapping.ModelToModel.SchemaModelName, nil)
+				validateSdkField(mapping.ModelToModel.SdkModelName, &mapping.ModelToModel.SdkFieldName)
+			}
+		}
+	}
+
+	if mappings.ModelToModelMappings != nil {
+		for _, mapping := range *mappings.ModelToModelMappings {
+			validateSchemaField(mapping.SchemaModelName, nil)
+			validateSdkField(mapping.SdkModelName, nil)
+		}
+	}
+
+	if mappings.ResourceIdMappings != nil && terraformResource.resource != nil {
+		schemaModelName := terraformResource.resource.definition.SchemaModelName
+		for _, mapping := range *mappings.ResourceIdMappings {
+			validateSchemaField(schemaModelName, &mapping.SchemaFieldName)
+
+			// when the Resource ID doesn't exist this has already been reported
+			if resourceId == nil {
+				continue
+			}
+			found := false
+			for _, segment := range resourceId.Segments {
+				if segment.Name == mapping.SegmentName {
+					found = true
+					break
+				}
+			}
+			if !found {
+				v.addProblem(InvalidTerraformMappingValidationProblemType, serviceName, filePath, "Terraform Resource %q: the Segment %q was not found in the Resource ID %q", resourceName, mapping.SegmentName, terraformResource.resource.definition.ResourceIdName)
+			}
+		}
+	}
+}
+
+// sdkModelContainsField returns whether the SDK Model (or one of its Parent Types) contains the Field fieldName.
+func sdkModelContainsField(resource *resourceDefinitionFiles, modelName, fieldName string) bool {
+	seen := make(map[string]struct{})
+	for name := &modelName; name != nil; {
+		if _, ok := seen[*name]; ok {
+			return false
+		}
+		seen[*name] = struct{}{}
+
+		model, ok := resource.models[*name]
+		if !ok {
+			return false
+		}
+		for _, field := range model.definition.Fields {
+			if field.Name == fieldName {
+				return true
+			}
+		}
+		name = model.definition.DiscriminatedParentModelName
+	}
+	return false
+}
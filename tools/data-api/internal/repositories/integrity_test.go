@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"os"
+	"path"
+	"reflect"
+	"testing"
+)
+
+func TestValidateDefinitions(t *testing.T) {
+	directory := t.TempDir()
+	writeTestFile(t, directory, "resource-manager/metadata.json", `{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/ServiceDefinition.json", `{"name": "Example", "generate": true}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/ApiVersionDefinition.json", `{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Operation-Get.json", `{"name": "Get", "httpMethod": "GET", "resourceIdName": "MissingId", "responseObject": {"type": "Reference", "referenceName": "Animal"}}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Model-Animal.json", `{"name": "Animal", "typeHintIn": "Kind", "fields": [
+		{"name": "Kind", "jsonName": "kind", "containsDiscriminatedTypeValue": true, "objectDefinition": {"type": "String"}},
+		{"name": "Owner", "jsonName": "owner", "objectDefinition": {"type": "Reference", "referenceName": "Person"}}
+	]}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Model-Cat.json", `{"name": "Cat", "discriminatedParentModelName": "Animal", "discriminatedTypeValue": "cat", "typeHintIn": "Kind", "fields": []}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Model-Lion.json", `{"name": "Lion", "discriminatedParentModelName": "Animal", "discriminatedTypeValue": "cat", "typeHintIn": "Kind", "fields": []}`)
+	// Discriminated Types are retained by the importer even when they're not referenced, so aren't orphaned
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Model-Vehicle.json", `{"name": "Vehicle", "typeHintIn": "Kind", "fields": [
+		{"name": "Kind", "jsonName": "kind", "containsDiscriminatedTypeValue": true, "objectDefinition": {"type": "String"}},
+		{"name": "Colour", "jsonName": "colour", "objectDefinition": {"type": "Reference", "referenceName": "Colour"}}
+	]}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Model-Car.json", `{"name": "Car", "discriminatedParentModelName": "Vehicle", "discriminatedTypeValue": "car", "typeHintIn": "Kind", "fields": []}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-Colour.json", `{"name": "Colour", "type": "String", "values": [{"key": "Red", "value": "red"}]}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-Unused.json", `{"name": "Unused", "type": "String", "values": [{"key": "A", "value": "A"}]}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-Broken.json", `{"name": "Broken", `)
+	writeTestFile(t, directory, "resource-manager/Example/Terraform/Thing-Resource.json", `{"apiVersion": "2020-01-01", "resource": "Things", "resourceIdName": "MissingId", "schemaModelName": "ThingResourceSchema", "createMethod": {"name": "Get"}, "readMethod": {"name": "Get"}, "deleteMethod": {"name": "Get"}}`)
+	writeTestFile(t, directory, "resource-manager/Example/Terraform/Thing-Resource-Schema.json", `{"name": "ThingResourceSchema", "fields": [{"name": "Kind", "hclName": "kind"}]}`)
+	writeTestFile(t, directory, "resource-manager/Example/Terraform/Thing-Resource-Mappings.json", `{"fieldMappings": [
+		{"type": "DirectAssignment", "directAssignment": {"schemaModelName": "ThingResourceSchema", "schemaFieldPath": "Kind", "sdkModelName": "Cat", "sdkFieldPath": "Kind"}},
+		{"type": "DirectAssignment", "directAssignment": {"schemaModelName": "ThingResourceSchema", "schemaFieldPath": "Name", "sdkModelName": "Animal", "sdkFieldPath": "Name"}}
+	]}`)
+
+	repo, err := NewServicesRepository(os.DirFS(directory), ResourceManagerServiceType, nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	problems, err := repo.ValidateDefinitions(false)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	thingsPath := "resource-manager/Example/2020-01-01/Things"
+	terraformPath := "resource-manager/Example/Terraform"
+	expected := []ValidationProblem{
+		{Type: InvalidDefinitionValidationProblemType, FilePath: path.Join(thingsPath, "Constant-Broken.json")},
+		{Type: OrphanedDefinitionValidationProblemType, FilePath: path.Join(thingsPath, "Constant-Unused.json")},
+		{Type: UnknownReferenceValidationProblemType, FilePath: path.Join(thingsPath, "Model-Animal.json")},
+		{Type: DuplicateDiscriminatedValueValidationProblemType, FilePath: path.Join(thingsPath, "Model-Lion.json")},
+		{Type: MissingResourceIdValidationProblemType, FilePath: path.Join(thingsPath, "Operation-Get.json")},
+		{Type: InvalidTerraformMappingValidationProblemType, FilePath: path.Join(terraformPath, "Thing-Resource-Mappings.json")},
+		{Type: InvalidTerraformMappingValidationProblemType, FilePath: path.Join(terraformPath, "Thing-Resource-Mappings.json")},
+		{Type: InvalidTerraformMappingValidationProblemType, FilePath: path.Join(terraformPath, "Thing-Resource.json")},
+	}
+
+	actual := make([]ValidationProblem, 0)
+	for _, problem := range *problems {
+		if problem.ServiceName != "Example" || problem.ServiceType != ResourceManagerServiceType || problem.Message == "" {
+			t.Fatalf("expected the problem to contain the Service Type, Service Name and a Message but got %+v", problem)
+		}
+		actual = append(actual, ValidationProblem{
+			Type:     problem.Type,
+			FilePath: problem.FilePath,
+		})
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected the problems:\n%+v\n\nbut got:\n%+v", expected, *problems)
+	}
+}
+
+func TestValidateDefinitions_CommonTypesFromOtherServices(t *testing.T) {
+	directory := t.TempDir()
+	writeTestFile(t, directory, "microsoft-graph/metadata.json", `{"dataSource": "MicrosoftGraph", "sourceInformation": "handwritten"}`)
+	writeTestFile(t, directory, "microsoft-graph/Applications/ServiceDefinition.json", `{"name": "Applications", "generate": true}`)
+	writeTestFile(t, directory, "microsoft-graph/Applications/stable/ApiVersionDefinition.json", `{"apiVersion": "stable", "generate": true, "resources": ["Application"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "microsoft-graph/Applications/stable/Application/Model-Application.json", `{"name": "Application", "fields": [
+		{"name": "Owner", "jsonName": "owner", "objectDefinition": {"type": "Reference", "referenceName": "DirectoryObject"}}
+	]}`)
+	// the Common Type is defined in another Service, which isn't being validated - as such the problems within it aren't reported
+	writeTestFile(t, directory, "microsoft-graph/Common/ServiceDefinition.json", `{"name": "Common", "generate": true}`)
+	writeTestFile(t, directory, "microsoft-graph/Common/stable/ApiVersionDefinition.json", `{"apiVersion": "stable", "generate": true, "resources": ["DirectoryObject"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "microsoft-graph/Common/stable/DirectoryObject/Model-DirectoryObject.json", `{"name": "DirectoryObject", "fields": [
+		{"name": "Owner", "jsonName": "owner", "objectDefinition": {"type": "Reference", "referenceName": "Missing"}}
+	]}`)
+
+	repo, err := NewServicesRepository(os.DirFS(directory), MicrosoftGraphServiceType, &[]string{"Applications"})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	problems, err := repo.ValidateDefinitions(true)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(*problems) != 0 {
+		t.Fatalf("expected no problems but got %+v", *problems)
+	}
+}
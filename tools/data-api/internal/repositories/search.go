@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type SearchResultKind string
+
+const (
+	ApiVersionSearchResultKind SearchResultKind = "ApiVersion"
+	ConstantSearchResultKind   SearchResultKind = "Constant"
+	FieldSearchResultKind      SearchResultKind = "Field"
+	ModelSearchResultKind      SearchResultKind = "Model"
+	OperationSearchResultKind  SearchResultKind = "Operation"
+	ResourceSearchResultKind   SearchResultKind = "Resource"
+	ResourceIdSearchResultKind SearchResultKind = "ResourceId"
+	ServiceSearchResultKind    SearchResultKind = "Service"
+)
+
+type SearchQuery struct {
+	// Query is the (case-insensitive) text which the name of each result must contain.
+	Query string
+
+	// Kinds optionally limits the results to the specified kinds, when empty results of every kind are returned.
+	Kinds []SearchResultKind
+
+	// ServiceName optionally limits the results to those within the specified Service.
+	ServiceName *string
+}
+
+type SearchResult struct {
+	// Kind specifies the kind of item which was matched, e.g. a Model or an Operation.
+	Kind SearchResultKind
+
+	// Name is the name of the item which was matched.
+	Name string
+
+	// Path is the full path to the matched item, e.g. `Compute/2023-03-01/VirtualMachines/Model/VirtualMachine`.
+	Path string
+
+	// ServiceName is the name of the Service containing the matched item.
+	ServiceName string
+
+	// ApiVersion is the API Version containing the matched item, if any.
+	ApiVersion *string
+
+	// ResourceName is the name of the API Resource containing the matched item, if any.
+	ResourceName *string
+
+	// ModelName is the name of the Model containing the matched item, which is only set for Fields.
+	ModelName *string
+}
+
+func (s *ServicesRepositoryImpl) Search(serviceType ServiceType, query SearchQuery) (*[]SearchResult, error) {
+	// Search returns the indexed items whose name contains the query, with exact matches sorted first. Since services
+	// are loaded on demand, this loads every service (which in turn indexes it) before searching
+	if _, err := s.GetAll(serviceType); err != nil {
+		return nil, fmt.Errorf("loading services: %+v", err)
+	}
+
+	kinds := make(map[SearchResultKind]struct{})
+	for _, kind := range query.Kinds {
+		kinds[kind] = struct{}{}
+	}
+	queryText := strings.ToLower(query.Query)
+
+	output := make([]SearchResult, 0)
+	s.Lock()
+	if s.searchIndex != nil {
+		for serviceName, entries := range *s.searchIndex {
+			if query.ServiceName != nil && !strings.EqualFold(*query.ServiceName, serviceName) {
+				continue
+			}
+
+			for _, entry := range entries {
+				if len(kinds) > 0 {
+					if _, ok := kinds[entry.Kind]; !ok {
+						continue
+					}
+				}
+				if !strings.Contains(strings.ToLower(entry.Name), queryText) {
+					continue
+				}
+				output = append(output, entry)
+			}
+		}
+	}
+	s.Unlock()
+
+	sort.Slice(output, func(i, j int) bool {
+		iExact := strings.EqualFold(output[i].Name, query.Query)
+		jExact := strings.EqualFold(output[j].Name, query.Query)
+		if iExact != jExact {
+			return iExact
+		}
+		return output[i].Path < output[j].Path
+	})
+
+	return &output, nil
+}
+
+// buildSearchIndex returns the searchable items contained within the specified Service
+func buildSearchIndex(service ServiceDetails) []SearchResult {
+	output := []SearchResult{
+		{
+			Kind:        ServiceSearchResultKind,
+			Name:        service.Name,
+			Path:        service.Name,
+			ServiceName: service.Name,
+		},
+	}
+
+	for _, apiVersion := range service.ApiVersions {
+		if apiVersion == nil {
+			continue
+		}
+
+		apiVersionName := apiVersion.Name
+		apiVersionPath := fmt.Sprintf("%s/%s", service.Name, apiVersionName)
+		output = append(output, SearchResult{
+			Kind:        ApiVersionSearchResultKind,
+			Name:        apiVersionName,
+			Path:        apiVersionPath,
+			ServiceName: service.Name,
+			ApiVersion:  &apiVersionName,
+		})
+
+		for resourceName, resource := range apiVersion.Resources {
+			if resource == nil {
+				continue
+			}
+
+			resourceName := resourceName
+			resourcePath := fmt.Sprintf("%s/%s", apiVersionPath, resourceName)
+			newResult := func(kind SearchResultKind, name string, path string) SearchResult {
+				return SearchResult{
+					Kind:         kind,
+					Name:         name,
+					Path:         path,
+					ServiceName:  service.Name,
+					ApiVersion:   &apiVersionName,
+					ResourceName: &resourceName,
+				}
+			}
+
+			output = append(output, newResult(ResourceSearchResultKind, resourceName, resourcePath))
+			for operationName := range resource.Operations {
+				output = append(output, newResult(OperationSearchResultKind, operationName, fmt.Sprintf("%s/Operation/%s", resourcePath, operationName)))
+			}
+			for constantName := range resource.Schema.Constants {
+				output = append(output, newResult(ConstantSearchResultKind, constantName, fmt.Sprintf("%s/Constant/%s", resourcePath, constantName)))
+			}
+			for resourceIdName := range resource.Schema.ResourceIds {
+				output = append(output, newResult(ResourceIdSearchResultKind, resourceIdName, fmt.Sprintf("%s/ResourceId/%s", resourcePath, resourceIdName)))
+			}
+			for modelName, model := range resource.Schema.Models {
+				modelName := modelName
+				modelPath := fmt.Sprintf("%s/Model/%s", resourcePath, modelName)
+				output = append(output, newResult(ModelSearchResultKind, modelName, modelPath))
+
+				for fieldName := range model.Fields {
+					field := newResult(FieldSearchResultKind, fieldName, fmt.Sprintf("%s/Field/%s", modelPath, fieldName))
+					field.ModelName = &modelName
+					output = append(output, field)
+				}
+			}
+		}
+	}
+
+	return output
+}
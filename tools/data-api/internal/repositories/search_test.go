@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"os"
+	"testing"
+)
+
+func TestSearch(t *testing.T) {
+	directory := t.TempDir()
+	writeTestFile(t, directory, "resource-manager/metadata.json", `{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/ServiceDefinition.json", `{"name": "Example", "generate": true}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/ApiVersionDefinition.json", `{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-SkuTier.json", `{"name": "SkuTier", "type": "String", "values": [{"key": "Basic", "value": "Basic"}]}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Model-Sku.json", `{"name": "Sku", "fields": [{"name": "Tier", "jsonName": "tier", "objectDefinition": {"type": "Reference", "referenceName": "SkuTier"}, "optional": true}]}`)
+	writeTestFile(t, directory, "resource-manager/Other/ServiceDefinition.json", `{"name": "Other", "generate": true}`)
+	writeTestFile(t, directory, "resource-manager/Other/2021-01-01/ApiVersionDefinition.json", `{"apiVersion": "2021-01-01", "generate": true, "resources": ["Tiers"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Other/2021-01-01/Tiers/Constant-Tier.json", `{"name": "Tier", "type": "String", "values": [{"key": "Free", "value": "Free"}]}`)
+
+	repo, err := NewServicesRepository(os.DirFS(directory), ResourceManagerServiceType, nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	results, err := repo.Search(ResourceManagerServiceType, SearchQuery{Query: "tier"})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	expectedPaths := []string{
+		// exact matches are returned first
+		"Example/2020-01-01/Things/Model/Sku/Field/Tier",
+		"Other/2021-01-01/Tiers/Constant/Tier",
+		"Example/2020-01-01/Things/Constant/SkuTier",
+		"Other/2021-01-01/Tiers",
+	}
+	if len(*results) != len(expectedPaths) {
+		t.Fatalf("expected %d results but got %d: %+v", len(expectedPaths), len(*results), *results)
+	}
+	for i, result := range *results {
+		if result.Path != expectedPaths[i] {
+			t.Fatalf("expected result %d to have the path %q but got %q", i, expectedPaths[i], result.Path)
+		}
+	}
+
+	serviceName := "Example"
+	results, err = repo.Search(ResourceManagerServiceType, SearchQuery{
+		Query:       "TIER",
+		Kinds:       []SearchResultKind{ConstantSearchResultKind},
+		ServiceName: &serviceName,
+	})
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(*results) != 1 || (*results)[0].Name != "SkuTier" || *(*results)[0].ResourceName != "Things" {
+		t.Fatalf("expected only the Constant `SkuTier` but got %+v", *results)
+	}
+}
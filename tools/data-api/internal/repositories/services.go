@@ -5,9 +5,11 @@ package repositories
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
+	"io/fs"
 	"path"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -20,7 +22,10 @@ import (
 type ServicesRepository interface {
 	GetByName(serviceName string, serviceType ServiceType) (*ServiceDetails, error)
 	GetAll(serviceType ServiceType) (*[]ServiceDetails, error)
+	GetServiceNames(serviceType ServiceType) (*[]string, error)
 	ClearCache() error
+	ReloadChangedServices() error
+	Search(serviceType ServiceType, query SearchQuery) (*[]SearchResult, error)
 }
 
 var _ ServicesRepository = &ServicesRepositoryImpl{}
@@ -29,16 +34,34 @@ type ServicesRepositoryImpl struct {
 	// expectedDataSource specifies the Data Source of the API definitions that we should load
 	expectedDataSource dataapimodels.DataSource
 
-	// rootDirectory is the directory containing the API definitions for all service types
-	rootDirectory string
+	// fileSystem contains the API definitions for all service types, such as a directory on disk, an archive or a
+	// directory within a git commit
+	fileSystem fs.FS
 
 	// Service, Version and Resource definitions loaded and unmarshalled from the JSON API definitions
 	services *map[string]ServiceDetails
 
+	// searchIndex is a map of Service Name to the searchable items within that Service, which is updated whenever a
+	// Service is loaded into (or removed from) the cache
+	searchIndex *map[string][]SearchResult
+
 	// serviceNamesToDirectory is a map of all the services belonging to a serviceType mapped to the directory containing its
 	// definitions
 	serviceNamesToDirectory *map[string]string
 
+	// serviceFingerprints is a map of Service Name to a fingerprint of the files within the directory containing its
+	// definitions, this is populated by ReloadChangedServices and used to determine which services have changed on disk
+	serviceFingerprints *map[string]string
+
+	// generation is incremented each time a service starts being loaded, so that loads can be ordered by when they
+	// started
+	generation uint64
+
+	// serviceGenerations is a map of Service Name to the generation of the load which produced the cached version
+	// of that service, which is used to ensure that a load which started earlier (and may have read older files)
+	// never replaces the cached version from a load which started later
+	serviceGenerations map[string]uint64
+
 	// serviceNames is a list containing the names of services which should be loaded
 	// this allows the loading/parsing of a subset of services for faster iterations during development
 	serviceNames *[]string
@@ -61,9 +84,29 @@ type ApiDefinition struct {
 	fileName string
 }
 
-func NewServicesRepository(directory string, serviceType ServiceType, serviceNames *[]string) (*ServicesRepositoryImpl, error) {
+// NewServicesRepositories initialises a Services Repository for each supported Service Type, which loads the
+// API Definitions found within fileSystem
+func NewServicesRepositories(fileSystem fs.FS, serviceNames *[]string) (map[ServiceType]ServicesRepository, error) {
+	serviceTypes := []ServiceType{
+		MicrosoftGraphServiceType,
+		ResourceManagerServiceType,
+	}
+
+	output := make(map[ServiceType]ServicesRepository)
+	for _, serviceType := range serviceTypes {
+		repo, err := NewServicesRepository(fileSystem, serviceType, serviceNames)
+		if err != nil {
+			return nil, fmt.Errorf("initialising Services Repository for %q: %+v", string(serviceType), err)
+		}
+		output[serviceType] = repo
+	}
+
+	return output, nil
+}
+
+func NewServicesRepository(fileSystem fs.FS, serviceType ServiceType, serviceNames *[]string) (*ServicesRepositoryImpl, error) {
 	// NewServicesRepository initialises a service repository for a given service type (e.g. resource-manager/graph etc.)
-	// beginning in the root directory for all api definitions, it auto discovers subdirectories with a metadata.json and collects
+	// beginning in the root directory of the filesystem containing all api definitions, it auto discovers subdirectories with a metadata.json and collects
 	// all service definitions for the specified service type, building a complete list of services as well as their directory paths
 	// to load from
 
@@ -80,29 +123,31 @@ func NewServicesRepository(directory string, serviceType ServiceType, serviceNam
 
 	repo := &ServicesRepositoryImpl{
 		expectedDataSource: dataSource,
-		rootDirectory:      directory,
+		fileSystem:         fileSystem,
 		serviceNames:       serviceNames,
 		serviceType:        serviceType,
 	}
 
-	if repo.serviceNames != nil {
-		if err := repo.discoverSubsetOfServices(); err != nil {
-			return nil, fmt.Errorf("discovering subset of services for %q: %+v", string(repo.serviceType), err)
-		}
-	} else {
-		if err := repo.discoverAllServices(); err != nil {
-			return nil, fmt.Errorf("discovering all services for %q: %+v", string(repo.serviceType), err)
-		}
+	services, err := repo.discoverServices()
+	if err != nil {
+		return nil, err
 	}
+	repo.serviceNamesToDirectory = services
 
 	return repo, nil
 }
 
 func (s *ServicesRepositoryImpl) ClearCache() error {
-	// TODO This gets automatically run if a file has changed and when serve-watch is used
-	// TODO this finds the files needed and loads it
-	// TODO file watching
+	// ClearCache removes all loaded services from the cache, meaning these will be loaded from disk when next requested
+	s.Lock()
 	s.services = nil
+	s.searchIndex = nil
+	s.serviceFingerprints = nil
+	// any loads which are in progress may have read the files before the cache was cleared, so are discarded
+	for serviceName := range s.serviceGenerations {
+		s.discardInProgressLoads(serviceName)
+	}
+	s.Unlock()
 
 	return nil
 }
@@ -110,62 +155,18 @@ func (s *ServicesRepositoryImpl) ClearCache() error {
 func (s *ServicesRepositoryImpl) GetAll(serviceType ServiceType) (*[]ServiceDetails, error) {
 	// GetAll calls GetByName for all the service names passed to the serve command, or for all the services available in the api definitions directory
 
-	if s.serviceNamesToDirectory == nil {
-		return nil, fmt.Errorf("no services to load")
-	}
-	servicesToLoadSorted := make([]string, 0)
-
-	for service := range *s.serviceNamesToDirectory {
-		servicesToLoadSorted = append(servicesToLoadSorted, service)
+	servicesToLoadSorted, err := s.GetServiceNames(serviceType)
+	if err != nil {
+		return nil, err
 	}
 
-	sort.Strings(servicesToLoadSorted)
-
 	serviceDetails := make([]ServiceDetails, 0)
-
-	if s.services != nil {
-		s.Lock()
-		for _, serviceToLoad := range servicesToLoadSorted {
-			serviceInCache, ok := (*s.services)[serviceToLoad]
-			if !ok {
-				logging.Debugf("Loading service %q", serviceToLoad)
-				serviceDetail, err := s.GetByName(serviceToLoad, s.serviceType)
-				if err != nil {
-					return nil, fmt.Errorf("retrieving service details for %s: %+v", serviceToLoad, err)
-				}
-				// add it to the cache
-				(*s.services)[serviceToLoad] = *serviceDetail
-				serviceDetails = append(serviceDetails, *serviceDetail)
-				logging.Debugf("Loaded service %q", serviceToLoad)
-			} else {
-				serviceDetails = append(serviceDetails, serviceInCache)
-			}
-		}
-		s.Unlock()
-		return &serviceDetails, nil
-	}
-
-	if s.services == nil {
-		s.Lock()
-		servicesMap := make(map[string]ServiceDetails)
-		for _, service := range servicesToLoadSorted {
-			logging.Debugf("Loading service %q", service)
-			serviceDetail, err := s.GetByName(service, s.serviceType)
-			if err != nil {
-				return nil, fmt.Errorf("retrieving service details for %s: %+v", service, err)
-			}
-			serviceDetails = append(serviceDetails, *serviceDetail)
-			servicesMap[serviceDetail.Name] = *serviceDetail
-			logging.Debugf("Loaded service %q", service)
+	for _, serviceToLoad := range *servicesToLoadSorted {
+		serviceDetail, err := s.GetByName(serviceToLoad, serviceType)
+		if err != nil {
+			return nil, fmt.Errorf("retrieving service details for %s: %+v", serviceToLoad, err)
 		}
-		s.services = &servicesMap
-		s.Unlock()
-
-		return &serviceDetails, nil
-	}
-
-	for _, details := range *s.services {
-		serviceDetails = append(serviceDetails, details)
+		serviceDetails = append(serviceDetails, *serviceDetail)
 	}
 
 	return &serviceDetails, nil
@@ -175,32 +176,126 @@ func (s *ServicesRepositoryImpl) GetByName(serviceName string, serviceType Servi
 	// GetByName loads the ServiceDetails for a service from cache if available or builds the ServiceDetails for a singular
 	// service by calling processing functions to build the structs for the ServiceApiVersionDetails and ServiceApiVersionResourceDetails
 
+	s.Lock()
 	if s.services != nil {
-		s.Lock()
 		service, ok := (*s.services)[serviceName]
-		s.Unlock()
 		if ok {
+			s.Unlock()
 			return &service, nil
 		}
 	}
+	generation := s.nextGeneration(serviceName)
+	s.Unlock()
 
+	logging.Debugf("Loading service %q", serviceName)
 	serviceDetails, err := s.ProcessServiceDefinitions(serviceName)
 	if err != nil {
 		return nil, fmt.Errorf("processing service definition for %s: %+v", serviceName, err)
 	}
+	logging.Debugf("Loaded service %q", serviceName)
 
-	if s.services != nil {
-		s.Lock()
-		(*s.services)[serviceName] = *serviceDetails
-		s.Unlock()
+	// the service is only added to the cache once it's been completely loaded, so that any concurrent requests
+	// either see the previously cached version or the new version - but never a partially loaded service
+	searchIndex := buildSearchIndex(*serviceDetails)
+	s.Lock()
+	defer s.Unlock()
+	if !s.cacheService(serviceName, generation, *serviceDetails, searchIndex) && s.services != nil {
+		// a load which started later has already been cached, so that (more recent) version is returned
+		if service, ok := (*s.services)[serviceName]; ok {
+			return &service, nil
+		}
 	}
 
 	return serviceDetails, nil
 }
 
+// nextGeneration returns the generation for a load of the specified service which is starting, the lock must be held
+// when calling this
+func (s *ServicesRepositoryImpl) nextGeneration(serviceName string) uint64 {
+	if s.serviceGenerations == nil {
+		s.serviceGenerations = make(map[string]uint64)
+	}
+	// tracking the service from the start of its first load means ClearCache can discard this load if needed
+	if _, ok := s.serviceGenerations[serviceName]; !ok {
+		s.serviceGenerations[serviceName] = 0
+	}
+	s.generation++
+	return s.generation
+}
+
+// discardInProgressLoads ensures that any loads of the specified service which are in progress aren't cached, the lock
+// must be held when calling this
+func (s *ServicesRepositoryImpl) discardInProgressLoads(serviceName string) {
+	if s.serviceGenerations == nil {
+		s.serviceGenerations = make(map[string]uint64)
+	}
+	s.serviceGenerations[serviceName] = s.generation
+}
+
+// cacheService adds the specified service to the cache, providing no load which started after the specified generation
+// has been cached (and that the cache hasn't been cleared since) - returning whether the service was cached. The lock
+// must be held when calling this.
+func (s *ServicesRepositoryImpl) cacheService(serviceName string, generation uint64, serviceDetails ServiceDetails, searchIndex []SearchResult) bool {
+	if s.serviceGenerations == nil {
+		s.serviceGenerations = make(map[string]uint64)
+	}
+	if generation <= s.serviceGenerations[serviceName] {
+		return false
+	}
+	s.serviceGenerations[serviceName] = generation
+
+	if s.services == nil {
+		s.services = &map[string]ServiceDetails{}
+	}
+	(*s.services)[serviceName] = serviceDetails
+	if s.searchIndex == nil {
+		s.searchIndex = &map[string][]SearchResult{}
+	}
+	(*s.searchIndex)[serviceName] = searchIndex
+	return true
+}
+
+func (s *ServicesRepositoryImpl) GetServiceNames(serviceType ServiceType) (*[]string, error) {
+	// GetServiceNames returns the (sorted) names of the services passed to the serve command, or of all the services
+	// available in the api definitions directory - without loading these services
+
+	s.Lock()
+	defer s.Unlock()
+
+	if s.serviceNamesToDirectory == nil {
+		return nil, fmt.Errorf("no services to load")
+	}
+
+	serviceNames := make([]string, 0)
+	for serviceName := range *s.serviceNamesToDirectory {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	return &serviceNames, nil
+}
+
+// directoryForService returns the directory containing the definitions for the specified Service
+func (s *ServicesRepositoryImpl) directoryForService(serviceName string) (string, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.serviceNamesToDirectory != nil {
+		if directory, ok := (*s.serviceNamesToDirectory)[serviceName]; ok {
+			return directory, nil
+		}
+	}
+
+	return "", fmt.Errorf("service %q was not found", serviceName)
+}
+
 func (s *ServicesRepositoryImpl) ProcessServiceDefinitions(serviceName string) (*ServiceDetails, error) {
-	servicePath := (*s.serviceNamesToDirectory)[serviceName]
-	versions, err := listSubDirectories(servicePath)
+	servicePath, err := s.directoryForService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := listSubDirectories(s.fileSystem, servicePath)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving versions: %+v", err)
 	}
@@ -214,7 +309,7 @@ func (s *ServicesRepositoryImpl) ProcessServiceDefinitions(serviceName string) (
 			if version == "Terraform" {
 				continue
 			}
-			resources, err := listSubDirectories(path.Join(servicePath, version))
+			resources, err := listSubDirectories(s.fileSystem, path.Join(servicePath, version))
 			if err != nil {
 				return nil, fmt.Errorf("retrieving resources for %s: %+v", version, err)
 			}
@@ -234,7 +329,7 @@ func (s *ServicesRepositoryImpl) ProcessServiceDefinitions(serviceName string) (
 
 	var serviceDefinition dataapimodels.ServiceDefinition
 
-	contents, err := loadJson(path.Join(servicePath, "ServiceDefinition.json"))
+	contents, err := loadJson(s.fileSystem, path.Join(servicePath, "ServiceDefinition.json"))
 	if err != nil {
 		return nil, fmt.Errorf("processing service definition for %q: %+v", serviceName, err)
 	}
@@ -264,9 +359,14 @@ func (s *ServicesRepositoryImpl) ProcessVersionDefinitions(serviceName string, v
 		Generate: true,
 	}
 
+	servicePath, err := s.directoryForService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
 	var apiVersionDefinition dataapimodels.ApiVersionDefinition
 
-	contents, err := loadJson(path.Join((*s.serviceNamesToDirectory)[serviceName], version, "ApiVersionDefinition.json"))
+	contents, err := loadJson(s.fileSystem, path.Join(servicePath, version, "ApiVersionDefinition.json"))
 	if err != nil {
 		return nil, fmt.Errorf("processing api version definition for %q: %+v", serviceName, err)
 	}
@@ -307,8 +407,13 @@ func (s *ServicesRepositoryImpl) ProcessResourceDefinitions(serviceName string,
 	operations := make(map[string]ResourceOperations)
 	resourceIds := make(map[string]ResourceIdDefinition)
 
-	resourcePath := path.Join((*s.serviceNamesToDirectory)[serviceName], version, resource)
-	files, err := os.ReadDir(resourcePath)
+	servicePath, err := s.directoryForService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	resourcePath := path.Join(servicePath, version, resource)
+	files, err := fs.ReadDir(s.fileSystem, resourcePath)
 	if err != nil {
 		return nil, fmt.Errorf("retrieving definitions under %s: %+v", resourcePath, err)
 	}
@@ -358,28 +463,28 @@ func (s *ServicesRepositoryImpl) ProcessResourceDefinitions(serviceName string,
 		switch strings.ToLower(definition.definitionType) {
 		// Ordering here is important, all Constants need to be processed first, then all Models
 		case "constant":
-			constant, err := parseConstantFromFilePath(definitionPath)
+			constant, err := parseConstantFromFilePath(s.fileSystem, definitionPath)
 			if err != nil {
 				return nil, fmt.Errorf("processing constant %s: %+v", definition.fileName, err)
 			}
 
 			constants[definition.name] = pointer.From(constant)
 		case "model":
-			model, err := parseModelFromFilePath(definitionPath)
+			model, err := parseModelFromFilePath(s.fileSystem, definitionPath)
 			if err != nil {
 				return nil, fmt.Errorf("processing model %s: %+v", definition.fileName, err)
 			}
 
 			apiModels[definition.name] = pointer.From(model)
 		case "resourceid":
-			resourceId, err := parseResourceIdFromFilePath(definitionPath, constants)
+			resourceId, err := parseResourceIdFromFilePath(s.fileSystem, definitionPath, constants)
 			if err != nil {
 				return nil, fmt.Errorf("processing resource id %s: %+v", definition.fileName, err)
 			}
 
 			resourceIds[definition.name] = pointer.From(resourceId)
 		case "operation":
-			operationDetails, err := parseOperationFromFilePath(definitionPath, constants, apiModels, resourceIds)
+			operationDetails, err := parseOperationFromFilePath(s.fileSystem, definitionPath, constants, apiModels, resourceIds)
 			if err != nil {
 				return nil, fmt.Errorf("processing operation %s: %+v", definition.fileName, err)
 			}
@@ -409,10 +514,10 @@ func (s *ServicesRepositoryImpl) ProcessResourceDefinitions(serviceName string,
 	return &resourceDefinition, nil
 }
 
-func parseConstantFromFilePath(filePath string) (*ConstantDetails, error) {
+func parseConstantFromFilePath(fileSystem fs.FS, filePath string) (*ConstantDetails, error) {
 	var constant dataapimodels.Constant
 
-	contents, err := loadJson(fmt.Sprintf(filePath))
+	contents, err := loadJson(fileSystem, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -438,10 +543,10 @@ func parseConstantFromFilePath(filePath string) (*ConstantDetails, error) {
 	}, nil
 }
 
-func parseModelFromFilePath(filePath string) (*ModelDetails, error) {
+func parseModelFromFilePath(fileSystem fs.FS, filePath string) (*ModelDetails, error) {
 	var model dataapimodels.Model
 
-	contents, err := loadJson(filePath)
+	contents, err := loadJson(fileSystem, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -495,10 +600,10 @@ func parseModelFromFilePath(filePath string) (*ModelDetails, error) {
 	}, nil
 }
 
-func parseOperationFromFilePath(filePath string, constants map[string]ConstantDetails, apiModels map[string]ModelDetails, resourceIds map[string]ResourceIdDefinition) (*ResourceOperations, error) {
+func parseOperationFromFilePath(fileSystem fs.FS, filePath string, constants map[string]ConstantDetails, apiModels map[string]ModelDetails, resourceIds map[string]ResourceIdDefinition) (*ResourceOperations, error) {
 	var operation dataapimodels.Operation
 
-	contents, err := loadJson(filePath)
+	contents, err := loadJson(fileSystem, filePath)
 	if err != nil {
 		return nil, err
 	}
@@ -567,10 +672,15 @@ func (s *ServicesRepositoryImpl) ProcessTerraformDefinitions(serviceName string)
 		DataSources: make(map[string]TerraformDataSourceDetails),
 	}
 
-	terraformDefinitionsPath := path.Join((*s.serviceNamesToDirectory)[serviceName], "Terraform")
-	files, err := os.ReadDir(terraformDefinitionsPath)
+	servicePath, err := s.directoryForService(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	terraformDefinitionsPath := path.Join(servicePath, "Terraform")
+	files, err := fs.ReadDir(s.fileSystem, terraformDefinitionsPath)
 	if err != nil {
-		if strings.Contains(err.Error(), "no such file or directory") {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("retrieving definitions under %s: %+v", terraformDefinitionsPath, err)
@@ -597,24 +707,24 @@ func (s *ServicesRepositoryImpl) ProcessTerraformDefinitions(serviceName string)
 		// we lower case these so that it's compatible with other OS e.g. Windows
 		switch strings.ToLower(definitionType) {
 		case "resource":
-			if resource, err = parseTerraformDefinitionResourceFromFilePath(terraformDefinitionsPath, file, resource); err != nil {
+			if resource, err = parseTerraformDefinitionResourceFromFilePath(s.fileSystem, terraformDefinitionsPath, file, resource); err != nil {
 				return nil, err
 			}
 
 		case "resource-mappings":
-			resource.Mappings, err = parseTerraformDefinitionResourceMappingsFromFilePath(terraformDefinitionsPath, file)
+			resource.Mappings, err = parseTerraformDefinitionResourceMappingsFromFilePath(s.fileSystem, terraformDefinitionsPath, file)
 			if err != nil {
 				return nil, err
 			}
 
 		case "resource-schema":
-			resource.SchemaModels, err = parseTerraformDefinitionResourceSchemaFromFilePath(terraformDefinitionsPath, file, resource.SchemaModels)
+			resource.SchemaModels, err = parseTerraformDefinitionResourceSchemaFromFilePath(s.fileSystem, terraformDefinitionsPath, file, resource.SchemaModels)
 			if err != nil {
 				return nil, err
 			}
 
 		case "resource-tests":
-			resource.Tests, err = parseTerraformDefinitionResourceTestsFromFilePath(terraformDefinitionsPath, file)
+			resource.Tests, err = parseTerraformDefinitionResourceTestsFromFilePath(s.fileSystem, terraformDefinitionsPath, file)
 			if err != nil {
 				return nil, err
 			}
@@ -624,14 +734,16 @@ func (s *ServicesRepositoryImpl) ProcessTerraformDefinitions(serviceName string)
 	}
 
 	terraformTestsPath := path.Join(terraformDefinitionsPath, "Tests")
-	testFiles, err := os.ReadDir(terraformTestsPath)
+	testFiles, err := fs.ReadDir(s.fileSystem, terraformTestsPath)
 	if err != nil {
-		if strings.Contains(err.Error(), "no such file or directory") {
+		if errors.Is(err, fs.ErrNotExist) {
 			return nil, nil
 		}
 		return nil, fmt.Errorf("retrieving tests under %s: %+v", terraformTestsPath, err)
 	}
 
+	// otherTestNumbers is a map of `{definitionName}/{testName}` to the (ordered) Test Numbers of the Other Tests
+	otherTestNumbers := make(map[string][]int)
 	for _, file := range testFiles {
 		if file.IsDir() {
 			continue
@@ -653,33 +765,32 @@ func (s *ServicesRepositoryImpl) ProcessTerraformDefinitions(serviceName string)
 		lowerCaseTestType := strings.ToLower(testType)
 		switch {
 		case lowerCaseTestType == "basic-test":
-			basicConfig, err := parseTerraformTestFromFilePath(terraformTestsPath, file)
+			basicConfig, err := parseTerraformTestFromFilePath(s.fileSystem, terraformTestsPath, file)
 			if err != nil {
 				return nil, err
 			}
 			tests.BasicConfiguration = basicConfig
 		case lowerCaseTestType == "complete-test":
-			completeConfig, err := parseTerraformTestFromFilePath(terraformTestsPath, file)
+			completeConfig, err := parseTerraformTestFromFilePath(s.fileSystem, terraformTestsPath, file)
 			if err != nil {
 				return nil, err
 			}
 			tests.CompleteConfiguration = &completeConfig
 		case lowerCaseTestType == "requires-import-test":
-			requiresImportConfig, err := parseTerraformTestFromFilePath(terraformTestsPath, file)
+			requiresImportConfig, err := parseTerraformTestFromFilePath(s.fileSystem, terraformTestsPath, file)
 			if err != nil {
 				return nil, err
 			}
 			tests.RequiresImportConfiguration = requiresImportConfig
 		case lowerCaseTestType == "template-test":
-			templateConfig, err := parseTerraformTestFromFilePath(terraformTestsPath, file)
+			templateConfig, err := parseTerraformTestFromFilePath(s.fileSystem, terraformTestsPath, file)
 			if err != nil {
 				return nil, err
 			}
 			tests.TemplateConfiguration = &templateConfig
 
 		case strings.HasPrefix(lowerCaseTestType, "other"):
-			// todo we're assuming that tests are read in order and we should instead confirm that order
-			testName, _, err := getTerraformOtherTestInfo(testType)
+			testName, testNum, err := getTerraformOtherTestInfo(testType)
 			if err != nil {
 				return nil, err
 			}
@@ -694,12 +805,17 @@ func (s *ServicesRepositoryImpl) ProcessTerraformDefinitions(serviceName string)
 			}
 			otherTest := otherTests[testName]
 
-			otherTestConfig, err := parseTerraformTestFromFilePath(terraformTestsPath, file)
+			otherTestConfig, err := parseTerraformTestFromFilePath(s.fileSystem, terraformTestsPath, file)
 			if err != nil {
 				return nil, err
 			}
 
-			otherTest = append(otherTest, otherTestConfig)
+			// the files are read in lexical order (e.g. `Other-Foo-10` before `Other-Foo-2`), so each step is
+			// inserted based on its Test Number rather than appended
+			otherTestKey := fmt.Sprintf("%s/%s", definitionName, testName)
+			index := sort.SearchInts(otherTestNumbers[otherTestKey], testNum)
+			otherTestNumbers[otherTestKey] = slices.Insert(otherTestNumbers[otherTestKey], index, testNum)
+			otherTest = slices.Insert(otherTest, index, otherTestConfig)
 			otherTests[testName] = otherTest
 			tests.OtherTests = otherTests
 		}
@@ -711,8 +827,8 @@ func (s *ServicesRepositoryImpl) ProcessTerraformDefinitions(serviceName string)
 	return &terraformDetails, nil
 }
 
-func parseTerraformDefinitionResourceFromFilePath(resourcePath string, file os.DirEntry, definition TerraformResourceDetails) (TerraformResourceDetails, error) {
-	contents, err := loadJson(path.Join(resourcePath, file.Name()))
+func parseTerraformDefinitionResourceFromFilePath(fileSystem fs.FS, resourcePath string, file fs.DirEntry, definition TerraformResourceDetails) (TerraformResourceDetails, error) {
+	contents, err := loadJson(fileSystem, path.Join(resourcePath, file.Name()))
 	if err != nil {
 		return definition, err
 	}
@@ -769,9 +885,9 @@ func parseTerraformDefinitionResourceFromFilePath(resourcePath string, file os.D
 	return definition, nil
 }
 
-func parseTerraformDefinitionResourceMappingsFromFilePath(resourcePath string, file os.DirEntry) (MappingDefinition, error) {
+func parseTerraformDefinitionResourceMappingsFromFilePath(fileSystem fs.FS, resourcePath string, file fs.DirEntry) (MappingDefinition, error) {
 	var mappings MappingDefinition
-	contents, err := loadJson(path.Join(resourcePath, file.Name()))
+	contents, err := loadJson(fileSystem, path.Join(resourcePath, file.Name()))
 	if err != nil {
 		return mappings, err
 	}
@@ -844,8 +960,8 @@ func parseTerraformDefinitionResourceMappingsFromFilePath(resourcePath string, f
 	return mappings, nil
 }
 
-func parseTerraformTestFromFilePath(resourcePath string, file os.DirEntry) (string, error) {
-	contents, err := loadHcl(path.Join(resourcePath, file.Name()))
+func parseTerraformTestFromFilePath(fileSystem fs.FS, resourcePath string, file fs.DirEntry) (string, error) {
+	contents, err := loadHcl(fileSystem, path.Join(resourcePath, file.Name()))
 	if err != nil {
 		return contents, err
 	}
@@ -853,12 +969,12 @@ func parseTerraformTestFromFilePath(resourcePath string, file os.DirEntry) (stri
 	return contents, nil
 }
 
-func parseTerraformDefinitionResourceSchemaFromFilePath(resourcePath string, file os.DirEntry, input map[string]TerraformSchemaModelDefinition) (map[string]TerraformSchemaModelDefinition, error) {
+func parseTerraformDefinitionResourceSchemaFromFilePath(fileSystem fs.FS, resourcePath string, file fs.DirEntry, input map[string]TerraformSchemaModelDefinition) (map[string]TerraformSchemaModelDefinition, error) {
 	if input == nil {
 		input = make(map[string]TerraformSchemaModelDefinition)
 	}
 
-	contents, err := loadJson(path.Join(resourcePath, file.Name()))
+	contents, err := loadJson(fileSystem, path.Join(resourcePath, file.Name()))
 	if err != nil {
 		return input, err
 	}
@@ -912,8 +1028,8 @@ func parseTerraformDefinitionResourceSchemaFromFilePath(resourcePath string, fil
 	return input, nil
 }
 
-func parseTerraformDefinitionResourceTestsFromFilePath(resourcePath string, file os.DirEntry) (TerraformResourceTestsDefinition, error) {
-	contents, err := loadJson(path.Join(resourcePath, file.Name()))
+func parseTerraformDefinitionResourceTestsFromFilePath(fileSystem fs.FS, resourcePath string, file fs.DirEntry) (TerraformResourceTestsDefinition, error) {
+	contents, err := loadJson(fileSystem, path.Join(resourcePath, file.Name()))
 	if err != nil {
 		return TerraformResourceTestsDefinition{}, err
 	}
@@ -949,10 +1065,10 @@ func terraformSchemaFieldObjectDefinitionFromField(input dataapimodels.Terraform
 	return objectDefinition
 }
 
-func parseResourceIdFromFilePath(filePath string, constants map[string]ConstantDetails) (*ResourceIdDefinition, error) {
+func parseResourceIdFromFilePath(fileSystem fs.FS, filePath string, constants map[string]ConstantDetails) (*ResourceIdDefinition, error) {
 	var resourceId dataapimodels.ResourceId
 
-	contents, err := loadJson(filePath)
+	contents, err := loadJson(fileSystem, filePath)
 	if err != nil {
 		return nil, err
 	}
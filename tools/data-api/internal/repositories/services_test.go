@@ -4,11 +4,14 @@
 package repositories
 
 import (
+	"os"
+	"reflect"
 	"testing"
+	"testing/fstest"
 )
 
 func TestServices_ResourceManager(t *testing.T) {
-	repo, err := NewServicesRepository("../../../../api-definitions/", ResourceManagerServiceType, nil)
+	repo, err := NewServicesRepository(os.DirFS("../../../../api-definitions/"), ResourceManagerServiceType, nil)
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
@@ -19,7 +22,7 @@ func TestServices_ResourceManager(t *testing.T) {
 }
 
 func TestServices_MicrosoftGraph(t *testing.T) {
-	repo, err := NewServicesRepository("../../../../api-definitions/", MicrosoftGraphServiceType, nil)
+	repo, err := NewServicesRepository(os.DirFS("../../../../api-definitions/"), MicrosoftGraphServiceType, nil)
 	if err != nil {
 		t.Fatalf(err.Error())
 	}
@@ -28,3 +31,40 @@ func TestServices_MicrosoftGraph(t *testing.T) {
 		t.Fatalf(err.Error())
 	}
 }
+
+func TestProcessTerraformDefinitions_OtherTestsAreOrderedByTestNumber(t *testing.T) {
+	// the files are read in lexical order, which means `Other-Update-10` would be read before `Other-Update-2`
+	fileSystem := fstest.MapFS{
+		"resource-manager/metadata.json":                                                   {Data: []byte(`{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)},
+		"resource-manager/Example/ServiceDefinition.json":                                  {Data: []byte(`{"name": "Example", "resourceProvider": "Microsoft.Example", "terraformPackageName": "example", "generate": true}`)},
+		"resource-manager/Example/Terraform/Thing-Resource.json":                           {Data: []byte(`{"apiVersion": "2020-01-01", "generate": true, "label": "thing", "resource": "Things", "resourceIdName": "ThingId", "schemaModelName": "ThingResourceSchema"}`)},
+		"resource-manager/Example/Terraform/Thing-Resource-Mappings.json":                  {Data: []byte(`{}`)},
+		"resource-manager/Example/Terraform/Thing-Resource-Schema.json":                    {Data: []byte(`{"name": "ThingResourceSchema"}`)},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Basic-Test.hcl":           {Data: []byte("basic")},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Other-Update-1-Test.hcl":  {Data: []byte("update-1")},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Other-Update-10-Test.hcl": {Data: []byte("update-10")},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Other-Update-2-Test.hcl":  {Data: []byte("update-2")},
+		"resource-manager/Example/Terraform/Tests/Thing-Resource-Other-Delete-1-Test.hcl":  {Data: []byte("delete-1")},
+	}
+	repo, err := NewServicesRepository(fileSystem, ResourceManagerServiceType, nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	terraformDetails, err := repo.ProcessTerraformDefinitions("Example")
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	resource, ok := terraformDetails.Resources["Thing"]
+	if !ok {
+		t.Fatalf("expected the Terraform Resource `Thing` to be loaded but got %+v", terraformDetails.Resources)
+	}
+	otherTests := resource.Tests.OtherTests
+	expected := map[string][]string{
+		"Delete": {"delete-1"},
+		"Update": {"update-1", "update-2", "update-10"},
+	}
+	if !reflect.DeepEqual(otherTests, expected) {
+		t.Fatalf("expected the Other Tests to be ordered by their Test Number as %+v but got %+v", expected, otherTests)
+	}
+}
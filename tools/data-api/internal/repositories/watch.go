@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api/internal/logging"
+)
+
+func (s *ServicesRepositoryImpl) ReloadChangedServices() error {
+	// ReloadChangedServices re-discovers the services available for this service type and compares the files on disk
+	// for each service against those seen when this was last called. Any cached service whose files have changed is
+	// reloaded in full before replacing the cached version, so that concurrent requests never see a partially loaded
+	// service - and services which no longer exist on disk are removed from the cache.
+	//
+	// The first call records the current state of each service without reloading anything.
+	services, err := s.discoverServices()
+	if err != nil {
+		return fmt.Errorf("discovering services: %+v", err)
+	}
+
+	fingerprints := make(map[string]string)
+	for serviceName, directory := range *services {
+		fingerprint, err := fingerprintDirectory(s.fileSystem, directory)
+		if err != nil {
+			return fmt.Errorf("fingerprinting service %q: %+v", serviceName, err)
+		}
+		fingerprints[serviceName] = fingerprint
+	}
+
+	s.Lock()
+	previousFingerprints := s.serviceFingerprints
+	s.serviceNamesToDirectory = services
+	if s.services != nil {
+		for serviceName := range *s.services {
+			if _, ok := (*services)[serviceName]; !ok {
+				logging.Infof("Service %q was removed - removing from the cache", serviceName)
+				s.discardInProgressLoads(serviceName)
+				delete(*s.services, serviceName)
+				if s.searchIndex != nil {
+					delete(*s.searchIndex, serviceName)
+				}
+			}
+		}
+	}
+	s.Unlock()
+
+	if previousFingerprints == nil {
+		s.Lock()
+		s.serviceFingerprints = &fingerprints
+		s.Unlock()
+		return nil
+	}
+
+	serviceNames := make([]string, 0)
+	for serviceName := range fingerprints {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Strings(serviceNames)
+
+	for _, serviceName := range serviceNames {
+		previous, ok := (*previousFingerprints)[serviceName]
+		if ok && previous == fingerprints[serviceName] {
+			continue
+		}
+		if !ok {
+			logging.Infof("Service %q was added", serviceName)
+		}
+
+		if err := s.reloadService(serviceName); err != nil {
+			// the files may be part-way through being written, so we keep serving the previous version and retry
+			// the next time this is called
+			logging.Errorf("Reloading service %q: %+v", serviceName, err)
+			if ok {
+				fingerprints[serviceName] = previous
+			} else {
+				delete(fingerprints, serviceName)
+			}
+		}
+	}
+
+	s.Lock()
+	s.serviceFingerprints = &fingerprints
+	s.Unlock()
+
+	return nil
+}
+
+// reloadService reloads the specified service from disk, replacing the cached version once it has been loaded
+// successfully. Services which have not yet been loaded into the cache are loaded on demand instead.
+func (s *ServicesRepositoryImpl) reloadService(serviceName string) error {
+	s.Lock()
+	isCached := false
+	if s.services != nil {
+		_, isCached = (*s.services)[serviceName]
+	}
+	generation := s.nextGeneration(serviceName)
+	s.Unlock()
+
+	if !isCached {
+		return nil
+	}
+
+	logging.Infof("Reloading service %q", serviceName)
+	serviceDetails, err := s.ProcessServiceDefinitions(serviceName)
+	if err != nil {
+		return fmt.Errorf("processing service definition for %s: %+v", serviceName, err)
+	}
+
+	// the cache may have been cleared, or a more recent version of this service loaded, whilst this was loading -
+	// in which case this version is discarded
+	searchIndex := buildSearchIndex(*serviceDetails)
+	s.Lock()
+	cached := s.services != nil && s.cacheService(serviceName, generation, *serviceDetails, searchIndex)
+	s.Unlock()
+	if !cached {
+		logging.Infof("Discarding the reloaded version of service %q since the cache was cleared or a more recent version was loaded", serviceName)
+		return nil
+	}
+	logging.Infof("Reloaded service %q", serviceName)
+
+	return nil
+}
+
+// fingerprintDirectory returns a hash of the name, size and modification time of every file within the specified
+// directory, which changes when any file within the directory is added, removed or modified
+func fingerprintDirectory(fileSystem fs.FS, directory string) (string, error) {
+	hash := sha256.New()
+	err := fs.WalkDir(fileSystem, directory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return fmt.Errorf("retrieving file info for %q: %+v", filePath, err)
+		}
+
+		relativePath := strings.TrimPrefix(filePath, directory+"/")
+		fmt.Fprintf(hash, "%s:%d:%d\n", relativePath, info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("walking %q: %+v", directory, err)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package repositories
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReloadChangedServices(t *testing.T) {
+	directory := t.TempDir()
+	writeTestFile(t, directory, "resource-manager/metadata.json", `{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/ServiceDefinition.json", `{"name": "Example", "generate": true}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/ApiVersionDefinition.json", `{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-First.json", `{"name": "First", "type": "String", "values": [{"key": "A", "value": "A"}]}`)
+
+	repo, err := NewServicesRepository(os.DirFS(directory), ResourceManagerServiceType, nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := repo.ReloadChangedServices(); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	before, err := repo.GetByName("Example", ResourceManagerServiceType)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(before.ApiVersions["2020-01-01"].Resources["Things"].Schema.Constants) != 1 {
+		t.Fatalf("expected 1 constant before reloading")
+	}
+
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-Second.json", `{"name": "Second", "type": "String", "values": [{"key": "B", "value": "B"}]}`)
+	if err := repo.ReloadChangedServices(); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	after, err := repo.GetByName("Example", ResourceManagerServiceType)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(after.ApiVersions["2020-01-01"].Resources["Things"].Schema.Constants) != 2 {
+		t.Fatalf("expected 2 constants after reloading")
+	}
+	if len(before.ApiVersions["2020-01-01"].Resources["Things"].Schema.Constants) != 1 {
+		t.Fatalf("expected the previously loaded service to be unchanged")
+	}
+
+	// a file which fails to parse should leave the previous version in place
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-Third.json", `{"name": "Third", `)
+	if err := repo.ReloadChangedServices(); err != nil {
+		t.Fatalf(err.Error())
+	}
+	partial, err := repo.GetByName("Example", ResourceManagerServiceType)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(partial.ApiVersions["2020-01-01"].Resources["Things"].Schema.Constants) != 2 {
+		t.Fatalf("expected the invalid definition to be ignored")
+	}
+
+	if err := os.RemoveAll(filepath.Join(directory, "resource-manager", "Example")); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := repo.ReloadChangedServices(); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if _, err := repo.GetByName("Example", ResourceManagerServiceType); err == nil {
+		t.Fatalf("expected an error retrieving a removed service")
+	}
+}
+
+func TestReloadChangedServices_OlderLoadIsDiscarded(t *testing.T) {
+	directory := t.TempDir()
+	writeTestFile(t, directory, "resource-manager/metadata.json", `{"dataSource": "AzureResourceManager", "sourceInformation": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/ServiceDefinition.json", `{"name": "Example", "generate": true}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/ApiVersionDefinition.json", `{"apiVersion": "2020-01-01", "generate": true, "resources": ["Things"], "source": "handwritten"}`)
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-First.json", `{"name": "First", "type": "String", "values": [{"key": "A", "value": "A"}]}`)
+
+	repo, err := NewServicesRepository(os.DirFS(directory), ResourceManagerServiceType, nil)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := repo.ReloadChangedServices(); err != nil {
+		t.Fatalf(err.Error())
+	}
+	before, err := repo.GetByName("Example", ResourceManagerServiceType)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	// a load which starts before the files change (e.g. a request which missed the cache) but completes after
+	// the service has been reloaded mustn't replace the reloaded version
+	repo.Lock()
+	olderGeneration := repo.nextGeneration("Example")
+	repo.Unlock()
+
+	writeTestFile(t, directory, "resource-manager/Example/2020-01-01/Things/Constant-Second.json", `{"name": "Second", "type": "String", "values": [{"key": "B", "value": "B"}]}`)
+	if err := repo.ReloadChangedServices(); err != nil {
+		t.Fatalf(err.Error())
+	}
+
+	repo.Lock()
+	cached := repo.cacheService("Example", olderGeneration, *before, nil)
+	repo.Unlock()
+	if cached {
+		t.Fatalf("expected the older load not to be cached")
+	}
+
+	after, err := repo.GetByName("Example", ResourceManagerServiceType)
+	if err != nil {
+		t.Fatalf(err.Error())
+	}
+	if len(after.ApiVersions["2020-01-01"].Resources["Things"].Schema.Constants) != 2 {
+		t.Fatalf("expected the reloaded version to remain cached")
+	}
+
+	// likewise a load which was in progress when the cache was cleared mustn't be cached
+	repo.Lock()
+	olderGeneration = repo.nextGeneration("Example")
+	repo.Unlock()
+	if err := repo.ClearCache(); err != nil {
+		t.Fatalf(err.Error())
+	}
+	repo.Lock()
+	cached = repo.cacheService("Example", olderGeneration, *before, nil)
+	repo.Unlock()
+	if cached {
+		t.Fatalf("expected a load which started before the cache was cleared not to be cached")
+	}
+
+	// including the first load of a service, which hasn't been cached previously
+	repo.Lock()
+	olderGeneration = repo.nextGeneration("Other")
+	repo.Unlock()
+	if err := repo.ClearCache(); err != nil {
+		t.Fatalf(err.Error())
+	}
+	repo.Lock()
+	cached = repo.cacheService("Other", olderGeneration, *before, nil)
+	repo.Unlock()
+	if cached {
+		t.Fatalf("expected the first load of a service which started before the cache was cleared not to be cached")
+	}
+}
+
+func writeTestFile(t *testing.T, directory, fileName, contents string) {
+	filePath := filepath.Join(directory, fileName)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		t.Fatalf(err.Error())
+	}
+	if err := os.WriteFile(filePath, []byte(contents), 0644); err != nil {
+		t.Fatalf(err.Error())
+	}
+}
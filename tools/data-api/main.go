@@ -4,7 +4,6 @@
 package main
 
 import (
-	"fmt"
 	"log"
 	"os"
 
@@ -26,11 +25,9 @@ func main() {
 	c := cli.NewCLI("data-api", "1.0.0")
 	c.Args = os.Args[1:]
 	c.Commands = map[string]cli.CommandFactory{
-		"serve": commands.NewServeCommand(),
-		// TODO hook this up
-		"serve-watch": func() (cli.Command, error) {
-			return nil, fmt.Errorf("TODO: implement me")
-		},
+		"serve":       commands.NewServeCommand(),
+		"serve-watch": commands.NewServeWatchCommand(),
+		"validate":    commands.NewValidateCommand(),
 	}
 
 	exitStatus, err := c.Run()
@@ -4,8 +4,10 @@ go 1.21
 
 require (
 	github.com/hashicorp/go-azure-helpers v0.66.2
-	github.com/hashicorp/go-hclog v1.4.0
+	github.com/hashicorp/go-hclog v1.5.0
+	github.com/hashicorp/pandora/tools/data-api v0.0.0-00010101000000-000000000000
 	github.com/hashicorp/pandora/tools/data-api-sdk v0.0.0-00010101000000-000000000000
+	github.com/hashicorp/pandora/tools/sdk v0.0.0-00010101000000-000000000000
 	github.com/mitchellh/cli v1.1.5
 )
 
@@ -13,9 +15,12 @@ require (
 	github.com/Masterminds/goutils v1.1.1 // indirect
 	github.com/Masterminds/semver/v3 v3.1.1 // indirect
 	github.com/Masterminds/sprig/v3 v3.2.1 // indirect
+	github.com/ajg/form v1.5.1 // indirect
 	github.com/armon/go-radix v0.0.0-20180808171621-7fddfc383310 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
 	github.com/fatih/color v1.13.0 // indirect
+	github.com/go-chi/chi/v5 v5.0.8 // indirect
+	github.com/go-chi/render v1.0.2 // indirect
 	github.com/google/uuid v1.1.2 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
@@ -34,4 +39,8 @@ require (
 	golang.org/x/sys v0.15.0 // indirect
 )
 
+replace github.com/hashicorp/pandora/tools/data-api => ../data-api
+
 replace github.com/hashicorp/pandora/tools/data-api-sdk => ../data-api-sdk
+
+replace github.com/hashicorp/pandora/tools/sdk => ../sdk
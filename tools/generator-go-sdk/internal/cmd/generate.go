@@ -11,13 +11,17 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/inprocess"
 	"github.com/hashicorp/pandora/tools/generator-go-sdk/internal/generator"
 	"github.com/hashicorp/pandora/tools/generator-go-sdk/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/generationcheck"
 	"github.com/mitchellh/cli"
 )
 
@@ -29,6 +33,9 @@ type GenerateCommand struct {
 
 type GeneratorInput struct {
 	apiServerEndpoint string
+	check             bool
+	dataDirectory     string
+	force             bool
 	outputDirectory   string
 	services          []string
 	settings          generator.Settings
@@ -77,6 +84,9 @@ func (g GenerateCommand) Run(args []string) int {
 
 	f := flag.NewFlagSet("generator-go-sdk", flag.ExitOnError)
 	f.StringVar(&input.apiServerEndpoint, "data-api", "http://localhost:5000", "-data-api=http://localhost:5000")
+	f.BoolVar(&input.check, "check", false, "-check (generates into a temporary directory and outputs a diff against the output directory, exiting with a non-zero exit code when these differ)")
+	f.StringVar(&input.dataDirectory, "data-directory", "", "-data-directory=../../api-definitions (reads the API Definitions from disk rather than from the Data API)")
+	f.BoolVar(&input.force, "force", false, "-force (generates every Resource, rather than only those which have changed since the last run)")
 	f.StringVar(&input.outputDirectory, "output-dir", "", "-output-dir=../generated-sdk-dev")
 	f.StringVar(&serviceNames, "services", "", "A list of comma separated Service named from the Data API to import")
 	if err := f.Parse(args); err != nil {
@@ -92,7 +102,18 @@ func (g GenerateCommand) Run(args []string) int {
 		input.outputDirectory = filepath.Join(homeDir, "/Desktop/generated-sdk-dev")
 	}
 
-	if err := g.run(ctx, input); err != nil {
+	if input.check {
+		hasChanges, err := g.check(ctx, input)
+		if err != nil {
+			log.Fatalf("checking the generated files: %+v", err)
+		}
+		if *hasChanges {
+			return 1
+		}
+		return 0
+	}
+
+	if _, err := g.run(ctx, input); err != nil {
 		log.Fatalf("running generator: %+v", err)
 	}
 
@@ -103,14 +124,130 @@ func (g GenerateCommand) Synopsis() string {
 	return "Generates a Go SDK based on the API Definitions from the Data API"
 }
 
-func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) error {
+// check generates the Go SDK into a temporary directory and outputs a diff of any files which differ from those
+// within the output directory (without changing the output directory), returning whether any files differ.
+func (g GenerateCommand) check(ctx context.Context, input GeneratorInput) (*bool, error) {
+	existingDirectory := path.Join(input.outputDirectory, string(g.sourceDataType))
+
+	tempDirectory, err := os.MkdirTemp("", "generator-go-sdk-check")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(tempDirectory)
+
+	input.force = true
+	input.outputDirectory = tempDirectory
+	data, err := g.run(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("running generator: %+v", err)
+	}
+	generatedDirectory := path.Join(tempDirectory, string(g.sourceDataType))
+
+	serviceDirectories, err := serviceDirectoriesToCheck(existingDirectory, generatedDirectory, shouldRemoveService(*data, input.services))
+	if err != nil {
+		return nil, fmt.Errorf("determining the Services to check: %+v", err)
+	}
+
+	changes, err := generationcheck.CompareDirectories(existingDirectory, generatedDirectory, generationcheck.Options{
+		Directories: serviceDirectories,
+		IgnoreFile: func(path string) bool {
+			return path == generator.ManifestFileName
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("comparing %q to the generated files: %+v", existingDirectory, err)
+	}
+
+	for _, change := range changes {
+		fmt.Print(change.Diff)
+	}
+	if len(changes) > 0 {
+		log.Printf("%s within %q", generationcheck.Summary(changes), existingDirectory)
+	} else {
+		log.Printf("No changes - %q is up-to-date", existingDirectory)
+	}
+
+	return pointer.To(len(changes) > 0), nil
+}
+
+// serviceDirectoriesToCheck returns the names of the Service directories which should be checked for removed files,
+// which are the Services which have been generated - and any other existing Services which would be removed when
+// regenerating (for example a Service which no longer exists in the API Definitions), as determined by shouldRemove.
+func serviceDirectoriesToCheck(existingDirectory, generatedDirectory string, shouldRemove func(serviceName string) bool) ([]string, error) {
+	serviceDirectories := make([]string, 0)
+	entries, err := os.ReadDir(generatedDirectory)
+	if err != nil {
+		return nil, fmt.Errorf("reading the generated directory %q: %+v", generatedDirectory, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			serviceDirectories = append(serviceDirectories, entry.Name())
+		}
+	}
+
+	existingEntries, err := os.ReadDir(existingDirectory)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading the existing directory %q: %+v", existingDirectory, err)
+	}
+	for _, entry := range existingEntries {
+		if !entry.IsDir() || slices.Contains(serviceDirectories, entry.Name()) {
+			continue
+		}
+		if shouldRemove(entry.Name()) {
+			serviceDirectories = append(serviceDirectories, entry.Name())
+		}
+	}
+
+	return serviceDirectories, nil
+}
+
+// shouldRemoveService returns a func which determines whether the files generated previously for the specified Service
+// (matched case-insensitively, since the Service directories are lower-cased) should be removed when they haven't been
+// generated during this run. Since only a subset of the Services may have been loaded, only Services which have been
+// loaded (or which no longer exist, when every Service is loaded) are removed - and Services which are opted out of
+// generation are left as-is.
+func shouldRemoveService(data v1.LoadAllDataResult, servicesToFilterTo []string) func(serviceName string) bool {
+	return func(serviceName string) bool {
+		for name, service := range data.Services {
+			if strings.EqualFold(name, serviceName) && !service.Generate {
+				// the Service is opted out of generation, so leave whatever's been generated previously
+				return false
+			}
+		}
+		if len(servicesToFilterTo) == 0 {
+			return true
+		}
+		for _, name := range servicesToFilterTo {
+			if strings.EqualFold(name, serviceName) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) (*v1.LoadAllDataResult, error) {
 	// output into a directory named after the source data type (e.g. `{dir}/resource-manager`)
 	input.outputDirectory = path.Join(input.outputDirectory, string(g.sourceDataType))
 
 	client := v1.NewClient(input.apiServerEndpoint, g.sourceDataType)
+	if input.dataDirectory != "" {
+		var err error
+		client, err = inprocess.NewClientForDirectory(input.dataDirectory, g.sourceDataType)
+		if err != nil {
+			return nil, fmt.Errorf("building Data API client for the directory %q: %+v", input.dataDirectory, err)
+		}
+	}
+
 	data, err := client.LoadAllData(ctx, input.services)
 	if err != nil {
-		return fmt.Errorf("retrieving API Definitions: %+v", err)
+		return nil, fmt.Errorf("retrieving API Definitions: %+v", err)
+	}
+
+	// the manifest tracks what's been generated previously, so that only the Resources which have changed are generated
+	manifest, err := generator.LoadManifest(input.outputDirectory, input.force)
+	if err != nil {
+		return nil, fmt.Errorf("loading the manifest: %+v", err)
 	}
 
 	errCh := make(chan error, 1)
@@ -151,7 +288,7 @@ func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) error {
 						Source:          versionDetails.Source,
 					}
 					logging.Debugf("Generating Service %q / Version %q / Resource %q", serviceName, versionNumber, resourceName)
-					if err := generatorService.Generate(generatorData); err != nil {
+					if _, err := generatorService.GenerateIfChanged(generatorData, manifest); err != nil {
 						addErr(fmt.Errorf("generating Service %q / Version %q / Resource %q: %+v", serviceName, versionNumber, resourceName, err))
 						return
 					}
@@ -171,7 +308,7 @@ func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) error {
 					generatorData.UseNewBaseLayer = true
 				}
 				logging.Debugf("Generating Service %q / Version %q", serviceName, versionNumber)
-				if err := generatorService.GenerateForVersion(generatorData); err != nil {
+				if _, err := generatorService.GenerateForVersionIfChanged(generatorData, manifest); err != nil {
 					addErr(fmt.Errorf("generating Service %q / Version %q: %+v", serviceName, versionNumber, err))
 					return
 				}
@@ -189,8 +326,22 @@ func (g GenerateCommand) run(ctx context.Context, input GeneratorInput) error {
 	case <-waitDone:
 		break
 	case err := <-errCh:
-		return err
+		// record what's been generated successfully so far, so that this isn't generated again on the next run
+		if saveErr := manifest.Save(); saveErr != nil {
+			logging.Errorf("saving the manifest: %+v", saveErr)
+		}
+		return nil, err
+	}
+
+	// then remove any Resources which no longer exist
+	if err := manifest.RemoveStale(shouldRemoveService(*data, input.services)); err != nil {
+		return nil, fmt.Errorf("removing stale Resources: %+v", err)
+	}
+	if err := manifest.Save(); err != nil {
+		return nil, fmt.Errorf("saving the manifest: %+v", err)
 	}
 
-	return nil
+	logging.Infof("Summary: %s", manifest.Summary().String())
+
+	return data, nil
 }
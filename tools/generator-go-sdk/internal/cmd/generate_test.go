@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/sdk/generationcheck"
+)
+
+func TestServiceDirectoriesToCheck(t *testing.T) {
+	existingDirectory := t.TempDir()
+	generatedDirectory := t.TempDir()
+	for _, path := range []string{
+		"compute/2023-03-01/virtualmachines/client.go",
+		"frontdoor/2020-01-01/frontdoors/client.go",
+		"network/2023-03-01/virtualnetworks/client.go",
+		"removed/2020-01-01/things/client.go",
+	} {
+		writeTestFile(t, existingDirectory, path)
+	}
+	for _, path := range []string{
+		"compute/2023-03-01/virtualmachines/client.go",
+		"network/2023-03-01/virtualnetworks/client.go",
+	} {
+		writeTestFile(t, generatedDirectory, path)
+	}
+
+	data := v1.LoadAllDataResult{
+		Services: map[string]models.Service{
+			"Compute":   {Generate: true},
+			"FrontDoor": {Generate: false},
+			"Network":   {Generate: true},
+		},
+	}
+
+	testData := []struct {
+		services []string
+		expected []string
+	}{
+		{
+			// when every Service is loaded, a Service which no longer exists should be checked (but not one which is opted out)
+			expected: []string{"compute", "network", "removed"},
+		},
+		{
+			// when a subset of the Services is loaded, only the Services within that subset should be checked
+			services: []string{"Compute"},
+			expected: []string{"compute", "network"},
+		},
+		{
+			services: []string{"Compute", "Removed"},
+			expected: []string{"compute", "network", "removed"},
+		},
+	}
+	for i, v := range testData {
+		t.Logf("[DEBUG] Test %d", i)
+
+		actual, err := serviceDirectoriesToCheck(existingDirectory, generatedDirectory, shouldRemoveService(data, v.services))
+		if err != nil {
+			t.Fatalf("determining the Services to check: %+v", err)
+		}
+		sort.Strings(actual)
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v but got %+v", v.expected, actual)
+		}
+	}
+
+	// a Service which has been removed entirely from the API Definitions should be reported as removed
+	directories, err := serviceDirectoriesToCheck(existingDirectory, generatedDirectory, shouldRemoveService(data, nil))
+	if err != nil {
+		t.Fatalf("determining the Services to check: %+v", err)
+	}
+	changes, err := generationcheck.CompareDirectories(existingDirectory, generatedDirectory, generationcheck.Options{
+		Directories: directories,
+	})
+	if err != nil {
+		t.Fatalf("comparing: %+v", err)
+	}
+	if len(changes) != 1 || changes[0].Path != "removed/2020-01-01/things/client.go" || changes[0].Type != generationcheck.RemovedChangeType {
+		t.Fatalf("expected only the removed Service to be reported but got %+v", changes)
+	}
+}
+
+func writeTestFile(t *testing.T, directory, path string) {
+	fullPath := filepath.Join(directory, filepath.FromSlash(path))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+		t.Fatalf("creating the directory for %q: %+v", path, err)
+	}
+	if err := os.WriteFile(fullPath, []byte("package example\n"), 0644); err != nil {
+		t.Fatalf("writing %q: %+v", path, err)
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/helpers"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+// clientMethod describes a single method which is generated on the Client for an Operation (see methodsPandoraTemplater),
+// for example `Get`, `CreateOrUpdateThenPoll` or `ListCompleteMatchingPredicate`.
+type clientMethod struct {
+	// name is the name of this method e.g. `CreateOrUpdateThenPoll`
+	name string
+
+	// arguments is the list of arguments for this method, excluding the `ctx` argument which is always present
+	arguments []clientMethodArgument
+
+	// returnTypes is the list of types returned from this method e.g. `GetOperationResponse` and `error`
+	returnTypes []string
+}
+
+type clientMethodArgument struct {
+	// name is the name of this argument e.g. `id`
+	name string
+
+	// typeName is the Go type for this argument e.g. `VirtualMachineId`
+	typeName string
+}
+
+// argumentsSignature returns the arguments for this method, including the `ctx` argument.
+func (m clientMethod) argumentsSignature() string {
+	arguments := []string{
+		"ctx context.Context",
+	}
+	for _, argument := range m.arguments {
+		arguments = append(arguments, fmt.Sprintf("%s %s", argument.name, argument.typeName))
+	}
+	return strings.Join(arguments, ", ")
+}
+
+// returnTypesSignature returns the return types for this method, wrapped in parentheses when there's more than one.
+func (m clientMethod) returnTypesSignature() string {
+	if len(m.returnTypes) == 1 {
+		return m.returnTypes[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(m.returnTypes, ", "))
+}
+
+// clientMethodsForResource returns the methods generated on the Client for each of the Operations within this
+// Resource, sorted by name. When packageName is specified the types are qualified using this package name, for
+// use outside of the generated package (e.g. in the `fakes` package).
+func clientMethodsForResource(data ServiceGeneratorData, packageName *string) ([]clientMethod, error) {
+	operationNames := make([]string, 0)
+	for operationName := range data.operations {
+		operationNames = append(operationNames, operationName)
+	}
+	sort.Strings(operationNames)
+
+	output := make([]clientMethod, 0)
+	for _, operationName := range operationNames {
+		methods, err := clientMethodsForOperation(operationName, data.operations[operationName], data.resourceIds, packageName)
+		if err != nil {
+			return nil, fmt.Errorf("determining the methods for Operation %q: %+v", operationName, err)
+		}
+		output = append(output, methods...)
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].name < output[j].name
+	})
+	return output, nil
+}
+
+// clientMethodsForOperation returns the methods generated on the Client for this Operation, which mirrors the
+// methods output by methodsPandoraTemplater.
+func clientMethodsForOperation(operationName string, operation models.SDKOperation, resourceIds map[string]models.ResourceID, packageName *string) ([]clientMethod, error) {
+	qualify := func(typeName string) string {
+		if packageName == nil {
+			return typeName
+		}
+		return fmt.Sprintf("%s.%s", *packageName, typeName)
+	}
+
+	arguments := make([]clientMethodArgument, 0)
+	if operation.ResourceIDName != nil {
+		idName := *operation.ResourceIDName
+		id, ok := resourceIds[idName]
+		if !ok {
+			return nil, fmt.Errorf("internal error: Resource ID %q was not found", idName)
+		}
+		typeName := qualify(idName)
+		if id.CommonIDAlias != nil {
+			typeName = fmt.Sprintf("commonids.%sId", *id.CommonIDAlias)
+		}
+		arguments = append(arguments, clientMethodArgument{
+			name:     "id",
+			typeName: typeName,
+		})
+	}
+	if operation.RequestObject != nil {
+		typeName, err := helpers.GolangTypeForSDKObjectDefinition(*operation.RequestObject, packageName)
+		if err != nil {
+			return nil, fmt.Errorf("determining type name for request object: %+v", err)
+		}
+		arguments = append(arguments, clientMethodArgument{
+			name:     "input",
+			typeName: *typeName,
+		})
+	}
+	if len(operation.Options) > 0 {
+		arguments = append(arguments, clientMethodArgument{
+			name:     "options",
+			typeName: qualify(fmt.Sprintf("%sOperationOptions", operationName)),
+		})
+	}
+
+	output := []clientMethod{
+		{
+			name:        operationName,
+			arguments:   arguments,
+			returnTypes: []string{qualify(fmt.Sprintf("%sOperationResponse", operationName)), "error"},
+		},
+	}
+
+	if operation.LongRunning {
+		output = append(output, clientMethod{
+			name:        fmt.Sprintf("%sThenPoll", operationName),
+			arguments:   arguments,
+			returnTypes: []string{"error"},
+		}, clientMethod{
+			name: fmt.Sprintf("Resume%sPoller", operationName),
+			arguments: []clientMethodArgument{
+				{
+					name:     "token",
+					typeName: "string",
+				},
+			},
+			returnTypes: []string{qualify(fmt.Sprintf("%sOperationResponse", operationName)), "error"},
+		})
+	}
+
+	// Long Running Operations take precedence over pagination, so the `Complete` and `Iter` methods aren't output for these
+	if !operation.LongRunning && operation.FieldContainingPaginationDetails != nil && operation.ResponseObject != nil {
+		completeResult := qualify(fmt.Sprintf("%sCompleteResult", operationName))
+		itemTypeName, err := helpers.GolangTypeForSDKObjectDefinition(*operation.ResponseObject, packageName)
+		if err != nil {
+			return nil, fmt.Errorf("determining qualified golang type name for response object: %+v", err)
+		}
+		iterator := fmt.Sprintf("iter.Seq2[%s, error]", *itemTypeName)
+
+		output = append(output, clientMethod{
+			name:        fmt.Sprintf("%sComplete", operationName),
+			arguments:   arguments,
+			returnTypes: []string{completeResult, "error"},
+		}, clientMethod{
+			name:        fmt.Sprintf("%sIter", operationName),
+			arguments:   arguments,
+			returnTypes: []string{iterator},
+		})
+
+		// predicates are only output for models and not for base types like string, int etc.
+		if operation.ResponseObject.Type == models.ReferenceSDKObjectDefinitionType || operation.ResponseObject.Type == models.ListSDKObjectDefinitionType {
+			typeName, err := helpers.GolangTypeForSDKObjectDefinition(*operation.ResponseObject, nil)
+			if err != nil {
+				return nil, fmt.Errorf("determining golang type name for response object: %+v", err)
+			}
+			predicateArguments := append(append([]clientMethodArgument{}, arguments...), clientMethodArgument{
+				name:     "predicate",
+				typeName: qualify(fmt.Sprintf("%sOperationPredicate", *typeName)),
+			})
+			output = append(output, clientMethod{
+				name:        fmt.Sprintf("%sCompleteMatchingPredicate", operationName),
+				arguments:   predicateArguments,
+				returnTypes: []string{completeResult, "error"},
+			}, clientMethod{
+				name:        fmt.Sprintf("%sIterMatchingPredicate", operationName),
+				arguments:   predicateArguments,
+				returnTypes: []string{iterator},
+			})
+		}
+	}
+
+	return output, nil
+}
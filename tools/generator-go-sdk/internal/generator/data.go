@@ -58,6 +58,9 @@ type ServiceGeneratorData struct {
 	// development feature flag - should this service use the new transport layer from `hashicorp/go-azure-sdk`
 	// rather than the existing Autorest base layer?
 	useNewBaseLayer bool
+
+	// generatedFiles contains the files generated for this Resource, which are written once every stage has completed
+	generatedFiles *generatedFiles
 }
 
 func (i ServiceGeneratorInput) generatorData(settings Settings) ServiceGeneratorData {
@@ -85,5 +88,6 @@ func (i ServiceGeneratorInput) generatorData(settings Settings) ServiceGenerator
 		source:             i.Source,
 		useIdAliases:       false,
 		useNewBaseLayer:    useNewBaseLayer,
+		generatedFiles:     newGeneratedFiles(),
 	}
 }
@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// knownImports is a map of Package Name (key) to Import Path (value) for the packages which can be referenced
+// by the generated code - which allows any imports missing from a template to be added when formatting.
+var knownImports = map[string]string{
+	// Standard Library
+	"context": "context",
+	"errors":  "errors",
+	"fmt":     "fmt",
+	"http":    "net/http",
+	"iter":    "iter",
+	"ioutil":  "io/ioutil",
+	"json":    "encoding/json",
+	"reflect": "reflect",
+	"regexp":  "regexp",
+	"sort":    "sort",
+	"strconv": "strconv",
+	"strings": "strings",
+	"sync":    "sync",
+	"testing": "testing",
+	"time":    "time",
+	"url":     "net/url",
+
+	// `Azure/go-autorest`
+	"autorest": "github.com/Azure/go-autorest/autorest",
+	"azure":    "github.com/Azure/go-autorest/autorest/azure",
+
+	// `hashicorp/go-azure-helpers`
+	"commonids":   "github.com/hashicorp/go-azure-helpers/resourcemanager/commonids",
+	"dates":       "github.com/hashicorp/go-azure-helpers/lang/dates",
+	"edgezones":   "github.com/hashicorp/go-azure-helpers/resourcemanager/edgezones",
+	"identity":    "github.com/hashicorp/go-azure-helpers/resourcemanager/identity",
+	"pointer":     "github.com/hashicorp/go-azure-helpers/lang/pointer",
+	"polling":     "github.com/hashicorp/go-azure-helpers/polling",
+	"recaser":     "github.com/hashicorp/go-azure-helpers/resourcemanager/recaser",
+	"resourceids": "github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids",
+	"response":    "github.com/hashicorp/go-azure-helpers/lang/response",
+	"systemdata":  "github.com/hashicorp/go-azure-helpers/resourcemanager/systemdata",
+	"zones":       "github.com/hashicorp/go-azure-helpers/resourcemanager/zones",
+
+	// `hashicorp/go-azure-sdk`
+	"client":          "github.com/hashicorp/go-azure-sdk/sdk/client",
+	"environments":    "github.com/hashicorp/go-azure-sdk/sdk/environments",
+	"odata":           "github.com/hashicorp/go-azure-sdk/sdk/odata",
+	"pollers":         "github.com/hashicorp/go-azure-sdk/sdk/client/pollers",
+	"resourcemanager": "github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager",
+}
+
+// goFile is a generated Go file which has been parsed (and as such is known to be valid), but not yet formatted.
+type goFile struct {
+	// filePath is the path to this file, which is used to identify the file when the generated code is invalid
+	filePath string
+
+	// input is the generated Go code for this file
+	input string
+
+	fileSet *token.FileSet
+	file    *ast.File
+}
+
+// parseGoCode parses the generated Go code for the file at filePath - an error is returned containing the line and
+// column when the generated code is invalid.
+func parseGoCode(filePath string, input string) (*goFile, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filePath, input, parser.ParseComments)
+	if err != nil {
+		return nil, errorForInvalidGoCode(filePath, input, err)
+	}
+
+	return &goFile{
+		filePath: filePath,
+		input:    input,
+		fileSet:  fileSet,
+		file:     file,
+	}, nil
+}
+
+// formatGoCode formats the generated Go code for the file at filePath, which is the only file within its package.
+func formatGoCode(filePath string, input string) (*string, error) {
+	file, err := parseGoCode(filePath, input)
+	if err != nil {
+		return nil, err
+	}
+
+	formatted, err := formatGoFiles([]goFile{*file})
+	if err != nil {
+		return nil, err
+	}
+	result := formatted[filePath]
+	return &result, nil
+}
+
+// formatGoFiles formats the generated Go code for each of the files (in the same manner as `gofmt`), removing any
+// unused imports and adding any missing imports which are defined in knownImports (in the same manner as
+// `goimports`) - returning a map of File Path (key) to the formatted code (value).
+//
+// Since an identifier declared at the package level can be referenced from any file within the package, the files
+// for a package must be formatted together - otherwise a reference to (for example) a package-level variable named
+// `client` in another file would be considered a reference to the `client` package.
+func formatGoFiles(files []goFile) (map[string]string, error) {
+	packageLevelNames := make(map[string]map[string]struct{})
+	for _, item := range files {
+		key := packageKeyForFile(item)
+		if _, ok := packageLevelNames[key]; !ok {
+			packageLevelNames[key] = make(map[string]struct{})
+		}
+		for _, name := range packageLevelNamesForFile(item.file) {
+			packageLevelNames[key][name] = struct{}{}
+		}
+	}
+
+	output := make(map[string]string)
+	for _, item := range files {
+		formatted, err := formatGoFile(item, packageLevelNames[packageKeyForFile(item)])
+		if err != nil {
+			return nil, err
+		}
+		output[item.filePath] = *formatted
+	}
+	return output, nil
+}
+
+// formatGoFile formats the generated Go code for the file, where packageLevelNames contains the names of the
+// identifiers declared at the package level within every file in this package.
+func formatGoFile(input goFile, packageLevelNames map[string]struct{}) (*string, error) {
+	// rather than manipulating the existing import declarations (which requires fixing up the positions of
+	// any comments) we remove them from the source and output a replacement import block
+	imports := determineImportsForFile(input.file, packageLevelNames)
+	var output strings.Builder
+	lastOffset := 0
+	for _, decl := range input.file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.IMPORT {
+			continue
+		}
+		output.WriteString(input.input[lastOffset:input.fileSet.Position(genDecl.Pos()).Offset])
+		if lastOffset == 0 {
+			output.WriteString(importBlockFor(imports, genDecl.Lparen.IsValid()))
+		}
+		lastOffset = input.fileSet.Position(genDecl.End()).Offset
+	}
+	if lastOffset == 0 {
+		// there's no existing imports, so these go directly after the package declaration
+		lastOffset = input.fileSet.Position(input.file.Name.End()).Offset
+		output.WriteString(input.input[0:lastOffset])
+		output.WriteString("\n\n")
+		output.WriteString(importBlockFor(imports, true))
+	}
+	output.WriteString(input.input[lastOffset:])
+
+	formatted, err := format.Source([]byte(output.String()))
+	if err != nil {
+		return nil, errorForInvalidGoCode(input.filePath, output.String(), err)
+	}
+	result := string(formatted)
+	return &result, nil
+}
+
+// packageKeyForFile returns a key identifying the package containing the file - which is both the directory and
+// the package name, since the tests for a package can be defined in a separate `_test` package.
+func packageKeyForFile(input goFile) string {
+	return fmt.Sprintf("%s:%s", filepath.Dir(input.filePath), input.file.Name.Name)
+}
+
+// packageLevelNamesForFile returns the names of the constants, functions, types and variables declared at the
+// package level within this file.
+func packageLevelNamesForFile(file *ast.File) []string {
+	output := make([]string, 0)
+	for _, decl := range file.Decls {
+		switch v := decl.(type) {
+		case *ast.FuncDecl:
+			// methods are referenced through their receiver, so aren't declared at the package level
+			if v.Recv == nil {
+				output = append(output, v.Name.Name)
+			}
+
+		case *ast.GenDecl:
+			for _, spec := range v.Specs {
+				switch item := spec.(type) {
+				case *ast.TypeSpec:
+					output = append(output, item.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range item.Names {
+						output = append(output, name.Name)
+					}
+				}
+			}
+		}
+	}
+	return output
+}
+
+type goImport struct {
+	// alias is the (optional) alias for this import, e.g. `_` or `autorestAzure`
+	alias string
+
+	// path is the import path for this package, e.g. `net/http`
+	path string
+}
+
+// determineImportsForFile returns the imports which are used within this file, which are the existing imports
+// which are referenced together with any missing imports which are defined in knownImports. packageLevelNames
+// contains the names of the identifiers declared at the package level within every file in this package.
+func determineImportsForFile(file *ast.File, packageLevelNames map[string]struct{}) []goImport {
+	// any reference to a package (e.g. `http.StatusOK`) is an identifier used within a selector which isn't
+	// declared within this file (which the parser resolves) or at the package level within another file
+	usedPackageNames := make(map[string]struct{})
+	ast.Inspect(file, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok && ident.Obj == nil {
+				if _, isPackageLevel := packageLevelNames[ident.Name]; !isPackageLevel {
+					usedPackageNames[ident.Name] = struct{}{}
+				}
+			}
+		}
+		return true
+	})
+
+	output := make([]goImport, 0)
+	existingPackageNames := make(map[string]struct{})
+	for _, item := range file.Imports {
+		importPath := strings.Trim(item.Path.Value, "\"`")
+		alias := ""
+		if item.Name != nil {
+			alias = item.Name.Name
+		}
+
+		packageName := alias
+		if packageName == "" {
+			packageName = packageNameForImportPath(importPath)
+		}
+		existingPackageNames[packageName] = struct{}{}
+
+		// blank and dot imports can't be determined to be unused, so are retained
+		_, isUsed := usedPackageNames[packageName]
+		if !isUsed && alias != "_" && alias != "." {
+			continue
+		}
+		output = append(output, goImport{
+			alias: alias,
+			path:  importPath,
+		})
+	}
+
+	for packageName := range usedPackageNames {
+		if _, ok := existingPackageNames[packageName]; ok {
+			continue
+		}
+		if importPath, ok := knownImports[packageName]; ok {
+			output = append(output, goImport{
+				path: importPath,
+			})
+		}
+	}
+
+	return output
+}
+
+// importBlockFor returns an import block containing the specified imports, grouped into the Standard Library
+// and Third Party imports (in the same manner as `goimports`). A single import is output without parentheses when
+// the existing import declaration doesn't use them.
+func importBlockFor(imports []goImport, parenthesized bool) string {
+	if len(imports) == 0 {
+		return ""
+	}
+
+	standardLibrary := make([]string, 0)
+	thirdParty := make([]string, 0)
+	for _, item := range imports {
+		line := fmt.Sprintf("\t%q", item.path)
+		if item.alias != "" {
+			line = fmt.Sprintf("\t%s %q", item.alias, item.path)
+		}
+
+		// the first path segment for Third Party packages is a domain name, e.g. `github.com`
+		if strings.Contains(strings.Split(item.path, "/")[0], ".") {
+			thirdParty = append(thirdParty, line)
+			continue
+		}
+		standardLibrary = append(standardLibrary, line)
+	}
+	if len(imports) == 1 && !parenthesized {
+		return fmt.Sprintf("import %s", strings.TrimPrefix(append(standardLibrary, thirdParty...)[0], "\t"))
+	}
+
+	sort.Strings(standardLibrary)
+	sort.Strings(thirdParty)
+
+	groups := make([]string, 0)
+	if len(standardLibrary) > 0 {
+		groups = append(groups, strings.Join(standardLibrary, "\n"))
+	}
+	if len(thirdParty) > 0 {
+		groups = append(groups, strings.Join(thirdParty, "\n"))
+	}
+	return fmt.Sprintf("import (\n%s\n)", strings.Join(groups, "\n\n"))
+}
+
+func packageNameForImportPath(importPath string) string {
+	for packageName, knownImportPath := range knownImports {
+		if knownImportPath == importPath {
+			return packageName
+		}
+	}
+
+	return path.Base(importPath)
+}
+
+// errorForInvalidGoCode returns an error describing the first problem within the generated Go code, including
+// the line containing the problem to make it easier to find the issue in the template.
+func errorForInvalidGoCode(filePath, input string, err error) error {
+	var errorList scanner.ErrorList
+	if !errors.As(err, &errorList) || len(errorList) == 0 {
+		return fmt.Errorf("the generated code for %q is invalid: %+v", filePath, err)
+	}
+
+	first := errorList[0]
+	line := ""
+	lines := strings.Split(input, "\n")
+	if first.Pos.Line > 0 && first.Pos.Line <= len(lines) {
+		line = strings.TrimSpace(lines[first.Pos.Line-1])
+	}
+	return fmt.Errorf("the generated code for %q is invalid at line %d (column %d): %s\n\nLine %d: %s", filePath, first.Pos.Line, first.Pos.Column, first.Msg, first.Pos.Line, line)
+}
@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormatGoCode_RemovesUnusedImports(t *testing.T) {
+	input := `package example
+
+import (
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"fmt"
+	"net/http"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	_ "embed"
+)
+
+// licence placeholder
+
+func example(resp *client.Response) string {
+	if resp.StatusCode == http.StatusOK {
+	return "ok"
+	}
+	return ""
+}
+`
+	actual, err := formatGoCode("example.go", input)
+	if err != nil {
+		t.Fatalf("formatting: %+v", err)
+	}
+	expected := `package example
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+// licence placeholder
+
+func example(resp *client.Response) string {
+	if resp.StatusCode == http.StatusOK {
+		return "ok"
+	}
+	return ""
+}
+`
+	if *actual != expected {
+		t.Fatalf("expected:\n%s\n\nbut got:\n%s", expected, *actual)
+	}
+}
+
+func TestFormatGoCode_AddsMissingKnownImports(t *testing.T) {
+	input := `package example
+
+import "fmt"
+
+func example(id commonids.SubscriptionId) *string {
+	return pointer.To(fmt.Sprintf("%s", id.ID()))
+}
+`
+	actual, err := formatGoCode("example.go", input)
+	if err != nil {
+		t.Fatalf("formatting: %+v", err)
+	}
+	expected := `package example
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+func example(id commonids.SubscriptionId) *string {
+	return pointer.To(fmt.Sprintf("%s", id.ID()))
+}
+`
+	if *actual != expected {
+		t.Fatalf("expected:\n%s\n\nbut got:\n%s", expected, *actual)
+	}
+}
+
+func TestFormatGoCode_LocalVariablesAreNotImports(t *testing.T) {
+	input := `package example
+
+import "strings"
+
+func example(client string) int {
+	return len(client)
+}
+
+func other(time Thing) string {
+	return time.Value
+}
+`
+	actual, err := formatGoCode("example.go", input)
+	if err != nil {
+		t.Fatalf("formatting: %+v", err)
+	}
+	expected := `package example
+
+func example(client string) int {
+	return len(client)
+}
+
+func other(time Thing) string {
+	return time.Value
+}
+`
+	if *actual != expected {
+		t.Fatalf("expected:\n%s\n\nbut got:\n%s", expected, *actual)
+	}
+}
+
+func TestFormatGoFiles_PackageLevelIdentifiersInOtherFilesAreNotImports(t *testing.T) {
+	declaration, err := parseGoCode("/some/path/client.go", `package example
+
+var client = Thing{}
+`)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	usage, err := parseGoCode("/some/path/method_example.go", `package example
+
+func example() string {
+	return client.Value + pointer.From(client.Other)
+}
+`)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	// a file in another package (e.g. the fakes) which references the `client` package
+	otherPackage, err := parseGoCode("/some/path/fakes/client.go", `package fakes
+
+func example(resp *client.Response) int {
+	return resp.StatusCode
+}
+`)
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	actual, err := formatGoFiles([]goFile{*declaration, *usage, *otherPackage})
+	if err != nil {
+		t.Fatalf("formatting: %+v", err)
+	}
+	expected := map[string]string{
+		"/some/path/client.go": `package example
+
+var client = Thing{}
+`,
+		"/some/path/method_example.go": `package example
+
+import (
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+func example() string {
+	return client.Value + pointer.From(client.Other)
+}
+`,
+		"/some/path/fakes/client.go": `package fakes
+
+import (
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+)
+
+func example(resp *client.Response) int {
+	return resp.StatusCode
+}
+`,
+	}
+	for filePath, expectedContents := range expected {
+		if actual[filePath] != expectedContents {
+			t.Fatalf("expected %q to be:\n%s\n\nbut got:\n%s", filePath, expectedContents, actual[filePath])
+		}
+	}
+}
+
+func TestFormatGoCode_InvalidCode(t *testing.T) {
+	input := `package example
+
+func example() string {
+	return "hello" +
+}
+`
+	_, err := formatGoCode("/some/path/method_example.go", input)
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	for _, expected := range []string{`"/some/path/method_example.go"`, "line 5", `Line 5: }`} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected the error to contain %q but got: %+v", expected, err)
+		}
+	}
+}
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/generator-go-sdk/internal/logging"
+)
+
+// ManifestFileName is the name of the file within the output directory used to track the hash of the
+// inputs used to generate each Resource (and API Version), allowing unchanged Resources to be skipped.
+const ManifestFileName = ".generator-manifest.json"
+
+// Manifest tracks the hash of the inputs used to generate each Resource (and API Version) within the
+// output directory, so that subsequent runs can skip any Resources whose inputs are unchanged and
+// remove any Resources which no longer exist in the API Definitions.
+type Manifest struct {
+	// force specifies that every Resource should be generated, regardless of whether it's changed
+	force bool
+
+	lock            sync.Mutex
+	outputDirectory string
+	previous        manifestFile
+	current         manifestFile
+	summary         GenerationSummary
+}
+
+type manifestFile struct {
+	// GeneratorVersion is the version of the generator used to output these files, for informational purposes.
+	GeneratorVersion string `json:"generatorVersion"`
+
+	// Resources is a map of the path to the Resource, relative to the output directory (e.g. `compute/2022-01-01/disks`)
+	// to the details for the generated Resource.
+	Resources map[string]manifestEntry `json:"resources"`
+
+	// Versions is a map of the path to the API Version, relative to the output directory (e.g. `compute/2022-01-01`)
+	// to the details for the generated API Version.
+	Versions map[string]manifestEntry `json:"versions"`
+}
+
+type manifestEntry struct {
+	// Hash is the hash of the inputs used to generate this Resource/API Version.
+	Hash string `json:"hash"`
+
+	// ServiceName is the name of the Service which this Resource/API Version belongs to.
+	ServiceName string `json:"serviceName"`
+}
+
+// GenerationSummary describes the number of Resources which were generated, skipped and removed.
+type GenerationSummary struct {
+	Generated int
+	Skipped   int
+	Removed   int
+}
+
+func (s GenerationSummary) String() string {
+	return fmt.Sprintf("%d Resources generated, %d skipped (unchanged) and %d removed", s.Generated, s.Skipped, s.Removed)
+}
+
+// LoadManifest loads the Manifest from within the output directory, if it exists. When force is specified
+// each Resource will be generated, regardless of whether it has changed.
+func LoadManifest(outputDirectory string, force bool) (*Manifest, error) {
+	manifest := Manifest{
+		force:           force,
+		outputDirectory: outputDirectory,
+		previous:        newManifestFile(),
+		current:         newManifestFile(),
+	}
+
+	path := filepath.Join(outputDirectory, ManifestFileName)
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &manifest, nil
+		}
+		return nil, fmt.Errorf("reading %q: %+v", path, err)
+	}
+	if err := json.Unmarshal(contents, &manifest.previous); err != nil {
+		// an invalid manifest means we can't determine what's changed, so everything is regenerated
+		logging.Warnf("unable to parse the manifest at %q, all Resources will be generated: %+v", path, err)
+		manifest.previous = newManifestFile()
+	}
+	if manifest.previous.Resources == nil {
+		manifest.previous.Resources = map[string]manifestEntry{}
+	}
+	if manifest.previous.Versions == nil {
+		manifest.previous.Versions = map[string]manifestEntry{}
+	}
+
+	return &manifest, nil
+}
+
+func newManifestFile() manifestFile {
+	return manifestFile{
+		GeneratorVersion: generatorVersion(),
+		Resources:        map[string]manifestEntry{},
+		Versions:         map[string]manifestEntry{},
+	}
+}
+
+// RemoveStale removes any Resources (and API Versions) which were present in the previous Manifest but haven't
+// been generated during this run. Since only a subset of Services may have been generated, only the Services
+// for which shouldRemove returns true are removed.
+func (m *Manifest) RemoveStale(shouldRemove func(serviceName string) bool) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	removeStale := func(previous, current map[string]manifestEntry, isResource bool) error {
+		for _, path := range sortedManifestPaths(previous) {
+			entry := previous[path]
+			if _, ok := current[path]; ok {
+				continue
+			}
+			if !shouldRemove(entry.ServiceName) {
+				// retain this so that it's removed when this Service is next in scope
+				current[path] = entry
+				continue
+			}
+
+			fullPath := filepath.Join(m.outputDirectory, filepath.FromSlash(path))
+			logging.Debugf("Removing %q since it no longer exists in the API Definitions", fullPath)
+			if err := os.RemoveAll(fullPath); err != nil {
+				return fmt.Errorf("removing %q: %+v", fullPath, err)
+			}
+			if err := removeEmptyParentDirectories(m.outputDirectory, fullPath); err != nil {
+				return err
+			}
+			if isResource {
+				m.summary.Removed++
+			}
+		}
+		return nil
+	}
+
+	if err := removeStale(m.previous.Resources, m.current.Resources, true); err != nil {
+		return fmt.Errorf("removing stale Resources: %+v", err)
+	}
+	if err := removeStale(m.previous.Versions, m.current.Versions, false); err != nil {
+		return fmt.Errorf("removing stale API Versions: %+v", err)
+	}
+
+	return nil
+}
+
+// Save writes the Manifest into the output directory.
+func (m *Manifest) Save() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	contents, err := json.MarshalIndent(m.current, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling the manifest: %+v", err)
+	}
+	if err := ensureWorkingDirectoryExists(m.outputDirectory); err != nil {
+		return fmt.Errorf("ensuring the output directory %q exists: %+v", m.outputDirectory, err)
+	}
+	path := filepath.Join(m.outputDirectory, ManifestFileName)
+	if err := os.WriteFile(path, contents, 0644); err != nil {
+		return fmt.Errorf("writing %q: %+v", path, err)
+	}
+	return nil
+}
+
+// Summary returns the number of Resources which have been generated, skipped and removed.
+func (m *Manifest) Summary() GenerationSummary {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.summary
+}
+
+// shouldGenerateResource returns whether the Resource at the specified path (relative to the output directory)
+// needs to be generated, which is the case when the hash differs from the previous run, or the Resource
+// has been removed from the output directory.
+func (m *Manifest) shouldGenerateResource(path, hash string) bool {
+	return m.shouldGenerate(m.previous.Resources, path, hash)
+}
+
+// shouldGenerateVersion returns whether the API Version at the specified path (relative to the output directory)
+// needs to be generated.
+func (m *Manifest) shouldGenerateVersion(path, hash string) bool {
+	return m.shouldGenerate(m.previous.Versions, path, hash)
+}
+
+func (m *Manifest) shouldGenerate(previous map[string]manifestEntry, path, hash string) bool {
+	if m.force {
+		return true
+	}
+
+	m.lock.Lock()
+	existing, ok := previous[path]
+	m.lock.Unlock()
+	if !ok || existing.Hash != hash {
+		return true
+	}
+
+	if _, err := os.Stat(filepath.Join(m.outputDirectory, filepath.FromSlash(path))); err != nil {
+		return true
+	}
+
+	return false
+}
+
+// recordResource records that the Resource at the specified path has been generated (or skipped).
+func (m *Manifest) recordResource(path string, entry manifestEntry, generated bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.current.Resources[path] = entry
+	if generated {
+		m.summary.Generated++
+	} else {
+		m.summary.Skipped++
+	}
+}
+
+// recordVersion records that the API Version at the specified path has been generated (or skipped).
+func (m *Manifest) recordVersion(path string, entry manifestEntry) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.current.Versions[path] = entry
+}
+
+// hashForResource returns a stable hash of the inputs used to generate this Resource, which changes when either
+// the API Definitions for this Resource, the Settings or the generator itself change.
+func hashForResource(input ServiceGeneratorInput, settings Settings) (*string, error) {
+	// NOTE: only the fields used to generate the Resource are included, since including the Service/API Version
+	// details would mean that a change to one Resource caused every Resource within that Service to be generated.
+	return hashForValue(struct {
+		GeneratorVersion string
+		ServiceName      string
+		VersionName      string
+		ResourceName     string
+		ResourceDetails  models.APIResource
+		Source           models.SourceDataOrigin
+		UseNewBaseLayer  bool
+	}{
+		GeneratorVersion: generatorVersion(),
+		ServiceName:      input.ServiceName,
+		VersionName:      input.VersionName,
+		ResourceName:     input.ResourceName,
+		ResourceDetails:  input.ResourceDetails,
+		Source:           input.Source,
+		UseNewBaseLayer:  settings.ShouldUseNewBaseLayer(input.ServiceName, input.VersionName),
+	})
+}
+
+// hashForVersion returns a stable hash of the inputs used to generate this API Version.
+func hashForVersion(input VersionInput) (*string, error) {
+	// the Meta Client only uses the names of each Resource
+	resourceNames := make([]string, 0)
+	for resourceName := range input.Resources {
+		resourceNames = append(resourceNames, resourceName)
+	}
+	sort.Strings(resourceNames)
+
+	return hashForValue(struct {
+		GeneratorVersion string
+		ServiceName      string
+		VersionName      string
+		ResourceNames    []string
+		Source           models.SourceDataOrigin
+		UseNewBaseLayer  bool
+	}{
+		GeneratorVersion: generatorVersion(),
+		ServiceName:      input.ServiceName,
+		VersionName:      input.VersionName,
+		ResourceNames:    resourceNames,
+		Source:           input.Source,
+		UseNewBaseLayer:  input.UseNewBaseLayer,
+	})
+}
+
+func hashForValue(input interface{}) (*string, error) {
+	// NOTE: `encoding/json` outputs map keys in sorted order, so this is stable
+	contents, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling: %+v", err)
+	}
+	sum := sha256.Sum256(contents)
+	hash := hex.EncodeToString(sum[:])
+	return &hash, nil
+}
+
+var (
+	generatorVersionOnce  sync.Once
+	generatorVersionValue string
+)
+
+// generatorVersion returns a value identifying this build of the generator, which is the hash of the
+// running executable - meaning that any change to the generator causes all Resources to be generated.
+func generatorVersion() string {
+	generatorVersionOnce.Do(func() {
+		generatorVersionValue = "unknown"
+
+		path, err := os.Executable()
+		if err != nil {
+			logging.Warnf("unable to determine the path to the generator, changes to the generator won't be detected: %+v", err)
+			return
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			logging.Warnf("unable to open %q, changes to the generator won't be detected: %+v", path, err)
+			return
+		}
+		defer file.Close()
+
+		hash := sha256.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			logging.Warnf("unable to hash %q, changes to the generator won't be detected: %+v", path, err)
+			return
+		}
+		generatorVersionValue = hex.EncodeToString(hash.Sum(nil))
+	})
+	return generatorVersionValue
+}
+
+// removeEmptyParentDirectories removes any empty directories between the specified path and the root directory
+// (for example a Service directory once the last API Version has been removed).
+func removeEmptyParentDirectories(rootDirectory, path string) error {
+	rootDirectory = filepath.Clean(rootDirectory)
+	for directory := filepath.Dir(path); directory != rootDirectory && strings.HasPrefix(directory, rootDirectory); directory = filepath.Dir(directory) {
+		entries, err := os.ReadDir(directory)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("reading directory %q: %+v", directory, err)
+		}
+		if len(entries) > 0 {
+			return nil
+		}
+		if err := os.Remove(directory); err != nil {
+			return fmt.Errorf("removing empty directory %q: %+v", directory, err)
+		}
+	}
+	return nil
+}
+
+func manifestPath(segments ...string) string {
+	for i, segment := range segments {
+		segments[i] = strings.ToLower(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func sortedManifestPaths(input map[string]manifestEntry) []string {
+	output := make([]string, 0)
+	for path := range input {
+		output = append(output, path)
+	}
+	sort.Strings(output)
+	return output
+}
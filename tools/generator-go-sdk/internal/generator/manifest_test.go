@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestHashForResource_IsStable(t *testing.T) {
+	input := manifestTestInput("Disks")
+	first, err := hashForResource(input, Settings{})
+	if err != nil {
+		t.Fatalf("hashing: %+v", err)
+	}
+	for i := 0; i < 10; i++ {
+		second, err := hashForResource(manifestTestInput("Disks"), Settings{})
+		if err != nil {
+			t.Fatalf("hashing: %+v", err)
+		}
+		if *first != *second {
+			t.Fatalf("expected the hash to be stable but got %q and %q", *first, *second)
+		}
+	}
+
+	// changes to the Resource should change the hash
+	input.ResourceDetails.Models["Disk"].Fields["Name"] = models.SDKField{
+		JsonName: "name",
+		ObjectDefinition: models.SDKObjectDefinition{
+			Type: models.IntegerSDKObjectDefinitionType,
+		},
+	}
+	changed, err := hashForResource(input, Settings{})
+	if err != nil {
+		t.Fatalf("hashing: %+v", err)
+	}
+	if *first == *changed {
+		t.Fatalf("expected the hash to change when the Resource changes")
+	}
+
+	// as should the Settings
+	settings := Settings{}
+	settings.UseOldBaseLayerFor("Compute")
+	withSettings, err := hashForResource(manifestTestInput("Disks"), settings)
+	if err != nil {
+		t.Fatalf("hashing: %+v", err)
+	}
+	if *first == *withSettings {
+		t.Fatalf("expected the hash to change when the base layer changes")
+	}
+}
+
+func TestManifest_SkipsUnchangedResources(t *testing.T) {
+	outputDirectory := t.TempDir()
+	hash := "abc123"
+	path := manifestPath("Compute", "2022-01-01", "Disks")
+
+	manifest, err := LoadManifest(outputDirectory, false)
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	if !manifest.shouldGenerateResource(path, hash) {
+		t.Fatalf("expected the Resource to be generated when there's no manifest")
+	}
+	manifest.recordResource(path, manifestEntry{Hash: hash, ServiceName: "Compute"}, true)
+	if err := manifest.Save(); err != nil {
+		t.Fatalf("saving: %+v", err)
+	}
+
+	manifest, err = LoadManifest(outputDirectory, false)
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	if !manifest.shouldGenerateResource(path, hash) {
+		t.Fatalf("expected the Resource to be generated when it doesn't exist in the output directory")
+	}
+	if err := os.MkdirAll(filepath.Join(outputDirectory, "compute", "2022-01-01", "disks"), 0777); err != nil {
+		t.Fatalf("creating directory: %+v", err)
+	}
+	if manifest.shouldGenerateResource(path, hash) {
+		t.Fatalf("expected the Resource to be skipped when it's unchanged")
+	}
+	if !manifest.shouldGenerateResource(path, "def456") {
+		t.Fatalf("expected the Resource to be generated when the hash has changed")
+	}
+
+	forced, err := LoadManifest(outputDirectory, true)
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	if !forced.shouldGenerateResource(path, hash) {
+		t.Fatalf("expected the Resource to be generated when forced")
+	}
+}
+
+func TestManifest_RemoveStale(t *testing.T) {
+	outputDirectory := t.TempDir()
+	paths := []string{
+		manifestPath("Compute", "2022-01-01", "Disks"),
+		manifestPath("Compute", "2022-01-01", "VirtualMachines"),
+		manifestPath("Network", "2022-01-01", "VirtualNetworks"),
+	}
+
+	manifest, err := LoadManifest(outputDirectory, false)
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	for _, path := range paths {
+		if err := os.MkdirAll(filepath.Join(outputDirectory, filepath.FromSlash(path)), 0777); err != nil {
+			t.Fatalf("creating directory: %+v", err)
+		}
+		serviceName := "Compute"
+		if path == paths[2] {
+			serviceName = "Network"
+		}
+		manifest.recordResource(path, manifestEntry{Hash: "abc123", ServiceName: serviceName}, true)
+	}
+	if err := manifest.Save(); err != nil {
+		t.Fatalf("saving: %+v", err)
+	}
+
+	// only `Disks` is generated in this run, and `Network` isn't in scope
+	manifest, err = LoadManifest(outputDirectory, false)
+	if err != nil {
+		t.Fatalf("loading: %+v", err)
+	}
+	manifest.recordResource(paths[0], manifestEntry{Hash: "abc123", ServiceName: "Compute"}, false)
+	if err := manifest.RemoveStale(func(serviceName string) bool {
+		return serviceName == "Compute"
+	}); err != nil {
+		t.Fatalf("removing stale Resources: %+v", err)
+	}
+
+	expected := GenerationSummary{
+		Skipped: 1,
+		Removed: 1,
+	}
+	if actual := manifest.Summary(); actual != expected {
+		t.Fatalf("expected the summary to be %+v but got %+v", expected, actual)
+	}
+	exists := map[string]bool{
+		paths[0]: true,
+		paths[1]: false,
+		paths[2]: true,
+	}
+	for path, shouldExist := range exists {
+		_, err := os.Stat(filepath.Join(outputDirectory, filepath.FromSlash(path)))
+		if shouldExist && err != nil {
+			t.Fatalf("expected %q to exist but got: %+v", path, err)
+		}
+		if !shouldExist && err == nil {
+			t.Fatalf("expected %q to have been removed", path)
+		}
+	}
+
+	// the Resource for the Service that wasn't in scope should be retained in the manifest
+	if _, ok := manifest.current.Resources[paths[2]]; !ok {
+		t.Fatalf("expected %q to be retained in the manifest", paths[2])
+	}
+	if _, ok := manifest.current.Resources[paths[1]]; ok {
+		t.Fatalf("expected %q to be removed from the manifest", paths[1])
+	}
+}
+
+func manifestTestInput(resourceName string) ServiceGeneratorInput {
+	return ServiceGeneratorInput{
+		ServiceName:  "Compute",
+		VersionName:  "2022-01-01",
+		ResourceName: resourceName,
+		ResourceDetails: models.APIResource{
+			Constants: map[string]models.SDKConstant{
+				"Size": {
+					Type: models.StringSDKConstantType,
+					Values: map[string]string{
+						"Large": "large",
+						"Small": "small",
+					},
+				},
+			},
+			Models: map[string]models.SDKModel{
+				"Disk": {
+					Fields: map[string]models.SDKField{
+						"Name": {
+							JsonName: "name",
+							ObjectDefinition: models.SDKObjectDefinition{
+								Type: models.StringSDKObjectDefinitionType,
+							},
+						},
+						"Size": {
+							JsonName: "size",
+							ObjectDefinition: models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: pointer.To("Size"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Source: models.AzureRestAPISpecsSourceDataOrigin,
+	}
+}
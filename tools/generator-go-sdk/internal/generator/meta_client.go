@@ -7,7 +7,7 @@ import (
 	"fmt"
 )
 
-func (s *ServiceGenerator) metaClient(data VersionInput, versionDirectory string) error {
+func (s *ServiceGenerator) metaClient(data VersionInput, versionDirectory string, files *generatedFiles) error {
 	if len(data.Resources) == 0 {
 		return nil
 	}
@@ -29,7 +29,7 @@ func (s *ServiceGenerator) metaClient(data VersionInput, versionDirectory string
 		}
 	}
 
-	if err := s.writeToPathForVersion(versionDirectory, "client.go", templater); err != nil {
+	if err := s.writeToPathForVersion(files, versionDirectory, "client.go", templater); err != nil {
 		return fmt.Errorf("templating meta client for API Version %q / Service %q: %+v", data.VersionName, data.ServiceName, err)
 	}
 
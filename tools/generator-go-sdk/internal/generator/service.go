@@ -6,7 +6,6 @@ package generator
 import (
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
@@ -48,14 +47,17 @@ func (s *ServiceGenerator) Generate(input ServiceGeneratorInput) error {
 	}
 
 	stages := map[string]func(data ServiceGeneratorData) error{
-		"clients":    s.clients,
-		"constants":  s.constants,
-		"ids":        s.ids,
-		"methods":    s.methods,
-		"models":     s.models,
-		"readmeFile": s.readmeFile,
-		"predicates": s.predicates,
-		"version":    s.version,
+		"clients":          s.clients,
+		"clientInterfaces": s.clientInterfaces,
+		"constants":        s.constants,
+		"ids":              s.ids,
+		"methods":          s.methods,
+		"models":           s.models,
+		"pollers":          s.pollers,
+		"readmeFile":       s.readmeFile,
+		"predicates":       s.predicates,
+		"validation":       s.validation,
+		"version":          s.version,
 	}
 	for name, stage := range stages {
 		logging.Debugf("Running Stage %q..", name)
@@ -64,12 +66,39 @@ func (s *ServiceGenerator) Generate(input ServiceGeneratorInput) error {
 		}
 	}
 
-	runGoFmt(data.resourceOutputPath)
-	runGoImports(data.resourceOutputPath)
+	if err := data.generatedFiles.write(); err != nil {
+		return fmt.Errorf("writing the generated files: %+v", err)
+	}
 
 	return nil
 }
 
+// GenerateIfChanged generates the Resource when the inputs for it have changed since the last run (as recorded in
+// the Manifest), returning whether the Resource was generated.
+func (s *ServiceGenerator) GenerateIfChanged(input ServiceGeneratorInput, manifest *Manifest) (bool, error) {
+	hash, err := hashForResource(input, s.settings)
+	if err != nil {
+		return false, fmt.Errorf("hashing the inputs: %+v", err)
+	}
+	path := manifestPath(input.ServiceName, input.VersionName, input.ResourceName)
+	entry := manifestEntry{
+		Hash:        *hash,
+		ServiceName: input.ServiceName,
+	}
+
+	if !manifest.shouldGenerateResource(path, *hash) {
+		logging.Debugf("Skipping %q since it's unchanged", path)
+		manifest.recordResource(path, entry, false)
+		return false, nil
+	}
+
+	if err := s.Generate(input); err != nil {
+		return false, err
+	}
+	manifest.recordResource(path, entry, true)
+	return true, nil
+}
+
 type VersionInput struct {
 	OutputDirectory string
 	Resources       map[string]models.APIResource
@@ -84,31 +113,48 @@ func (s *ServiceGenerator) GenerateForVersion(input VersionInput) error {
 	input.VersionName = strings.ToLower(input.VersionName)
 	versionDirectory := filepath.Join(input.OutputDirectory, input.ServiceName, input.VersionName)
 
-	stages := map[string]func(data VersionInput, versionDirectory string) error{
+	files := newGeneratedFiles()
+	stages := map[string]func(data VersionInput, versionDirectory string, files *generatedFiles) error{
 		"metaClient": s.metaClient,
 	}
 	for name, stage := range stages {
 		logging.Debugf("Running Stage %q..", name)
-		if err := stage(input, versionDirectory); err != nil {
+		if err := stage(input, versionDirectory, files); err != nil {
 			return fmt.Errorf("generating %s: %+v", name, err)
 		}
 	}
 
-	runGoFmt(versionDirectory)
-	runGoImports(versionDirectory)
+	if err := files.write(); err != nil {
+		return fmt.Errorf("writing the generated files: %+v", err)
+	}
+
 	return nil
 }
 
-func runGoFmt(path string) {
-	cmd := exec.Command("gofmt", "-w", path)
-	_ = cmd.Start()
-	_ = cmd.Wait()
-}
+// GenerateForVersionIfChanged generates the API Version when the inputs for it have changed since the last run (as
+// recorded in the Manifest), returning whether the API Version was generated.
+func (s *ServiceGenerator) GenerateForVersionIfChanged(input VersionInput, manifest *Manifest) (bool, error) {
+	hash, err := hashForVersion(input)
+	if err != nil {
+		return false, fmt.Errorf("hashing the inputs: %+v", err)
+	}
+	path := manifestPath(input.ServiceName, input.VersionName)
+	entry := manifestEntry{
+		Hash:        *hash,
+		ServiceName: input.ServiceName,
+	}
+
+	if !manifest.shouldGenerateVersion(path, *hash) {
+		logging.Debugf("Skipping %q since it's unchanged", path)
+		manifest.recordVersion(path, entry)
+		return false, nil
+	}
 
-func runGoImports(path string) {
-	cmd := exec.Command("goimports", "-w", path)
-	_ = cmd.Start()
-	_ = cmd.Wait()
+	if err := s.GenerateForVersion(input); err != nil {
+		return false, err
+	}
+	manifest.recordVersion(path, entry)
+	return true, nil
 }
 
 func cleanAndRecreateWorkingDirectory(path string) error {
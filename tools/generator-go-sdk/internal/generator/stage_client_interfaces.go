@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+func (s *ServiceGenerator) clientInterfaces(data ServiceGeneratorData) error {
+	// the methods available on the AutoRest clients differ, so these are only output for `hashicorp/go-azure-sdk`
+	if !data.useNewBaseLayer {
+		return nil
+	}
+
+	if err := s.writeToPathForResource(data.resourceOutputPath, "interface.go", clientInterfaceTemplater{}, data); err != nil {
+		return fmt.Errorf("templating client interface: %+v", err)
+	}
+
+	fakesOutputPath := filepath.Join(data.resourceOutputPath, "fakes")
+	if err := ensureWorkingDirectoryExists(fakesOutputPath); err != nil {
+		return fmt.Errorf("ensuring the fakes working directory %q exists: %+v", fakesOutputPath, err)
+	}
+	if err := s.writeToPathForResource(fakesOutputPath, "client.go", clientFakesTemplater{}, data); err != nil {
+		return fmt.Errorf("templating client fakes: %+v", err)
+	}
+
+	return nil
+}
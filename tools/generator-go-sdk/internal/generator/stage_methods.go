@@ -13,6 +13,11 @@ func (s *ServiceGenerator) methods(data ServiceGeneratorData) error {
 
 		if data.useNewBaseLayer {
 			fileName := fmt.Sprintf("method_%s.go", strings.ToLower(operationName))
+			if strings.HasSuffix(fileName, "_test.go") {
+				// files ending in `_test.go` are only compiled by `go test`, which would mean the methods for an
+				// Operation named `Test` (which are referenced by the Client Interface) are otherwise unavailable
+				fileName = fmt.Sprintf("method_%s_operation.go", strings.ToLower(operationName))
+			}
 			gen := methodsPandoraTemplater{
 				operationName: operationName,
 				operation:     operation,
@@ -0,0 +1,30 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import "fmt"
+
+func (s *ServiceGenerator) pollers(data ServiceGeneratorData) error {
+	// resuming a Poller relies on the Pollers within the `hashicorp/go-azure-sdk` base layer
+	if !data.useNewBaseLayer {
+		return nil
+	}
+
+	hasLongRunningOperations := false
+	for _, operation := range data.operations {
+		if operation.LongRunning {
+			hasLongRunningOperations = true
+			break
+		}
+	}
+	if !hasLongRunningOperations {
+		return nil
+	}
+
+	if err := s.writeToPathForResource(data.resourceOutputPath, "pollers.go", pollerResumeTokenTemplater{}, data); err != nil {
+		return fmt.Errorf("templating poller resume tokens: %+v", err)
+	}
+
+	return nil
+}
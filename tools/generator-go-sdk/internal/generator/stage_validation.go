@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import "fmt"
+
+func (s *ServiceGenerator) validation(data ServiceGeneratorData) error {
+	if len(data.models) == 0 {
+		return nil
+	}
+
+	if err := s.writeToPathForResource(data.resourceOutputPath, "validation.go", validationTemplater{}, data); err != nil {
+		return fmt.Errorf("templating validation functions: %+v", err)
+	}
+
+	return nil
+}
@@ -25,38 +25,76 @@ func (s *ServiceGenerator) writeToPathForResource(directory, filePath string, te
 		return fmt.Errorf("templating: %+v", err)
 	}
 
-	fullFilePath := filepath.Join(directory, filePath)
+	return data.generatedFiles.add(filepath.Join(directory, filePath), *fileContents)
+}
 
-	// remove any existing file if it exists
-	_ = os.Remove(fullFilePath)
-	file, err := os.Create(fullFilePath)
-	defer file.Close()
+func (s *ServiceGenerator) writeToPathForVersion(files *generatedFiles, directory, filePath string, templater templaterForVersion) error {
+	fileContents, err := templater.template()
 	if err != nil {
-		return fmt.Errorf("opening %q: %+v", fullFilePath, err)
+		return fmt.Errorf("templating: %+v", err)
 	}
 
-	logging.Tracef(fmt.Sprintf("writing to %q", fullFilePath))
-	_, _ = file.WriteString(*fileContents)
+	return files.add(filepath.Join(directory, filePath), *fileContents)
+}
+
+// generatedFiles contains the files generated for a Resource (or API Version), which are written once every stage
+// has completed - since the Go files within each package need to be formatted together.
+type generatedFiles struct {
+	// goFiles is a list of the Go files which have been generated, which are formatted prior to being written
+	goFiles []goFile
+
+	// otherFiles is a map of File Path (key) to the contents (value) for any other files which have been generated
+	otherFiles map[string]string
+}
+
+func newGeneratedFiles() *generatedFiles {
+	return &generatedFiles{
+		goFiles:    make([]goFile, 0),
+		otherFiles: make(map[string]string),
+	}
+}
+
+// add adds the file at fullFilePath to the list of generated files - Go files are parsed at this point to ensure
+// that the generated code is valid, which allows the stage which generated any invalid code to be identified.
+func (f *generatedFiles) add(fullFilePath, fileContents string) error {
+	if filepath.Ext(fullFilePath) != ".go" {
+		f.otherFiles[fullFilePath] = fileContents
+		return nil
+	}
+
+	file, err := parseGoCode(fullFilePath, fileContents)
+	if err != nil {
+		return fmt.Errorf("parsing: %+v", err)
+	}
+	f.goFiles = append(f.goFiles, *file)
 	return nil
 }
 
-func (s *ServiceGenerator) writeToPathForVersion(directory, filePath string, templater templaterForVersion) error {
-	fileContents, err := templater.template()
+// write formats each of the Go files (and fixes their imports) and then writes all of the generated files to disk.
+func (f *generatedFiles) write() error {
+	formatted, err := formatGoFiles(f.goFiles)
 	if err != nil {
-		return fmt.Errorf("templating: %+v", err)
+		return fmt.Errorf("formatting: %+v", err)
+	}
+	for fullFilePath, fileContents := range f.otherFiles {
+		formatted[fullFilePath] = fileContents
 	}
 
-	fullFilePath := filepath.Join(directory, filePath)
+	for fullFilePath, fileContents := range formatted {
+		if err := writeToPath(fullFilePath, fileContents); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func writeToPath(fullFilePath, fileContents string) error {
 	// remove any existing file if it exists
 	_ = os.Remove(fullFilePath)
-	file, err := os.Create(fullFilePath)
-	defer file.Close()
-	if err != nil {
-		return fmt.Errorf("opening %q: %+v", fullFilePath, err)
-	}
 
 	logging.Tracef(fmt.Sprintf("writing to %q", fullFilePath))
-	_, _ = file.WriteString(*fileContents)
+	if err := os.WriteFile(fullFilePath, []byte(fileContents), 0644); err != nil {
+		return fmt.Errorf("writing to %q: %+v", fullFilePath, err)
+	}
 	return nil
 }
@@ -0,0 +1,117 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+)
+
+var _ templaterForResource = clientFakesTemplater{}
+
+// clientFakesTemplater outputs a fake implementation of the Client interface (see clientInterfaceTemplater) into
+// the `fakes` package, which records the calls made to each method and returns programmable responses - allowing
+// the Client to be used in tests without making any HTTP requests.
+type clientFakesTemplater struct {
+}
+
+func (c clientFakesTemplater) template(data ServiceGeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	methods, err := clientMethodsForResource(data, pointer.To(data.packageName))
+	if err != nil {
+		return nil, fmt.Errorf("determining the methods for the client: %+v", err)
+	}
+
+	fields := make([]string, 0)
+	callTypes := make([]string, 0)
+	implementations := make([]string, 0)
+	for _, method := range methods {
+		fields = append(fields, fmt.Sprintf(`
+	// %[1]sFunc is called by %[1]s when set, otherwise %[1]s returns the zero value.
+	%[1]sFunc func(%[2]s) %[3]s
+
+	// %[1]sCalls contains the arguments for each call made to %[1]s.
+	%[1]sCalls []%[1]sCall
+`, method.name, method.argumentsSignature(), method.returnTypesSignature()))
+
+		callFields := make([]string, 0)
+		callAssignments := make([]string, 0)
+		argumentNames := []string{"ctx"}
+		for _, argument := range method.arguments {
+			fieldName := capitalizeFirstLetter(argument.name)
+			callFields = append(callFields, fmt.Sprintf("\t%s %s", fieldName, argument.typeName))
+			callAssignments = append(callAssignments, fmt.Sprintf("\t\t%s: %s,", fieldName, argument.name))
+			argumentNames = append(argumentNames, argument.name)
+		}
+		callTypes = append(callTypes, fmt.Sprintf(`
+// %[1]sCall contains the arguments for a call made to %[1]s.
+type %[1]sCall struct {
+%[2]s
+}
+`, method.name, strings.Join(callFields, "\n")))
+
+		zeroValues := make([]string, 0)
+		for _, returnType := range method.returnTypes {
+			if returnType == "error" {
+				zeroValues = append(zeroValues, "nil")
+				continue
+			}
+			if strings.HasPrefix(returnType, "iter.Seq2[") {
+				// an iterator is a func, so the zero value is one which yields nothing
+				itemTypes := strings.TrimSuffix(strings.TrimPrefix(returnType, "iter.Seq2["), "]")
+				zeroValues = append(zeroValues, fmt.Sprintf("func(func(%s) bool) {}", itemTypes))
+				continue
+			}
+			zeroValues = append(zeroValues, fmt.Sprintf("%s{}", returnType))
+		}
+		implementations = append(implementations, fmt.Sprintf(`
+func (f *%[1]s) %[2]s(%[3]s) %[4]s {
+	f.mu.Lock()
+	f.%[2]sCalls = append(f.%[2]sCalls, %[2]sCall{
+%[5]s
+	})
+	fn := f.%[2]sFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(%[6]s)
+	}
+	return %[7]s
+}
+`, data.serviceClientName, method.name, method.argumentsSignature(), method.returnTypesSignature(), strings.Join(callAssignments, "\n"), strings.Join(argumentNames, ", "), strings.Join(zeroValues, ", ")))
+	}
+
+	template := fmt.Sprintf(`package fakes
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/%[2]s/%[3]s/%[1]s"
+)
+
+%[5]s
+
+var _ %[1]s.%[4]sInterface = &%[4]s{}
+
+// %[4]s is a fake implementation of %[1]s.%[4]sInterface for use in tests, which records the calls
+// made to each method and returns the response from the matching '{Method}Func' field when set.
+type %[4]s struct {
+	mu sync.Mutex
+%[6]s
+}
+
+%[7]s
+%[8]s
+`, data.packageName, data.servicePackageName, data.apiVersion, data.serviceClientName, *copyrightLines, strings.Join(fields, ""), strings.Join(callTypes, ""), strings.Join(implementations, ""))
+	return &template, nil
+}
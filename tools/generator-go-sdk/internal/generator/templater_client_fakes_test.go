@@ -0,0 +1,246 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestTemplateClientFakes(t *testing.T) {
+	input := ServiceGeneratorData{
+		apiVersion:         "2020-01-01",
+		packageName:        "somepackage",
+		serviceClientName:  "ExampleClient",
+		servicePackageName: "someservice",
+		source:             AccTestLicenceType,
+		operations: map[string]models.SDKOperation{
+			"Delete": {
+				LongRunning:    true,
+				Method:         "DELETE",
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+			"Get": {
+				Method:         "GET",
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+			"List": {
+				FieldContainingPaginationDetails: pointer.To("nextLink"),
+				Method:                           "GET",
+				ResourceIDName:                   pointer.To("ExampleId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type: models.StringSDKObjectDefinitionType,
+				},
+			},
+		},
+		resourceIds: map[string]models.ResourceID{
+			"ExampleId": {},
+		},
+	}
+
+	actual, err := clientFakesTemplater{}.template(input)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := `package fakes
+
+import (
+	"context"
+	"iter"
+	"sync"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/someservice/2020-01-01/somepackage"
+)
+
+// acctests licence placeholder
+
+var _ somepackage.ExampleClientInterface = &ExampleClient{}
+
+// ExampleClient is a fake implementation of somepackage.ExampleClientInterface for use in tests, which records the calls
+// made to each method and returns the response from the matching '{Method}Func' field when set.
+type ExampleClient struct {
+	mu sync.Mutex
+
+	// DeleteFunc is called by Delete when set, otherwise Delete returns the zero value.
+	DeleteFunc func(ctx context.Context, id somepackage.ExampleId) (somepackage.DeleteOperationResponse, error)
+
+	// DeleteCalls contains the arguments for each call made to Delete.
+	DeleteCalls []DeleteCall
+
+	// DeleteThenPollFunc is called by DeleteThenPoll when set, otherwise DeleteThenPoll returns the zero value.
+	DeleteThenPollFunc func(ctx context.Context, id somepackage.ExampleId) error
+
+	// DeleteThenPollCalls contains the arguments for each call made to DeleteThenPoll.
+	DeleteThenPollCalls []DeleteThenPollCall
+
+	// GetFunc is called by Get when set, otherwise Get returns the zero value.
+	GetFunc func(ctx context.Context, id somepackage.ExampleId) (somepackage.GetOperationResponse, error)
+
+	// GetCalls contains the arguments for each call made to Get.
+	GetCalls []GetCall
+
+	// ListFunc is called by List when set, otherwise List returns the zero value.
+	ListFunc func(ctx context.Context, id somepackage.ExampleId) (somepackage.ListOperationResponse, error)
+
+	// ListCalls contains the arguments for each call made to List.
+	ListCalls []ListCall
+
+	// ListCompleteFunc is called by ListComplete when set, otherwise ListComplete returns the zero value.
+	ListCompleteFunc func(ctx context.Context, id somepackage.ExampleId) (somepackage.ListCompleteResult, error)
+
+	// ListCompleteCalls contains the arguments for each call made to ListComplete.
+	ListCompleteCalls []ListCompleteCall
+
+	// ListIterFunc is called by ListIter when set, otherwise ListIter returns the zero value.
+	ListIterFunc func(ctx context.Context, id somepackage.ExampleId) iter.Seq2[string, error]
+
+	// ListIterCalls contains the arguments for each call made to ListIter.
+	ListIterCalls []ListIterCall
+
+	// ResumeDeletePollerFunc is called by ResumeDeletePoller when set, otherwise ResumeDeletePoller returns the zero value.
+	ResumeDeletePollerFunc func(ctx context.Context, token string) (somepackage.DeleteOperationResponse, error)
+
+	// ResumeDeletePollerCalls contains the arguments for each call made to ResumeDeletePoller.
+	ResumeDeletePollerCalls []ResumeDeletePollerCall
+}
+
+// DeleteCall contains the arguments for a call made to Delete.
+type DeleteCall struct {
+	Id somepackage.ExampleId
+}
+
+// DeleteThenPollCall contains the arguments for a call made to DeleteThenPoll.
+type DeleteThenPollCall struct {
+	Id somepackage.ExampleId
+}
+
+// GetCall contains the arguments for a call made to Get.
+type GetCall struct {
+	Id somepackage.ExampleId
+}
+
+// ListCall contains the arguments for a call made to List.
+type ListCall struct {
+	Id somepackage.ExampleId
+}
+
+// ListCompleteCall contains the arguments for a call made to ListComplete.
+type ListCompleteCall struct {
+	Id somepackage.ExampleId
+}
+
+// ListIterCall contains the arguments for a call made to ListIter.
+type ListIterCall struct {
+	Id somepackage.ExampleId
+}
+
+// ResumeDeletePollerCall contains the arguments for a call made to ResumeDeletePoller.
+type ResumeDeletePollerCall struct {
+	Token string
+}
+
+func (f *ExampleClient) Delete(ctx context.Context, id somepackage.ExampleId) (somepackage.DeleteOperationResponse, error) {
+	f.mu.Lock()
+	f.DeleteCalls = append(f.DeleteCalls, DeleteCall{
+		Id: id,
+	})
+	fn := f.DeleteFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	return somepackage.DeleteOperationResponse{}, nil
+}
+
+func (f *ExampleClient) DeleteThenPoll(ctx context.Context, id somepackage.ExampleId) error {
+	f.mu.Lock()
+	f.DeleteThenPollCalls = append(f.DeleteThenPollCalls, DeleteThenPollCall{
+		Id: id,
+	})
+	fn := f.DeleteThenPollFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	return nil
+}
+
+func (f *ExampleClient) Get(ctx context.Context, id somepackage.ExampleId) (somepackage.GetOperationResponse, error) {
+	f.mu.Lock()
+	f.GetCalls = append(f.GetCalls, GetCall{
+		Id: id,
+	})
+	fn := f.GetFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	return somepackage.GetOperationResponse{}, nil
+}
+
+func (f *ExampleClient) List(ctx context.Context, id somepackage.ExampleId) (somepackage.ListOperationResponse, error) {
+	f.mu.Lock()
+	f.ListCalls = append(f.ListCalls, ListCall{
+		Id: id,
+	})
+	fn := f.ListFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	return somepackage.ListOperationResponse{}, nil
+}
+
+func (f *ExampleClient) ListComplete(ctx context.Context, id somepackage.ExampleId) (somepackage.ListCompleteResult, error) {
+	f.mu.Lock()
+	f.ListCompleteCalls = append(f.ListCompleteCalls, ListCompleteCall{
+		Id: id,
+	})
+	fn := f.ListCompleteFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	return somepackage.ListCompleteResult{}, nil
+}
+
+func (f *ExampleClient) ListIter(ctx context.Context, id somepackage.ExampleId) iter.Seq2[string, error] {
+	f.mu.Lock()
+	f.ListIterCalls = append(f.ListIterCalls, ListIterCall{
+		Id: id,
+	})
+	fn := f.ListIterFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, id)
+	}
+	return func(func(string, error) bool) {}
+}
+
+func (f *ExampleClient) ResumeDeletePoller(ctx context.Context, token string) (somepackage.DeleteOperationResponse, error) {
+	f.mu.Lock()
+	f.ResumeDeletePollerCalls = append(f.ResumeDeletePollerCalls, ResumeDeletePollerCall{
+		Token: token,
+	})
+	fn := f.ResumeDeletePollerFunc
+	f.mu.Unlock()
+
+	if fn != nil {
+		return fn(ctx, token)
+	}
+	return somepackage.DeleteOperationResponse{}, nil
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
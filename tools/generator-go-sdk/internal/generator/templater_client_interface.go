@@ -0,0 +1,54 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+)
+
+var _ templaterForResource = clientInterfaceTemplater{}
+
+// clientInterfaceTemplater outputs an interface covering each of the methods available on the Client, which
+// allows the Client to be substituted (for example with the implementation in the `fakes` package) in tests.
+type clientInterfaceTemplater struct {
+}
+
+func (c clientInterfaceTemplater) template(data ServiceGeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	methods, err := clientMethodsForResource(data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("determining the methods for the client: %+v", err)
+	}
+
+	lines := make([]string, 0)
+	for _, method := range methods {
+		lines = append(lines, fmt.Sprintf("\t%s(%s) %s", method.name, method.argumentsSignature(), method.returnTypesSignature()))
+	}
+
+	template := fmt.Sprintf(`package %[1]s
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+%[3]s
+
+var _ %[2]sInterface = &%[2]s{}
+
+// %[2]sInterface is the interface implemented by %[2]s, allowing this to be substituted in tests
+// (for example using the implementation within the 'fakes' package).
+type %[2]sInterface interface {
+%[4]s
+}
+`, data.packageName, data.serviceClientName, *copyrightLines, strings.Join(lines, "\n"))
+	return &template, nil
+}
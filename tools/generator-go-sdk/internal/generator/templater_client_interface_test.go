@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestTemplateClientInterface(t *testing.T) {
+	input := ServiceGeneratorData{
+		packageName:       "somepackage",
+		serviceClientName: "ExampleClient",
+		source:            AccTestLicenceType,
+		operations: map[string]models.SDKOperation{
+			"CreateOrUpdate": {
+				LongRunning: true,
+				Method:      "PUT",
+				RequestObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("Example"),
+				},
+				ResourceIDName: pointer.To("ExampleId"),
+			},
+			"Get": {
+				Method:         "GET",
+				ResourceIDName: pointer.To("ExampleId"),
+				Options: map[string]models.SDKOperationOption{
+					"Expand": {
+						QueryStringName: pointer.To("$expand"),
+						ObjectDefinition: models.SDKOperationOptionObjectDefinition{
+							Type: models.StringSDKOperationOptionObjectDefinitionType,
+						},
+					},
+				},
+			},
+			"List": {
+				FieldContainingPaginationDetails: pointer.To("nextLink"),
+				Method:                           "GET",
+				ResourceIDName:                   pointer.To("SubscriptionId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("Example"),
+				},
+			},
+			// Long Running Operations take precedence over pagination, so no `Complete` or `Iter` methods are output
+			"Stop": {
+				FieldContainingPaginationDetails: pointer.To("nextLink"),
+				LongRunning:                      true,
+				Method:                           "POST",
+				ResourceIDName:                   pointer.To("ExampleId"),
+				ResponseObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: pointer.To("Example"),
+				},
+			},
+		},
+		resourceIds: map[string]models.ResourceID{
+			"ExampleId": {},
+			"SubscriptionId": {
+				CommonIDAlias: pointer.To("Subscription"),
+			},
+		},
+	}
+
+	actual, err := clientInterfaceTemplater{}.template(input)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := `package somepackage
+
+import (
+	"context"
+	"iter"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+)
+
+// acctests licence placeholder
+
+var _ ExampleClientInterface = &ExampleClient{}
+
+// ExampleClientInterface is the interface implemented by ExampleClient, allowing this to be substituted in tests
+// (for example using the implementation within the 'fakes' package).
+type ExampleClientInterface interface {
+	CreateOrUpdate(ctx context.Context, id ExampleId, input Example) (CreateOrUpdateOperationResponse, error)
+	CreateOrUpdateThenPoll(ctx context.Context, id ExampleId, input Example) error
+	Get(ctx context.Context, id ExampleId, options GetOperationOptions) (GetOperationResponse, error)
+	List(ctx context.Context, id commonids.SubscriptionId) (ListOperationResponse, error)
+	ListComplete(ctx context.Context, id commonids.SubscriptionId) (ListCompleteResult, error)
+	ListCompleteMatchingPredicate(ctx context.Context, id commonids.SubscriptionId, predicate ExampleOperationPredicate) (ListCompleteResult, error)
+	ListIter(ctx context.Context, id commonids.SubscriptionId) iter.Seq2[Example, error]
+	ListIterMatchingPredicate(ctx context.Context, id commonids.SubscriptionId, predicate ExampleOperationPredicate) iter.Seq2[Example, error]
+	ResumeCreateOrUpdatePoller(ctx context.Context, token string) (CreateOrUpdateOperationResponse, error)
+	ResumeStopPoller(ctx context.Context, token string) (StopOperationResponse, error)
+	Stop(ctx context.Context, id ExampleId) (StopOperationResponse, error)
+	StopThenPoll(ctx context.Context, id ExampleId) error
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -24,6 +24,10 @@ import (
 
 type %[2]s struct {
 	Client  *resourcemanager.Client
+
+	// ValidateRequestBodies specifies whether the Request Body should be validated (using the Validate
+	// function on the Model) before each request is sent, rather than relying on the API to reject it.
+	ValidateRequestBodies bool
 }
 
 func New%[2]sWithBaseURI(sdkApi sdkEnv.Api) (*%[2]s, error) {
@@ -30,6 +30,10 @@ import (
 
 type ExampleClient struct {
 	Client  *resourcemanager.Client
+
+	// ValidateRequestBodies specifies whether the Request Body should be validated (using the Validate
+	// function on the Model) before each request is sent, rather than relying on the API to reject it.
+	ValidateRequestBodies bool
 }
 
 func NewExampleClientWithBaseURI(sdkApi sdkEnv.Api) (*ExampleClient, error) {
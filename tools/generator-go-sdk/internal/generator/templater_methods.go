@@ -34,6 +34,7 @@ func (c methodsPandoraTemplater) template(data ServiceGeneratorData) (*string, e
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 
@@ -138,7 +139,7 @@ func (c methodsPandoraTemplater) immediateOperationTemplate(data ServiceGenerato
 	if err != nil {
 		return nil, fmt.Errorf("building request config: %+v", err)
 	}
-	marshalerCode, err := c.marshalerTemplate()
+	marshalerCode, err := c.marshalerTemplate(data)
 	if err != nil {
 		return nil, fmt.Errorf("building marshaler template: %+v", err)
 	}
@@ -198,7 +199,7 @@ func (c methodsPandoraTemplater) longRunningOperationTemplate(data ServiceGenera
 	if err != nil {
 		return nil, fmt.Errorf("building request config: %+v", err)
 	}
-	marshalerCode, err := c.marshalerTemplate()
+	marshalerCode, err := c.marshalerTemplate(data)
 	if err != nil {
 		return nil, fmt.Errorf("building marshaler template: %+v", err)
 	}
@@ -264,7 +265,30 @@ func (c %[1]s) %[2]sThenPoll(ctx context.Context %[3]s) error {
 
 	return nil
 }
-`, data.serviceClientName, c.operationName, *methodArguments, *requestOptions, *marshalerCode, *unmarshalerCode, argumentsCode, *responseStruct, *optionsStruct)
+
+// ResumeToken returns a token which can be used to resume polling the Long Running Operation started by %[2]s
+// (for example in another process, once this one has exited) using Resume%[2]sPoller
+func (r %[2]sOperationResponse) ResumeToken() (string, error) {
+	return newPollerResumeToken("%[2]s", r.HttpResponse)
+}
+
+// Resume%[2]sPoller resumes polling the Long Running Operation started by %[2]s, using the token returned
+// from %[2]sOperationResponse.ResumeToken - the Poller within the result can then be used to wait for it to complete
+func (c %[1]s) Resume%[2]sPoller(ctx context.Context, token string) (result %[2]sOperationResponse, err error) {
+	resp, err := responseForPollerResumeToken(ctx, "%[2]s", http.Method%[10]s, token)
+	if err != nil {
+		return
+	}
+	result.HttpResponse = resp
+
+	result.Poller, err = resourcemanager.PollerFromResponse(&client.Response{Response: resp}, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
+`, data.serviceClientName, c.operationName, *methodArguments, *requestOptions, *marshalerCode, *unmarshalerCode, argumentsCode, *responseStruct, *optionsStruct, capitalizeFirstLetter(c.operation.Method))
 	return &templated, nil
 }
 
@@ -384,9 +408,119 @@ func (c %[1]s) %[2]sComplete(ctx context.Context%[3]s) (result %[2]sCompleteResu
 }
 `, data.serviceClientName, c.operationName, *methodArguments, argumentsCode, *typeName)
 	}
+
+	iteratorCode, err := c.iteratorTemplate(data, *methodArguments, argumentsCode, *requestOptions, *typeName)
+	if err != nil {
+		return nil, fmt.Errorf("building iterator template: %+v", err)
+	}
+	templated += *iteratorCode
+
 	return &templated, nil
 }
 
+// iteratorTemplate returns the `{Operation}Iter` methods for a List Operation, which return an iterator over the
+// results - unlike the `Complete` methods each page is retrieved only as it's needed, so that the caller can stop early.
+func (c methodsPandoraTemplater) iteratorTemplate(data ServiceGeneratorData, methodArguments, argumentsCode, requestOptions, typeName string) (*string, error) {
+	if c.operation.FieldContainingPaginationDetails == nil {
+		return nil, fmt.Errorf("the iterator methods require the field containing the pagination details")
+	}
+
+	// as with the `Complete` methods, predicates are only available for models and not for base types like string, int etc.
+	usePredicate := c.operation.ResponseObject.Type == models.ReferenceSDKObjectDefinitionType || c.operation.ResponseObject.Type == models.ListSDKObjectDefinitionType
+
+	yieldCondition := "!yield(v, nil)"
+	if usePredicate {
+		yieldCondition = "predicate.Matches(v) && !yield(v, nil)"
+	}
+
+	valuesType := typeName
+	yieldValues := fmt.Sprintf(`
+				for _, v := range *page.Values {
+					if %[1]s {
+						return
+					}
+				}`, yieldCondition)
+	if discriminatedTypeParentName := c.discriminatedTypeParentName(data, typeName); discriminatedTypeParentName != "" {
+		valuesType = "json.RawMessage"
+		yieldValues = fmt.Sprintf(`
+				for i, raw := range *page.Values {
+					v, err := unmarshal%[1]sImplementation(raw)
+					if err != nil {
+						yield(empty, fmt.Errorf("unmarshalling item %%d for %[1]s (%%q): %%+v", i, raw, err))
+						return
+					}
+					if %[2]s {
+						return
+					}
+				}`, discriminatedTypeParentName, yieldCondition)
+	}
+
+	methodName := fmt.Sprintf("%sIter", c.operationName)
+	comment := fmt.Sprintf("// %s returns an iterator over the results, retrieving each page only when it's needed", methodName)
+	predicateArgument := ""
+	output := ""
+	if usePredicate {
+		output = fmt.Sprintf(`
+%[4]s
+func (c %[1]s) %[2]sIter(ctx context.Context%[3]s) iter.Seq2[%[6]s, error] {
+	return c.%[2]sIterMatchingPredicate(ctx%[5]s, %[6]sOperationPredicate{})
+}
+`, data.serviceClientName, c.operationName, methodArguments, comment, argumentsCode, typeName)
+
+		methodName = fmt.Sprintf("%sIterMatchingPredicate", c.operationName)
+		comment = fmt.Sprintf("// %s returns an iterator over the results matching the predicate, retrieving each page only when it's needed", methodName)
+		predicateArgument = fmt.Sprintf(", predicate %sOperationPredicate", typeName)
+	}
+
+	output += fmt.Sprintf(`
+%[1]s
+func (c %[2]s) %[3]s(ctx context.Context%[4]s%[5]s) iter.Seq2[%[6]s, error] {
+	return func(yield func(%[6]s, error) bool) {
+		var empty %[6]s
+		opts := %[7]s
+
+		var nextLink *string
+		for {
+			req, err := c.Client.NewRequest(ctx, opts)
+			if err != nil {
+				yield(empty, err)
+				return
+			}
+			if nextLink != nil {
+				if req.URL, err = url.Parse(*nextLink); err != nil {
+					yield(empty, fmt.Errorf("parsing nextLink %%q: %%+v", *nextLink, err))
+					return
+				}
+			}
+
+			resp, err := req.Execute(ctx)
+			if err != nil {
+				yield(empty, fmt.Errorf("loading results: %%+v", err))
+				return
+			}
+
+			var page struct {
+				Values   *[]%[8]s %[9]s
+				NextLink *string %[10]s
+			}
+			if err = resp.Unmarshal(&page); err != nil {
+				yield(empty, fmt.Errorf("unmarshaling results: %%+v", err))
+				return
+			}
+			if page.Values != nil {%[11]s
+			}
+
+			if page.NextLink == nil || *page.NextLink == "" {
+				return
+			}
+			nextLink = page.NextLink
+		}
+	}
+}
+`, comment, data.serviceClientName, methodName, methodArguments, predicateArgument, typeName, requestOptions, valuesType, "`json:\"value\"`", fmt.Sprintf("`json:%q`", *c.operation.FieldContainingPaginationDetails), yieldValues)
+	return &output, nil
+}
+
 func (c methodsPandoraTemplater) argumentsTemplate() string {
 	args := make([]string, 0)
 	if c.operation.ResourceIDName != nil {
@@ -517,20 +651,53 @@ func (c methodsPandoraTemplater) requestOptions() (*string, error) {
 	return &out, nil
 }
 
-func (c methodsPandoraTemplater) marshalerTemplate() (*string, error) {
+func (c methodsPandoraTemplater) marshalerTemplate(data ServiceGeneratorData) (*string, error) {
 	var output string
 
 	if c.operation.RequestObject != nil {
 		output = fmt.Sprintf(`
+	%s
 	if err = req.Marshal(input); err != nil {
 		return
 	}
-`)
+`, c.validateRequestBodyTemplate(data))
 	}
 
 	return &output, nil
 }
 
+// validateRequestBodyTemplate returns the code used to validate the Request Body prior to it being sent, when
+// opted into on the Client - which is only possible when the Request Object is a Model. Unlike the public Validate
+// function this also checks that no ReadOnly fields are specified, since these can't be sent in a request.
+func (c methodsPandoraTemplater) validateRequestBodyTemplate(data ServiceGeneratorData) string {
+	if c.operation.RequestObject.Type != models.ReferenceSDKObjectDefinitionType {
+		return ""
+	}
+	model, ok := data.models[*c.operation.RequestObject.ReferenceName]
+	if !ok {
+		return ""
+	}
+
+	validateCode := `if err = errors.Join(input.validate("", true)...); err != nil {
+			err = fmt.Errorf("validating the request body: %+v", err)
+			return
+		}`
+	if model.IsDiscriminatedParentType() {
+		// Discriminated Types are exposed as an interface, so this validates the implementation
+		validateCode = `if impl, ok := input.(validatable); ok {
+			if err = errors.Join(impl.validate("", true)...); err != nil {
+				err = fmt.Errorf("validating the request body: %+v", err)
+				return
+			}
+		}`
+	}
+
+	return fmt.Sprintf(`if c.ValidateRequestBodies {
+		%s
+	}
+`, validateCode)
+}
+
 func (c methodsPandoraTemplater) unmarshalerTemplate(data ServiceGeneratorData) (*string, error) {
 	var output string
 
@@ -547,24 +714,7 @@ func (c methodsPandoraTemplater) unmarshalerTemplate(data ServiceGeneratorData)
 			return nil, fmt.Errorf("determing golang type name for response object: %+v", err)
 		}
 		typeName := *golangTypeName
-
-		discriminatedTypeParentName := ""
-		if model, ok := data.models[typeName]; ok {
-			// it's either a parent model
-			if model.FieldNameContainingDiscriminatedValue != nil {
-				discriminatedTypeParentName = typeName
-			}
-			// or an implementation referencing a parent
-			if model.ParentTypeName != nil {
-				discriminatedTypeParentName = *model.ParentTypeName
-			}
-
-			if model.DiscriminatedValue != nil {
-				// in this instance this would be a discriminated implementation present in the response object
-				// as such we should use that directly, rather than calling the parents unmarshal function
-				discriminatedTypeParentName = ""
-			}
-		}
+		discriminatedTypeParentName := c.discriminatedTypeParentName(data, typeName)
 
 		if c.operation.FieldContainingPaginationDetails != nil {
 			output = fmt.Sprintf(`
@@ -647,6 +797,29 @@ func (c methodsPandoraTemplater) unmarshalerTemplate(data ServiceGeneratorData)
 	return &output, nil
 }
 
+// discriminatedTypeParentName returns the name of the Discriminated Parent Type whose unmarshal function should be
+// used to unmarshal the specified type, or an empty string when this can be unmarshaled directly.
+func (c methodsPandoraTemplater) discriminatedTypeParentName(data ServiceGeneratorData, typeName string) string {
+	discriminatedTypeParentName := ""
+	if model, ok := data.models[typeName]; ok {
+		// it's either a parent model
+		if model.FieldNameContainingDiscriminatedValue != nil {
+			discriminatedTypeParentName = typeName
+		}
+		// or an implementation referencing a parent
+		if model.ParentTypeName != nil {
+			discriminatedTypeParentName = *model.ParentTypeName
+		}
+
+		if model.DiscriminatedValue != nil {
+			// in this instance this would be a discriminated implementation present in the response object
+			// as such we should use that directly, rather than calling the parents unmarshal function
+			discriminatedTypeParentName = ""
+		}
+	}
+	return discriminatedTypeParentName
+}
+
 func (c methodsPandoraTemplater) responseStructTemplate(data ServiceGeneratorData) (*string, error) {
 	model := ""
 	typeName := ""
@@ -65,6 +65,7 @@ package chubbypandas
 import (
 "context"
 "fmt"
+"iter"
 "net/http"
 "net/url"
 "github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -168,6 +169,7 @@ package chubbypandas
 import (
 "context"
 "fmt"
+"iter"
 "net/http"
 "net/url"
 "github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -278,6 +280,7 @@ package chubbypandas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -364,7 +367,69 @@ func (c pandaClient) ListCompleteMatchingPredicate(ctx context.Context, predicat
 	}
 	return
 }
-`, "`json:\"value\"`")
+
+// ListIter returns an iterator over the results, retrieving each page only when it's needed
+func (c pandaClient) ListIter(ctx context.Context) iter.Seq2[PandaPop, error] {
+	return c.ListIterMatchingPredicate(ctx, PandaPopOperationPredicate{})
+}
+
+// ListIterMatchingPredicate returns an iterator over the results matching the predicate, retrieving each page only when it's needed
+func (c pandaClient) ListIterMatchingPredicate(ctx context.Context, predicate PandaPopOperationPredicate) iter.Seq2[PandaPop, error] {
+	return func(yield func(PandaPop, error) bool) {
+		var empty PandaPop
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			Path: "/thing",
+		}
+
+		var nextLink *string
+		for {
+			req, err := c.Client.NewRequest(ctx, opts)
+			if err != nil {
+				yield(empty, err)
+				return
+			}
+			if nextLink != nil {
+				if req.URL, err = url.Parse(*nextLink); err != nil {
+					yield(empty, fmt.Errorf("parsing nextLink %%q: %%+v", *nextLink, err))
+					return
+				}
+			}
+
+			resp, err := req.Execute(ctx)
+			if err != nil {
+				yield(empty, fmt.Errorf("loading results: %%+v", err))
+				return
+			}
+
+			var page struct {
+				Values   *[]PandaPop %[1]s
+				NextLink *string %[2]s
+			}
+			if err = resp.Unmarshal(&page); err != nil {
+				yield(empty, fmt.Errorf("unmarshaling results: %%+v", err))
+				return
+			}
+			if page.Values != nil {
+				for _, v := range *page.Values {
+					if predicate.Matches(v) && !yield(v, nil) {
+						return
+					}
+				}
+			}
+
+			if page.NextLink == nil || *page.NextLink == "" {
+				return
+			}
+			nextLink = page.NextLink
+		}
+	}
+}
+`, "`json:\"value\"`", "`json:\"SomeField\"`")
 
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -421,6 +486,7 @@ package chubbypandas
 import (
 	"context"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
@@ -516,7 +582,129 @@ func (c pandaClient) ListCompleteMatchingPredicate(ctx context.Context, predicat
 	}
 	return
 }
-`, "`json:\"value\"`")
 
+// ListIter returns an iterator over the results, retrieving each page only when it's needed
+func (c pandaClient) ListIter(ctx context.Context) iter.Seq2[FizzyDrink, error] {
+	return c.ListIterMatchingPredicate(ctx, FizzyDrinkOperationPredicate{})
+}
+
+// ListIterMatchingPredicate returns an iterator over the results matching the predicate, retrieving each page only when it's needed
+func (c pandaClient) ListIterMatchingPredicate(ctx context.Context, predicate FizzyDrinkOperationPredicate) iter.Seq2[FizzyDrink, error] {
+	return func(yield func(FizzyDrink, error) bool) {
+		var empty FizzyDrink
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			Path: "/thing",
+		}
+
+		var nextLink *string
+		for {
+			req, err := c.Client.NewRequest(ctx, opts)
+			if err != nil {
+				yield(empty, err)
+				return
+			}
+			if nextLink != nil {
+				if req.URL, err = url.Parse(*nextLink); err != nil {
+					yield(empty, fmt.Errorf("parsing nextLink %%q: %%+v", *nextLink, err))
+					return
+				}
+			}
+
+			resp, err := req.Execute(ctx)
+			if err != nil {
+				yield(empty, fmt.Errorf("loading results: %%+v", err))
+				return
+			}
+
+			var page struct {
+				Values   *[]json.RawMessage %[1]s
+				NextLink *string %[2]s
+			}
+			if err = resp.Unmarshal(&page); err != nil {
+				yield(empty, fmt.Errorf("unmarshaling results: %%+v", err))
+				return
+			}
+			if page.Values != nil {
+				for i, raw := range *page.Values {
+					v, err := unmarshalFizzyDrinkImplementation(raw)
+					if err != nil {
+						yield(empty, fmt.Errorf("unmarshalling item %%d for FizzyDrink (%%q): %%+v", i, raw, err))
+						return
+					}
+					if predicate.Matches(v) && !yield(v, nil) {
+						return
+					}
+				}
+			}
+
+			if page.NextLink == nil || *page.NextLink == "" {
+				return
+			}
+			nextLink = page.NextLink
+		}
+	}
+}
+`, "`json:\"value\"`", "`json:\"SomeField\"`")
+
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestTemplateMethods_Discriminator_RequestObjectIsParent_ValidatesImplementation(t *testing.T) {
+	// This test covers the Request Object being a Discriminated Parent Type, which is exposed as an interface -
+	// in this instance the Request Body should be validated using the implementation, as a request.
+
+	input := ServiceGeneratorData{
+		packageName:       "chubbypandas",
+		serviceClientName: "pandaClient",
+		source:            AccTestLicenceType,
+		models: map[string]models.SDKModel{
+			"FizzyDrink": {
+				FieldNameContainingDiscriminatedValue: stringPointer("Flavour"),
+				Fields: map[string]models.SDKField{
+					"Flavour": {
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		useNewBaseLayer: true,
+	}
+
+	actual, err := methodsPandoraTemplater{
+		operation: models.SDKOperation{
+			Method: "PUT",
+			RequestObject: &models.SDKObjectDefinition{
+				Type:          models.ReferenceSDKObjectDefinitionType,
+				ReferenceName: stringPointer("FizzyDrink"),
+			},
+		},
+		operationName: "Create",
+	}.marshalerTemplate(input)
+	if err != nil {
+		t.Fatalf("err %+v", err)
+	}
+
+	expected := `
+	if c.ValidateRequestBodies {
+		if impl, ok := input.(validatable); ok {
+			if err = errors.Join(impl.validate("", true)...); err != nil {
+				err = fmt.Errorf("validating the request body: %+v", err)
+				return
+			}
+		}
+	}
+
+	if err = req.Marshal(input); err != nil {
+		return
+	}
+`
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -98,6 +98,29 @@ func (c pandaClient) CreateThenPoll(ctx context.Context , id PandaPop, input str
 
 	return nil
 }
+
+// ResumeToken returns a token which can be used to resume polling the Long Running Operation started by Create
+// (for example in another process, once this one has exited) using ResumeCreatePoller
+func (r CreateOperationResponse) ResumeToken() (string, error) {
+	return newPollerResumeToken("Create", r.HttpResponse)
+}
+
+// ResumeCreatePoller resumes polling the Long Running Operation started by Create, using the token returned
+// from CreateOperationResponse.ResumeToken - the Poller within the result can then be used to wait for it to complete
+func (c pandaClient) ResumeCreatePoller(ctx context.Context, token string) (result CreateOperationResponse, err error) {
+	resp, err := responseForPollerResumeToken(ctx, "Create", http.MethodPut, token)
+	if err != nil {
+		return
+	}
+	result.HttpResponse = resp
+
+	result.Poller, err = resourcemanager.PollerFromResponse(&client.Response{Response: resp}, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -182,6 +205,29 @@ func (c pandaClient) RebootThenPoll(ctx context.Context , id PandaPop) error {
 
 	return nil
 }
+
+// ResumeToken returns a token which can be used to resume polling the Long Running Operation started by Reboot
+// (for example in another process, once this one has exited) using ResumeRebootPoller
+func (r RebootOperationResponse) ResumeToken() (string, error) {
+	return newPollerResumeToken("Reboot", r.HttpResponse)
+}
+
+// ResumeRebootPoller resumes polling the Long Running Operation started by Reboot, using the token returned
+// from RebootOperationResponse.ResumeToken - the Poller within the result can then be used to wait for it to complete
+func (c pandaClient) ResumeRebootPoller(ctx context.Context, token string) (result RebootOperationResponse, err error) {
+	resp, err := responseForPollerResumeToken(ctx, "Reboot", http.MethodPost, token)
+	if err != nil {
+		return
+	}
+	result.HttpResponse = resp
+
+	result.Poller, err = resourcemanager.PollerFromResponse(&client.Response{Response: resp}, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -266,6 +312,13 @@ func (c pandaClient) Create(ctx context.Context , id PandaPop, input Example) (r
 		return
 	}
 
+	if c.ValidateRequestBodies {
+		if err = errors.Join(input.validate("", true)...); err != nil {
+			err = fmt.Errorf("validating the request body: %+v", err)
+			return
+		}
+	}
+
 	if err = req.Marshal(input); err != nil {
 		return
 	}
@@ -301,6 +354,29 @@ func (c pandaClient) CreateThenPoll(ctx context.Context , id PandaPop, input Exa
 
 	return nil
 }
+
+// ResumeToken returns a token which can be used to resume polling the Long Running Operation started by Create
+// (for example in another process, once this one has exited) using ResumeCreatePoller
+func (r CreateOperationResponse) ResumeToken() (string, error) {
+	return newPollerResumeToken("Create", r.HttpResponse)
+}
+
+// ResumeCreatePoller resumes polling the Long Running Operation started by Create, using the token returned
+// from CreateOperationResponse.ResumeToken - the Poller within the result can then be used to wait for it to complete
+func (c pandaClient) ResumeCreatePoller(ctx context.Context, token string) (result CreateOperationResponse, err error) {
+	resp, err := responseForPollerResumeToken(ctx, "Create", http.MethodPut, token)
+	if err != nil {
+		return
+	}
+	result.HttpResponse = resp
+
+	result.Poller, err = resourcemanager.PollerFromResponse(&client.Response{Response: resp}, c.Client)
+	if err != nil {
+		return
+	}
+
+	return
+}
 `
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -317,7 +317,74 @@ func (c pandaClient) ListCompleteMatchingPredicate(ctx context.Context, id Panda
 	}
 	return
 }
-`, "`json:\"value\"`")
+
+// ListIter returns an iterator over the results, retrieving each page only when it's needed
+func (c pandaClient) ListIter(ctx context.Context, id PandaPop) iter.Seq2[Bottle, error] {
+	return c.ListIterMatchingPredicate(ctx, id, BottleOperationPredicate{})
+}
+
+// ListIterMatchingPredicate returns an iterator over the results matching the predicate, retrieving each page only when it's needed
+func (c pandaClient) ListIterMatchingPredicate(ctx context.Context, id PandaPop, predicate BottleOperationPredicate) iter.Seq2[Bottle, error] {
+	return func(yield func(Bottle, error) bool) {
+		var empty Bottle
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			Path: fmt.Sprintf("%%s/pandas", id.ID()),
+		}
+
+		var nextLink *string
+		for {
+			req, err := c.Client.NewRequest(ctx, opts)
+			if err != nil {
+				yield(empty, err)
+				return
+			}
+			if nextLink != nil {
+				if req.URL, err = url.Parse(*nextLink); err != nil {
+					yield(empty, fmt.Errorf("parsing nextLink %%q: %%+v", *nextLink, err))
+					return
+				}
+			}
+
+			resp, err := req.Execute(ctx)
+			if err != nil {
+				yield(empty, fmt.Errorf("loading results: %%+v", err))
+				return
+			}
+
+			var page struct {
+				Values   *[]json.RawMessage %[1]s
+				NextLink *string %[2]s
+			}
+			if err = resp.Unmarshal(&page); err != nil {
+				yield(empty, fmt.Errorf("unmarshaling results: %%+v", err))
+				return
+			}
+			if page.Values != nil {
+				for i, raw := range *page.Values {
+					v, err := unmarshalBottleImplementation(raw)
+					if err != nil {
+						yield(empty, fmt.Errorf("unmarshalling item %%d for Bottle (%%q): %%+v", i, raw, err))
+						return
+					}
+					if predicate.Matches(v) && !yield(v, nil) {
+						return
+					}
+				}
+			}
+
+			if page.NextLink == nil || *page.NextLink == "" {
+				return
+			}
+			nextLink = page.NextLink
+		}
+	}
+}
+`, "`json:\"value\"`", "`json:\"nextLink\"`")
 
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -427,7 +494,64 @@ func (c pandaClient) ListComplete(ctx context.Context, id PandaPop) (result List
 	}
 	return
 }
-`, "`json:\"value\"`")
+
+// ListIter returns an iterator over the results, retrieving each page only when it's needed
+func (c pandaClient) ListIter(ctx context.Context, id PandaPop) iter.Seq2[string, error] {
+	return func(yield func(string, error) bool) {
+		var empty string
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			Path: fmt.Sprintf("%%s/pandas", id.ID()),
+		}
+
+		var nextLink *string
+		for {
+			req, err := c.Client.NewRequest(ctx, opts)
+			if err != nil {
+				yield(empty, err)
+				return
+			}
+			if nextLink != nil {
+				if req.URL, err = url.Parse(*nextLink); err != nil {
+					yield(empty, fmt.Errorf("parsing nextLink %%q: %%+v", *nextLink, err))
+					return
+				}
+			}
+
+			resp, err := req.Execute(ctx)
+			if err != nil {
+				yield(empty, fmt.Errorf("loading results: %%+v", err))
+				return
+			}
+
+			var page struct {
+				Values   *[]string %[1]s
+				NextLink *string %[2]s
+			}
+			if err = resp.Unmarshal(&page); err != nil {
+				yield(empty, fmt.Errorf("unmarshaling results: %%+v", err))
+				return
+			}
+			if page.Values != nil {
+				for _, v := range *page.Values {
+					if !yield(v, nil) {
+						return
+					}
+				}
+			}
+
+			if page.NextLink == nil || *page.NextLink == "" {
+				return
+			}
+			nextLink = page.NextLink
+		}
+	}
+}
+`, "`json:\"value\"`", "`json:\"nextLink\"`")
 
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
@@ -544,7 +668,69 @@ func (c pandaClient) ListCompleteMatchingPredicate(ctx context.Context, id Panda
 	}
 	return
 }
-`, "`json:\"value\"`")
+
+// ListIter returns an iterator over the results, retrieving each page only when it's needed
+func (c pandaClient) ListIter(ctx context.Context, id PandaPop) iter.Seq2[LingLing, error] {
+	return c.ListIterMatchingPredicate(ctx, id, LingLingOperationPredicate{})
+}
+
+// ListIterMatchingPredicate returns an iterator over the results matching the predicate, retrieving each page only when it's needed
+func (c pandaClient) ListIterMatchingPredicate(ctx context.Context, id PandaPop, predicate LingLingOperationPredicate) iter.Seq2[LingLing, error] {
+	return func(yield func(LingLing, error) bool) {
+		var empty LingLing
+		opts := client.RequestOptions{
+			ContentType: "application/json",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodGet,
+			Path: fmt.Sprintf("%%s/pandas", id.ID()),
+		}
+
+		var nextLink *string
+		for {
+			req, err := c.Client.NewRequest(ctx, opts)
+			if err != nil {
+				yield(empty, err)
+				return
+			}
+			if nextLink != nil {
+				if req.URL, err = url.Parse(*nextLink); err != nil {
+					yield(empty, fmt.Errorf("parsing nextLink %%q: %%+v", *nextLink, err))
+					return
+				}
+			}
+
+			resp, err := req.Execute(ctx)
+			if err != nil {
+				yield(empty, fmt.Errorf("loading results: %%+v", err))
+				return
+			}
+
+			var page struct {
+				Values   *[]LingLing %[1]s
+				NextLink *string %[2]s
+			}
+			if err = resp.Unmarshal(&page); err != nil {
+				yield(empty, fmt.Errorf("unmarshaling results: %%+v", err))
+				return
+			}
+			if page.Values != nil {
+				for _, v := range *page.Values {
+					if predicate.Matches(v) && !yield(v, nil) {
+						return
+					}
+				}
+			}
+
+			if page.NextLink == nil || *page.NextLink == "" {
+				return
+			}
+			nextLink = page.NextLink
+		}
+	}
+}
+`, "`json:\"value\"`", "`json:\"nextLink\"`")
 
 	assertTemplatedCodeMatches(t, expected, *actual)
 }
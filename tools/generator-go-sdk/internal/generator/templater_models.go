@@ -190,7 +190,7 @@ func (c modelsTemplater) methods(data ServiceGeneratorData) (*string, error) {
 	}
 	code = append(code, *unmarshalFunctions)
 
-	// TODO: validation functions (#58)
+	// NOTE: the validation functions for each Model are output into `validation.go` (see validationTemplater)
 
 	output := strings.Join(code, "\n")
 	return &output, nil
@@ -199,6 +199,17 @@ func (c modelsTemplater) methods(data ServiceGeneratorData) (*string, error) {
 func (c modelsTemplater) structLineForField(fieldName, fieldType string, fieldDetails models.SDKField, data ServiceGeneratorData) (*string, error) {
 	jsonDetails := fieldDetails.JsonName
 
+	if fieldIsOutputAsPointer(fieldDetails, data) {
+		fieldType = fmt.Sprintf("*%s", fieldType)
+		jsonDetails += ",omitempty"
+	}
+
+	line := fmt.Sprintf("\t%s %s `json:\"%s\"`", fieldName, fieldType, jsonDetails)
+	return &line, nil
+}
+
+// fieldIsOutputAsPointer returns whether the specified field is output as a pointer within the Model.
+func fieldIsOutputAsPointer(fieldDetails models.SDKField, data ServiceGeneratorData) bool {
 	isOptional := false
 	if fieldDetails.Optional {
 		isOptional = true
@@ -216,14 +227,9 @@ func (c modelsTemplater) structLineForField(fieldName, fieldType string, fieldDe
 			}
 		}
 	}
-	// TODO: proper support for ReadOnly fields, which is likely to necessitate a custom marshal func
-	if isOptional || fieldDetails.ReadOnly {
-		fieldType = fmt.Sprintf("*%s", fieldType)
-		jsonDetails += ",omitempty"
-	}
 
-	line := fmt.Sprintf("\t%s %s `json:\"%s\"`", fieldName, fieldType, jsonDetails)
-	return &line, nil
+	// TODO: proper support for ReadOnly fields, which is likely to necessitate a custom marshal func
+	return isOptional || fieldDetails.ReadOnly
 }
 
 func (c modelsTemplater) dateFormatString(input models.SDKDateFormat) string {
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import "fmt"
+
+var _ templaterForResource = pollerResumeTokenTemplater{}
+
+// pollerResumeTokenTemplater outputs the functions used by each Long Running Operation to serialize the details
+// required to poll it into a resume token, and to build a Poller from a resume token - allowing polling to be
+// resumed (for example once the process which started the Long Running Operation has exited).
+type pollerResumeTokenTemplater struct {
+}
+
+func (t pollerResumeTokenTemplater) template(data ServiceGeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	template := fmt.Sprintf(`package %[1]s
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+%[2]s
+
+const (
+	// pollerStrategyAsyncOperation polls the URL returned in the 'Azure-AsyncOperation' header
+	pollerStrategyAsyncOperation = "AsyncOperation"
+
+	// pollerStrategyLocation polls the URL returned in the 'Location' header
+	pollerStrategyLocation = "Location"
+
+	// pollerStrategyDelete polls the resource until it's been deleted
+	pollerStrategyDelete = "Delete"
+
+	// pollerStrategyProvisioningState polls the resource until it's in a terminal Provisioning State
+	pollerStrategyProvisioningState = "ProvisioningState"
+)
+
+// pollerResumeToken contains the details required to resume polling a Long Running Operation, which is
+// serialized into the (opaque) token returned from the 'ResumeToken' function on the Operation Response.
+type pollerResumeToken struct {
+	// OperationName is the name of the Operation which started the Long Running Operation, e.g. 'CreateOrUpdate'
+	OperationName string %[3]s
+
+	// PollingURL is the URL which is polled to determine the status of the Long Running Operation
+	PollingURL string %[4]s
+
+	// RequestURL is the URL of the request which started the Long Running Operation
+	RequestURL string %[5]s
+
+	// Strategy is the polling strategy (one of the 'pollerStrategy' constants) used to poll the PollingURL
+	Strategy string %[6]s
+}
+
+// newPollerResumeToken returns a resume token for the Long Running Operation started by the specified Operation,
+// determining the polling strategy from the initial response in the same manner as 'resourcemanager.PollerFromResponse'.
+func newPollerResumeToken(operationName string, resp *http.Response) (string, error) {
+	if resp == nil || resp.Request == nil || resp.Request.URL == nil {
+		return "", fmt.Errorf("the Long Running Operation %%q hasn't been started", operationName)
+	}
+
+	token := pollerResumeToken{
+		OperationName: operationName,
+		RequestURL:    resp.Request.URL.String(),
+	}
+	// the polling headers are only used when the API has accepted the Long Running Operation
+	isLongRunningStatus := resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted
+	if pollingURL := resp.Header.Get("Azure-AsyncOperation"); isLongRunningStatus && pollingURL != "" {
+		token.PollingURL = pollingURL
+		token.Strategy = pollerStrategyAsyncOperation
+	} else if pollingURL := resp.Header.Get("Location"); isLongRunningStatus && pollingURL != "" {
+		token.PollingURL = pollingURL
+		token.Strategy = pollerStrategyLocation
+	} else if resp.Request.Method == http.MethodDelete {
+		token.PollingURL = token.RequestURL
+		token.Strategy = pollerStrategyDelete
+	} else {
+		token.PollingURL = token.RequestURL
+		token.Strategy = pollerStrategyProvisioningState
+	}
+
+	contents, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("marshaling the resume token for %%q: %%+v", operationName, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(contents), nil
+}
+
+// responseForPollerResumeToken parses the resume token for the specified Operation and returns a Response matching
+// the initial response for the Long Running Operation, from which a Poller can be built using 'resourcemanager.PollerFromResponse'.
+func responseForPollerResumeToken(ctx context.Context, operationName, httpMethod, input string) (*http.Response, error) {
+	contents, err := base64.RawURLEncoding.DecodeString(input)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the resume token: %%+v", err)
+	}
+	var token pollerResumeToken
+	if err := json.Unmarshal(contents, &token); err != nil {
+		return nil, fmt.Errorf("unmarshaling the resume token: %%+v", err)
+	}
+	if token.OperationName != operationName {
+		return nil, fmt.Errorf("the resume token is for the Long Running Operation %%q but was used to resume %%q", token.OperationName, operationName)
+	}
+	if _, err := url.Parse(token.PollingURL); err != nil {
+		return nil, fmt.Errorf("parsing the polling URL %%q: %%+v", token.PollingURL, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpMethod, token.RequestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building the request for %%q: %%+v", token.RequestURL, err)
+	}
+	resp := &http.Response{
+		Body:    http.NoBody,
+		Header:  http.Header{},
+		Request: req,
+	}
+	switch token.Strategy {
+	case pollerStrategyAsyncOperation:
+		resp.StatusCode = http.StatusAccepted
+		resp.Header.Set("Azure-AsyncOperation", token.PollingURL)
+
+	case pollerStrategyLocation:
+		resp.StatusCode = http.StatusAccepted
+		resp.Header.Set("Location", token.PollingURL)
+
+	// 'resourcemanager.PollerFromResponse' treats a 201/202 containing a polling header as a Long Running Operation,
+	// so the other strategies use a 200 without one - which is the response polled for a Delete or Provisioning State
+	case pollerStrategyDelete:
+		// the resource itself is polled, so there's nothing further to specify
+		resp.StatusCode = http.StatusOK
+
+	case pollerStrategyProvisioningState:
+		resp.StatusCode = http.StatusOK
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Body = io.NopCloser(strings.NewReader("{}"))
+
+	default:
+		return nil, fmt.Errorf("the resume token contains an unsupported polling strategy %%q", token.Strategy)
+	}
+
+	return resp, nil
+}
+`, data.packageName, *copyrightLines, "`json:\"operationName\"`", "`json:\"pollingUrl\"`", "`json:\"requestUrl\"`", "`json:\"strategy\"`")
+	return &template, nil
+}
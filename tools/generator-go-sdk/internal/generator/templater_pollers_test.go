@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestTemplatePollerResumeTokens(t *testing.T) {
+	input := ServiceGeneratorData{
+		packageName: "somepackage",
+		source:      AccTestLicenceType,
+	}
+
+	actual, err := pollerResumeTokenTemplater{}.template(input)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := fmt.Sprintf(`package somepackage
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// acctests licence placeholder
+
+const (
+	// pollerStrategyAsyncOperation polls the URL returned in the 'Azure-AsyncOperation' header
+	pollerStrategyAsyncOperation = "AsyncOperation"
+
+	// pollerStrategyLocation polls the URL returned in the 'Location' header
+	pollerStrategyLocation = "Location"
+
+	// pollerStrategyDelete polls the resource until it's been deleted
+	pollerStrategyDelete = "Delete"
+
+	// pollerStrategyProvisioningState polls the resource until it's in a terminal Provisioning State
+	pollerStrategyProvisioningState = "ProvisioningState"
+)
+
+// pollerResumeToken contains the details required to resume polling a Long Running Operation, which is
+// serialized into the (opaque) token returned from the 'ResumeToken' function on the Operation Response.
+type pollerResumeToken struct {
+	// OperationName is the name of the Operation which started the Long Running Operation, e.g. 'CreateOrUpdate'
+	OperationName string %[1]s
+
+	// PollingURL is the URL which is polled to determine the status of the Long Running Operation
+	PollingURL string %[2]s
+
+	// RequestURL is the URL of the request which started the Long Running Operation
+	RequestURL string %[3]s
+
+	// Strategy is the polling strategy (one of the 'pollerStrategy' constants) used to poll the PollingURL
+	Strategy string %[4]s
+}
+
+// newPollerResumeToken returns a resume token for the Long Running Operation started by the specified Operation,
+// determining the polling strategy from the initial response in the same manner as 'resourcemanager.PollerFromResponse'.
+func newPollerResumeToken(operationName string, resp *http.Response) (string, error) {
+	if resp == nil || resp.Request == nil || resp.Request.URL == nil {
+		return "", fmt.Errorf("the Long Running Operation %%q hasn't been started", operationName)
+	}
+
+	token := pollerResumeToken{
+		OperationName: operationName,
+		RequestURL:    resp.Request.URL.String(),
+	}
+	// the polling headers are only used when the API has accepted the Long Running Operation
+	isLongRunningStatus := resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted
+	if pollingURL := resp.Header.Get("Azure-AsyncOperation"); isLongRunningStatus && pollingURL != "" {
+		token.PollingURL = pollingURL
+		token.Strategy = pollerStrategyAsyncOperation
+	} else if pollingURL := resp.Header.Get("Location"); isLongRunningStatus && pollingURL != "" {
+		token.PollingURL = pollingURL
+		token.Strategy = pollerStrategyLocation
+	} else if resp.Request.Method == http.MethodDelete {
+		token.PollingURL = token.RequestURL
+		token.Strategy = pollerStrategyDelete
+	} else {
+		token.PollingURL = token.RequestURL
+		token.Strategy = pollerStrategyProvisioningState
+	}
+
+	contents, err := json.Marshal(token)
+	if err != nil {
+		return "", fmt.Errorf("marshaling the resume token for %%q: %%+v", operationName, err)
+	}
+	return base64.RawURLEncoding.EncodeToString(contents), nil
+}
+
+// responseForPollerResumeToken parses the resume token for the specified Operation and returns a Response matching
+// the initial response for the Long Running Operation, from which a Poller can be built using 'resourcemanager.PollerFromResponse'.
+func responseForPollerResumeToken(ctx context.Context, operationName, httpMethod, input string) (*http.Response, error) {
+	contents, err := base64.RawURLEncoding.DecodeString(input)
+	if err != nil {
+		return nil, fmt.Errorf("decoding the resume token: %%+v", err)
+	}
+	var token pollerResumeToken
+	if err := json.Unmarshal(contents, &token); err != nil {
+		return nil, fmt.Errorf("unmarshaling the resume token: %%+v", err)
+	}
+	if token.OperationName != operationName {
+		return nil, fmt.Errorf("the resume token is for the Long Running Operation %%q but was used to resume %%q", token.OperationName, operationName)
+	}
+	if _, err := url.Parse(token.PollingURL); err != nil {
+		return nil, fmt.Errorf("parsing the polling URL %%q: %%+v", token.PollingURL, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, httpMethod, token.RequestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building the request for %%q: %%+v", token.RequestURL, err)
+	}
+	resp := &http.Response{
+		Body:    http.NoBody,
+		Header:  http.Header{},
+		Request: req,
+	}
+	switch token.Strategy {
+	case pollerStrategyAsyncOperation:
+		resp.StatusCode = http.StatusAccepted
+		resp.Header.Set("Azure-AsyncOperation", token.PollingURL)
+
+	case pollerStrategyLocation:
+		resp.StatusCode = http.StatusAccepted
+		resp.Header.Set("Location", token.PollingURL)
+
+	// 'resourcemanager.PollerFromResponse' treats a 201/202 containing a polling header as a Long Running Operation,
+	// so the other strategies use a 200 without one - which is the response polled for a Delete or Provisioning State
+	case pollerStrategyDelete:
+		// the resource itself is polled, so there's nothing further to specify
+		resp.StatusCode = http.StatusOK
+
+	case pollerStrategyProvisioningState:
+		resp.StatusCode = http.StatusOK
+		resp.Header.Set("Content-Type", "application/json")
+		resp.Body = io.NopCloser(strings.NewReader("{}"))
+
+	default:
+		return nil, fmt.Errorf("the resume token contains an unsupported polling strategy %%q", token.Strategy)
+	}
+
+	return resp, nil
+}
+`, "`json:\"operationName\"`", "`json:\"pollingUrl\"`", "`json:\"requestUrl\"`", "`json:\"strategy\"`")
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
+
+func TestPollerResumeTokens_Behaviour(t *testing.T) {
+	// the generated code only depends on the Standard Library, so can be compiled and tested in a temporary module
+	goBinary, err := exec.LookPath("go")
+	if err != nil {
+		t.Skipf("skipping since the Go binary wasn't found: %+v", err)
+	}
+
+	input := ServiceGeneratorData{
+		packageName: "somepackage",
+		source:      AccTestLicenceType,
+	}
+	templated, err := pollerResumeTokenTemplater{}.template(input)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	formatted, err := formatGoCode("pollers.go", *templated)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	directory := t.TempDir()
+	files := map[string]string{
+		"go.mod":          "module example.com/somepackage\n\ngo 1.21\n",
+		"pollers.go":      *formatted,
+		"pollers_test.go": pollerResumeTokenBehaviourTests,
+	}
+	for fileName, contents := range files {
+		if err := os.WriteFile(filepath.Join(directory, fileName), []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", fileName, err)
+		}
+	}
+
+	cmd := exec.Command(goBinary, "test", "./...")
+	cmd.Dir = directory
+	cmd.Env = append(os.Environ(), "GOFLAGS=-mod=mod", "GOPROXY=off", "GOWORK=off")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("running the tests for the generated code: %+v\n%s", err, string(output))
+	}
+}
+
+// pollerResumeTokenBehaviourTests are the tests run against the generated code by TestPollerResumeTokens_Behaviour.
+const pollerResumeTokenBehaviourTests = `package somepackage
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const requestURL = "https://management.example.com/subscriptions/1/things/example?api-version=2020-01-01"
+
+func TestResumeToken_RoundTrip(t *testing.T) {
+	testData := []struct {
+		name               string
+		method             string
+		statusCode         int
+		headers            map[string]string
+		expectedStrategy   string
+		expectedStatusCode int
+		expectedHeaders    map[string]string
+		expectedBody       string
+	}{
+		{
+			name:               "AsyncOperation",
+			method:             http.MethodPut,
+			statusCode:         http.StatusCreated,
+			headers:            map[string]string{"Azure-AsyncOperation": "https://management.example.com/operations/1"},
+			expectedStrategy:   pollerStrategyAsyncOperation,
+			expectedStatusCode: http.StatusAccepted,
+			expectedHeaders:    map[string]string{"Azure-AsyncOperation": "https://management.example.com/operations/1"},
+		},
+		{
+			name:               "Location",
+			method:             http.MethodPost,
+			statusCode:         http.StatusAccepted,
+			headers:            map[string]string{"Location": "https://management.example.com/operationResults/1"},
+			expectedStrategy:   pollerStrategyLocation,
+			expectedStatusCode: http.StatusAccepted,
+			expectedHeaders:    map[string]string{"Location": "https://management.example.com/operationResults/1"},
+		},
+		{
+			name:               "Delete",
+			method:             http.MethodDelete,
+			statusCode:         http.StatusAccepted,
+			expectedStrategy:   pollerStrategyDelete,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{},
+		},
+		{
+			name:               "ProvisioningState",
+			method:             http.MethodPut,
+			statusCode:         http.StatusCreated,
+			expectedStrategy:   pollerStrategyProvisioningState,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"Content-Type": "application/json"},
+			expectedBody:       "{}",
+		},
+		{
+			// the polling headers are only used when the Long Running Operation has been accepted (a 201/202)
+			name:               "ProvisioningStateIgnoringLocation",
+			method:             http.MethodPatch,
+			statusCode:         http.StatusOK,
+			headers:            map[string]string{"Location": "https://management.example.com/operationResults/1"},
+			expectedStrategy:   pollerStrategyProvisioningState,
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders:    map[string]string{"Content-Type": "application/json"},
+			expectedBody:       "{}",
+		},
+	}
+	for _, v := range testData {
+		t.Run(v.name, func(t *testing.T) {
+			req, err := http.NewRequest(v.method, requestURL, nil)
+			if err != nil {
+				t.Fatalf("building the request: %+v", err)
+			}
+			initial := &http.Response{
+				Header:     http.Header{},
+				Request:    req,
+				StatusCode: v.statusCode,
+			}
+			for key, value := range v.headers {
+				initial.Header.Set(key, value)
+			}
+
+			token, err := newPollerResumeToken("CreateOrUpdate", initial)
+			if err != nil {
+				t.Fatalf("building the resume token: %+v", err)
+			}
+			resp, err := responseForPollerResumeToken(context.Background(), "CreateOrUpdate", v.method, token)
+			if err != nil {
+				t.Fatalf("parsing the resume token: %+v", err)
+			}
+
+			if resp.Request.Method != v.method || resp.Request.URL.String() != requestURL {
+				t.Fatalf("expected the request to be %s %q but got %s %q", v.method, requestURL, resp.Request.Method, resp.Request.URL.String())
+			}
+			if resp.StatusCode != v.expectedStatusCode {
+				t.Fatalf("expected the status code to be %d but got %d", v.expectedStatusCode, resp.StatusCode)
+			}
+			if len(resp.Header) != len(v.expectedHeaders) {
+				t.Fatalf("expected the headers %+v but got %+v", v.expectedHeaders, resp.Header)
+			}
+			for key, value := range v.expectedHeaders {
+				if resp.Header.Get(key) != value {
+					t.Fatalf("expected the header %q to be %q but got %q", key, value, resp.Header.Get(key))
+				}
+			}
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("reading the body: %+v", err)
+			}
+			if string(body) != v.expectedBody {
+				t.Fatalf("expected the body to be %q but got %q", v.expectedBody, string(body))
+			}
+			if actual := strategyFromPollerFromResponse(resp); actual != v.expectedStrategy {
+				t.Fatalf("expected the Poller to use the strategy %q but got %q", v.expectedStrategy, actual)
+			}
+		})
+	}
+}
+
+func TestResumeToken_NotStarted(t *testing.T) {
+	_, err := newPollerResumeToken("CreateOrUpdate", nil)
+	assertErrorContains(t, err, "hasn't been started")
+}
+
+func TestResumeToken_DifferentOperation(t *testing.T) {
+	req, err := http.NewRequest(http.MethodPut, requestURL, nil)
+	if err != nil {
+		t.Fatalf("building the request: %+v", err)
+	}
+	token, err := newPollerResumeToken("CreateOrUpdate", &http.Response{
+		Header:     http.Header{},
+		Request:    req,
+		StatusCode: http.StatusCreated,
+	})
+	if err != nil {
+		t.Fatalf("building the resume token: %+v", err)
+	}
+
+	_, err = responseForPollerResumeToken(context.Background(), "Update", http.MethodPatch, token)
+	assertErrorContains(t, err, "is for the Long Running Operation \"CreateOrUpdate\" but was used to resume \"Update\"")
+}
+
+func TestResumeToken_InvalidEncoding(t *testing.T) {
+	_, err := responseForPollerResumeToken(context.Background(), "CreateOrUpdate", http.MethodPut, "not a resume token!")
+	assertErrorContains(t, err, "decoding the resume token")
+}
+
+func TestResumeToken_UnknownStrategy(t *testing.T) {
+	token := base64.RawURLEncoding.EncodeToString([]byte("{\"operationName\": \"CreateOrUpdate\", \"pollingUrl\": \"https://management.example.com\", \"requestUrl\": \"https://management.example.com\", \"strategy\": \"Magic\"}"))
+	_, err := responseForPollerResumeToken(context.Background(), "CreateOrUpdate", http.MethodPut, token)
+	assertErrorContains(t, err, "unsupported polling strategy \"Magic\"")
+}
+
+// strategyFromPollerFromResponse returns the polling strategy which 'resourcemanager.PollerFromResponse' within
+// 'hashicorp/go-azure-sdk' selects for the response - which checks for a Long Running Operation (a 201/202 with a
+// polling header), then the Provisioning State (a 200/201 for a PATCH/POST/PUT returning JSON) and then a Delete.
+func strategyFromPollerFromResponse(resp *http.Response) string {
+	if resp.StatusCode == http.StatusCreated || resp.StatusCode == http.StatusAccepted {
+		if resp.Header.Get("Azure-AsyncOperation") != "" {
+			return pollerStrategyAsyncOperation
+		}
+		if resp.Header.Get("Location") != "" {
+			return pollerStrategyLocation
+		}
+	}
+
+	method := resp.Request.Method
+	isProvisioningStateMethod := method == http.MethodPatch || method == http.MethodPost || method == http.MethodPut
+	isProvisioningStateStatus := resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated
+	if isProvisioningStateMethod && isProvisioningStateStatus && strings.Contains(resp.Header.Get("Content-Type"), "application/json") {
+		return pollerStrategyProvisioningState
+	}
+
+	isDeleteStatus := resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusNoContent
+	if method == http.MethodDelete && isDeleteStatus {
+		return pollerStrategyDelete
+	}
+
+	return ""
+}
+
+func assertErrorContains(t *testing.T, err error, expected string) {
+	if err == nil {
+		t.Fatalf("expected an error containing %q but didn't get one", expected)
+	}
+	if !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected the error to contain %q but got: %+v", expected, err)
+	}
+}
+`
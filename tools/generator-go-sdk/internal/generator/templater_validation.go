@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+var _ templaterForResource = validationTemplater{}
+
+// validationTemplater outputs a `Validate` function for each Model within this Resource, which checks
+// that the Required fields are specified and that any Constants contain one of the possible values - and
+// (when validating the Request Body for an Operation) that ReadOnly fields aren't specified.
+type validationTemplater struct {
+}
+
+func (t validationTemplater) template(data ServiceGeneratorData) (*string, error) {
+	copyrightLines, err := copyrightLinesForSource(data.source)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving copyright lines: %+v", err)
+	}
+
+	modelNames := make([]string, 0)
+	for modelName := range data.models {
+		modelNames = append(modelNames, modelName)
+	}
+	sort.Strings(modelNames)
+
+	functions := make([]string, 0)
+	for _, modelName := range modelNames {
+		model := data.models[modelName]
+
+		// Discriminated Parent Types are output as an interface, so the implementation is validated instead
+		if model.IsDiscriminatedParentType() {
+			continue
+		}
+
+		code, err := t.functionsForModel(modelName, model, data)
+		if err != nil {
+			return nil, fmt.Errorf("generating validation functions for model %q: %+v", modelName, err)
+		}
+		functions = append(functions, *code)
+	}
+
+	template := fmt.Sprintf(`package %[1]s
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+%[2]s
+
+// validatable is implemented by each Model, allowing nested Models (including the implementations
+// of Discriminated Types, which are exposed as an interface) to be validated - where isRequest specifies
+// whether this is the Request Body for an Operation, in which case ReadOnly fields can't be specified.
+type validatable interface {
+	validate(prefix string, isRequest bool) []error
+}
+%[3]s
+`, data.packageName, *copyrightLines, strings.Join(functions, "\n"))
+	return &template, nil
+}
+
+func (t validationTemplater) functionsForModel(modelName string, model models.SDKModel, data ServiceGeneratorData) (*string, error) {
+	// as with the struct, the fields inherited from the Parent are output alongside the fields for this Model
+	fields := make(map[string]models.SDKField)
+	if model.ParentTypeName != nil {
+		parent, ok := data.models[*model.ParentTypeName]
+		if !ok {
+			return nil, fmt.Errorf("couldn't find Parent Model %q for Model %q", *model.ParentTypeName, modelName)
+		}
+		for fieldName, fieldDetails := range parent.Fields {
+			fields[fieldName] = fieldDetails
+		}
+	}
+	for fieldName, fieldDetails := range model.Fields {
+		fields[fieldName] = fieldDetails
+	}
+	if model.FieldNameContainingDiscriminatedValue != nil {
+		// this isn't user configurable (and is hard-coded) so there's nothing to validate
+		delete(fields, *model.FieldNameContainingDiscriminatedValue)
+	}
+
+	fieldNames := make([]string, 0)
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	lines := make([]string, 0)
+	for _, fieldName := range fieldNames {
+		fieldLines, err := t.linesForField(fieldName, fields[fieldName], data)
+		if err != nil {
+			return nil, fmt.Errorf("generating validation for field %q: %+v", fieldName, err)
+		}
+		if len(fieldLines) > 0 {
+			lines = append(lines, strings.Join(fieldLines, "\n"))
+		}
+	}
+
+	body := "return nil"
+	if len(lines) > 0 {
+		body = fmt.Sprintf(`errs := make([]error, 0)
+
+%s
+
+	return errs`, strings.Join(lines, "\n\n"))
+	}
+
+	output := fmt.Sprintf(`
+// Validate validates %[1]s, returning an error describing each of the fields which are invalid.
+func (s %[1]s) Validate() error {
+	return errors.Join(s.validate("", false)...)
+}
+
+func (s %[1]s) validate(prefix string, isRequest bool) []error {
+	%[2]s
+}
+`, modelName, body)
+	return &output, nil
+}
+
+func (t validationTemplater) linesForField(fieldName string, fieldDetails models.SDKField, data ServiceGeneratorData) ([]string, error) {
+	path := fmt.Sprintf("prefix+%q", fieldDetails.JsonName)
+	isPointer := fieldIsOutputAsPointer(fieldDetails, data)
+
+	lines := make([]string, 0)
+	if fieldDetails.Required && !fieldDetails.ReadOnly && (isPointer || t.objectDefinitionIsNilable(fieldDetails.ObjectDefinition, data)) {
+		lines = append(lines, fmt.Sprintf(`	if s.%[1]s == nil {
+		errs = append(errs, fmt.Errorf("%%s: this field is required but was not specified", %[2]s))
+	}`, fieldName, path))
+	}
+	if fieldDetails.ReadOnly {
+		lines = append(lines, fmt.Sprintf(`	if isRequest && s.%[1]s != nil {
+		errs = append(errs, fmt.Errorf("%%s: this field is read-only and cannot be specified in a request", %[2]s))
+	}`, fieldName, path))
+	}
+
+	value := fmt.Sprintf("s.%s", fieldName)
+	if isPointer {
+		value = fmt.Sprintf("*s.%s", fieldName)
+	}
+	valueLines, err := t.linesForValue(fieldDetails.ObjectDefinition, value, path, 0, data)
+	if err != nil {
+		return nil, err
+	}
+	if len(valueLines) > 0 {
+		if isPointer {
+			lines = append(lines, fmt.Sprintf(`	if s.%[1]s != nil {
+%[2]s
+	}`, fieldName, strings.Join(valueLines, "\n")))
+		} else {
+			lines = append(lines, valueLines...)
+		}
+	}
+
+	return lines, nil
+}
+
+// linesForValue returns the lines required to validate the value (a Go expression) for the specified Object Definition,
+// where path is a Go expression for the JSON path to this value. This returns no lines when there's nothing to validate.
+func (t validationTemplater) linesForValue(objectDefinition models.SDKObjectDefinition, value, path string, depth int, data ServiceGeneratorData) ([]string, error) {
+	switch objectDefinition.Type {
+	case models.DictionarySDKObjectDefinitionType, models.ListSDKObjectDefinitionType:
+		if objectDefinition.NestedItem == nil {
+			return nil, fmt.Errorf("%s Object Definition had no Nested Item", string(objectDefinition.Type))
+		}
+		itemValue := fmt.Sprintf("item%d", depth)
+		itemPath := fmt.Sprintf("itemPath%d", depth)
+		nestedLines, err := t.linesForValue(*objectDefinition.NestedItem, itemValue, itemPath, depth+1, data)
+		if err != nil {
+			return nil, err
+		}
+		if len(nestedLines) == 0 {
+			return nil, nil
+		}
+
+		key := fmt.Sprintf("i%d", depth)
+		pathFormat := "%s[%d]"
+		if objectDefinition.Type == models.DictionarySDKObjectDefinitionType {
+			key = fmt.Sprintf("key%d", depth)
+			pathFormat = "%s[%q]"
+		}
+		return []string{fmt.Sprintf(`	for %[1]s, %[2]s := range %[3]s {
+		%[4]s := fmt.Sprintf("%[5]s", %[6]s, %[1]s)
+%[7]s
+	}`, key, itemValue, value, itemPath, pathFormat, path, strings.Join(nestedLines, "\n"))}, nil
+
+	case models.ReferenceSDKObjectDefinitionType:
+		referenceName := *objectDefinition.ReferenceName
+
+		if constant, ok := data.constants[referenceName]; ok {
+			constantType := constantTemplater{name: referenceName, details: constant}.mapToGoType()
+			valueFormat := "%v"
+			if constant.Type == models.StringSDKConstantType {
+				valueFormat = "%q"
+			}
+			return []string{fmt.Sprintf(`	if !slices.Contains(PossibleValuesFor%[1]s(), %[2]s(%[3]s)) {
+		errs = append(errs, fmt.Errorf("%%s: %[5]s is not a valid value for %[1]s, expected one of %%v", %[4]s, %[3]s, PossibleValuesFor%[1]s()))
+	}`, referenceName, constantType, value, path, valueFormat)}, nil
+		}
+
+		if model, ok := data.models[referenceName]; ok {
+			nestedPrefix := t.pathWithSuffix(path, ".")
+			if model.IsDiscriminatedParentType() {
+				// the Raw{Name}Impl type used for unknown implementations doesn't implement validatable, so is skipped
+				if strings.HasPrefix(value, "*") {
+					value = fmt.Sprintf("(%s)", value)
+				}
+				return []string{fmt.Sprintf(`	if impl, ok := %[1]s.(validatable); ok {
+		errs = append(errs, impl.validate(%[2]s, isRequest)...)
+	}`, value, nestedPrefix)}, nil
+			}
+
+			// methods can be called on the pointer directly
+			return []string{fmt.Sprintf(`	errs = append(errs, %[1]s.validate(%[2]s, isRequest)...)`, strings.TrimPrefix(value, "*"), nestedPrefix)}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// objectDefinitionIsNilable returns whether the Go type for this Object Definition can be nil, meaning
+// that a Required field of this type can be checked for its presence.
+func (t validationTemplater) objectDefinitionIsNilable(objectDefinition models.SDKObjectDefinition, data ServiceGeneratorData) bool {
+	switch objectDefinition.Type {
+	case models.DictionarySDKObjectDefinitionType, models.ListSDKObjectDefinitionType, models.RawFileSDKObjectDefinitionType,
+		models.RawObjectSDKObjectDefinitionType, models.TagsSDKObjectDefinitionType, models.ZonesSDKObjectDefinitionType:
+		return true
+
+	case models.ReferenceSDKObjectDefinitionType:
+		// Discriminated Parent Types are output as an interface
+		model, ok := data.models[*objectDefinition.ReferenceName]
+		return ok && model.IsDiscriminatedParentType()
+	}
+
+	return false
+}
+
+// pathWithSuffix appends the suffix to the Go expression for the path, combining this into the string literal
+// when the expression ends with one.
+func (t validationTemplater) pathWithSuffix(path, suffix string) string {
+	if strings.HasSuffix(path, `"`) {
+		return fmt.Sprintf(`%s%s"`, strings.TrimSuffix(path, `"`), suffix)
+	}
+	return fmt.Sprintf("%s+%q", path, suffix)
+}
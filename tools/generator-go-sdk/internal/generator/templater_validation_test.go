@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+)
+
+func TestTemplateValidation(t *testing.T) {
+	input := ServiceGeneratorData{
+		packageName: "somepackage",
+		constants: map[string]models.SDKConstant{
+			"ExampleKind": {
+				Type: models.StringSDKConstantType,
+				Values: map[string]string{
+					"First":  "first",
+					"Second": "second",
+				},
+			},
+		},
+		models: map[string]models.SDKModel{
+			"Example": {
+				Fields: map[string]models.SDKField{
+					"Id": {
+						JsonName: "id",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Optional: true,
+						ReadOnly: true,
+					},
+					"Kind": {
+						JsonName: "kind",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type:          models.ReferenceSDKObjectDefinitionType,
+							ReferenceName: stringPointer("ExampleKind"),
+						},
+						Required: true,
+					},
+					"Name": {
+						JsonName: "name",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+					"Properties": {
+						JsonName: "properties",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type:          models.ReferenceSDKObjectDefinitionType,
+							ReferenceName: stringPointer("ExampleProperties"),
+						},
+						Optional: true,
+					},
+					"Transit": {
+						JsonName: "transit",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.ListSDKObjectDefinitionType,
+							NestedItem: &models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: stringPointer("ModeOfTransit"),
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+			"ExampleProperties": {
+				Fields: map[string]models.SDKField{
+					"Kinds": {
+						JsonName: "kinds",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.DictionarySDKObjectDefinitionType,
+							NestedItem: &models.SDKObjectDefinition{
+								Type:          models.ReferenceSDKObjectDefinitionType,
+								ReferenceName: stringPointer("ExampleKind"),
+							},
+						},
+						Optional: true,
+					},
+				},
+			},
+			"ModeOfTransit": {
+				FieldNameContainingDiscriminatedValue: stringPointer("Type"),
+				Fields: map[string]models.SDKField{
+					"Type": {
+						ContainsDiscriminatedValue: true,
+						JsonName:                   "type",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.StringSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+			"Car": {
+				DiscriminatedValue:                    stringPointer("car"),
+				FieldNameContainingDiscriminatedValue: stringPointer("Type"),
+				ParentTypeName:                        stringPointer("ModeOfTransit"),
+				Fields: map[string]models.SDKField{
+					"Wheels": {
+						JsonName: "wheels",
+						ObjectDefinition: models.SDKObjectDefinition{
+							Type: models.IntegerSDKObjectDefinitionType,
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		// the public Validate function is request-agnostic, even for Models used as the Request Object
+		operations: map[string]models.SDKOperation{
+			"CreateOrUpdate": {
+				Method: "PUT",
+				RequestObject: &models.SDKObjectDefinition{
+					Type:          models.ReferenceSDKObjectDefinitionType,
+					ReferenceName: stringPointer("Example"),
+				},
+			},
+		},
+		source: AccTestLicenceType,
+	}
+
+	actual, err := validationTemplater{}.template(input)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	expected := `package somepackage
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// acctests licence placeholder
+
+// validatable is implemented by each Model, allowing nested Models (including the implementations
+// of Discriminated Types, which are exposed as an interface) to be validated - where isRequest specifies
+// whether this is the Request Body for an Operation, in which case ReadOnly fields can't be specified.
+type validatable interface {
+	validate(prefix string, isRequest bool) []error
+}
+
+// Validate validates Car, returning an error describing each of the fields which are invalid.
+func (s Car) Validate() error {
+	return errors.Join(s.validate("", false)...)
+}
+
+func (s Car) validate(prefix string, isRequest bool) []error {
+	return nil
+}
+
+// Validate validates Example, returning an error describing each of the fields which are invalid.
+func (s Example) Validate() error {
+	return errors.Join(s.validate("", false)...)
+}
+
+func (s Example) validate(prefix string, isRequest bool) []error {
+	errs := make([]error, 0)
+
+	if isRequest && s.Id != nil {
+		errs = append(errs, fmt.Errorf("%s: this field is read-only and cannot be specified in a request", prefix+"id"))
+	}
+
+	if !slices.Contains(PossibleValuesForExampleKind(), string(s.Kind)) {
+		errs = append(errs, fmt.Errorf("%s: %q is not a valid value for ExampleKind, expected one of %v", prefix+"kind", s.Kind, PossibleValuesForExampleKind()))
+	}
+
+	if s.Properties != nil {
+		errs = append(errs, s.Properties.validate(prefix+"properties.", isRequest)...)
+	}
+
+	if s.Transit == nil {
+		errs = append(errs, fmt.Errorf("%s: this field is required but was not specified", prefix+"transit"))
+	}
+	for i0, item0 := range s.Transit {
+		itemPath0 := fmt.Sprintf("%s[%d]", prefix+"transit", i0)
+		if impl, ok := item0.(validatable); ok {
+			errs = append(errs, impl.validate(itemPath0+".", isRequest)...)
+		}
+	}
+
+	return errs
+}
+
+// Validate validates ExampleProperties, returning an error describing each of the fields which are invalid.
+func (s ExampleProperties) Validate() error {
+	return errors.Join(s.validate("", false)...)
+}
+
+func (s ExampleProperties) validate(prefix string, isRequest bool) []error {
+	errs := make([]error, 0)
+
+	if s.Kinds != nil {
+		for key0, item0 := range *s.Kinds {
+			itemPath0 := fmt.Sprintf("%s[%q]", prefix+"kinds", key0)
+			if !slices.Contains(PossibleValuesForExampleKind(), string(item0)) {
+				errs = append(errs, fmt.Errorf("%s: %q is not a valid value for ExampleKind, expected one of %v", itemPath0, item0, PossibleValuesForExampleKind()))
+			}
+		}
+	}
+
+	return errs
+}
+`
+	assertTemplatedCodeMatches(t, expected, *actual)
+}
@@ -12,10 +12,13 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	v1 "github.com/hashicorp/pandora/tools/data-api-sdk/v1"
 	"github.com/hashicorp/pandora/tools/data-api-sdk/v1/models"
+	"github.com/hashicorp/pandora/tools/data-api/inprocess"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/generator"
 	"github.com/hashicorp/pandora/tools/generator-terraform/internal/logging"
+	"github.com/hashicorp/pandora/tools/sdk/generationcheck"
 	"github.com/mitchellh/cli"
 )
 
@@ -33,6 +36,8 @@ type GenerateCommand struct {
 	sourceDataType models.SourceDataType
 
 	apiServerEndpoint string
+	check             bool
+	dataDirectory     string
 	providerPrefix    string
 	outputDirectory   string
 	serviceNamesRaw   string
@@ -43,8 +48,14 @@ func (*GenerateCommand) Help() string {
 
 Flags:
 
+* '--check'
+  Generates into a temporary directory and outputs a diff of any generated files which differ
+  from those within '--output-dir', exiting with a non-zero exit code when these differ.
 * '--data-api=https://example.com'
   Specifies the path to the Data API.
+* '--data-directory=../../api-definitions'
+  Specifies the path to a directory containing the API Definitions, which are read directly
+  from disk rather than from the Data API (in which case '--data-api' is ignored).
 * '--output-dir=../generated-tf-dev'
   Specifies the path where the generated files should be output
 * '--services=Example1,Example2'
@@ -58,7 +69,9 @@ func (i *GenerateCommand) Run(args []string) int {
 	i.providerPrefix = "azurerm"
 
 	f := flag.NewFlagSet("generator-terraform", flag.ExitOnError)
+	f.BoolVar(&i.check, "check", false, "-check (generates into a temporary directory and outputs a diff against the output directory, exiting with a non-zero exit code when these differ)")
 	f.StringVar(&i.apiServerEndpoint, "data-api", "http://localhost:8080", "-data-api=http://localhost:8080")
+	f.StringVar(&i.dataDirectory, "data-directory", "", "-data-directory=../../api-definitions (reads the API Definitions from disk rather than from the Data API)")
 	f.StringVar(&i.outputDirectory, "output-dir", "", "-output-dir=../generated-tf-dev")
 	f.StringVar(&i.serviceNamesRaw, "services", "", "A list of comma separated Service named from the Data API to import")
 	if err := f.Parse(args); err != nil {
@@ -70,6 +83,18 @@ func (i *GenerateCommand) Run(args []string) int {
 		i.outputDirectory = filepath.Join(homeDir, "/Desktop/generated-tf-dev")
 	}
 
+	if i.check {
+		hasChanges, err := i.runCheck(ctx)
+		if err != nil {
+			log.Printf("error: %+v", err)
+			return 1
+		}
+		if *hasChanges {
+			return 1
+		}
+		return 0
+	}
+
 	if err := i.run(ctx); err != nil {
 		log.Printf("error: %+v", err)
 		return 1
@@ -78,21 +103,112 @@ func (i *GenerateCommand) Run(args []string) int {
 	return 0
 }
 
+// runCheck generates the Terraform Resources into a temporary directory and outputs a diff of any generated files
+// which differ from those within the output directory (without changing the output directory), returning whether
+// any files differ.
+func (i *GenerateCommand) runCheck(ctx context.Context) (*bool, error) {
+	existingDirectory := i.outputDirectory
+
+	tempDirectory, err := os.MkdirTemp("", "generator-terraform-check")
+	if err != nil {
+		return nil, fmt.Errorf("creating temporary directory: %+v", err)
+	}
+	defer os.RemoveAll(tempDirectory)
+
+	i.outputDirectory = tempDirectory
+	defer func() {
+		i.outputDirectory = existingDirectory
+	}()
+	if err := i.run(ctx); err != nil {
+		return nil, err
+	}
+
+	// the generated files live alongside hand-written files - as such only the generated files (`*_gen.go` and
+	// `*_gen_test.go`) within the Services which have been generated are checked for removed files
+	directories := []string{
+		"internal/clients",
+		"internal/provider",
+	}
+	serviceDirectories, err := os.ReadDir(filepath.Join(tempDirectory, "internal", "services"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("reading the generated Services: %+v", err)
+	}
+	for _, entry := range serviceDirectories {
+		if entry.IsDir() {
+			directories = append(directories, fmt.Sprintf("internal/services/%s", entry.Name()))
+		}
+	}
+
+	// the documentation for every Resource (including hand-written Resources) is output into the same directory,
+	// so only the documentation for the Resources which have been generated is checked for removed files
+	resourceLabels, err := generatedResourceLabels(existingDirectory, directories)
+	if err != nil {
+		return nil, fmt.Errorf("determining the generated Resources within %q: %+v", existingDirectory, err)
+	}
+	directories = append(directories, "website/docs/r")
+
+	changes, err := generationcheck.CompareDirectories(existingDirectory, tempDirectory, generationcheck.Options{
+		Directories: directories,
+		IsGeneratedFile: func(path string) bool {
+			if fileName, ok := strings.CutPrefix(path, "website/docs/r/"); ok {
+				_, isGenerated := resourceLabels[strings.TrimSuffix(fileName, ".html.markdown")]
+				return isGenerated && strings.HasSuffix(fileName, ".html.markdown")
+			}
+			return strings.HasSuffix(path, "_gen.go") || strings.HasSuffix(path, "_gen_test.go")
+		},
+		IsScaffoldingFile: func(path string) bool {
+			// the manual registration for each Service is only output when it doesn't exist
+			return strings.HasPrefix(path, "internal/services/") && strings.HasSuffix(path, "/registration.go")
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("comparing %q to the generated files: %+v", existingDirectory, err)
+	}
+
+	for _, change := range changes {
+		fmt.Print(change.Diff)
+	}
+	if len(changes) > 0 {
+		logging.Log.Warn(fmt.Sprintf("%s within %q", generationcheck.Summary(changes), existingDirectory))
+	} else {
+		logging.Log.Info(fmt.Sprintf("No changes - %q is up-to-date", existingDirectory))
+	}
+
+	return pointer.To(len(changes) > 0), nil
+}
+
+// generatedResourceLabels returns the labels of the Terraform Resources which have been generated within the
+// specified directories of the existing directory - which are determined from the `<label>_resource_gen.go` files.
+func generatedResourceLabels(existingDirectory string, directories []string) (map[string]struct{}, error) {
+	output := make(map[string]struct{})
+	for _, directory := range directories {
+		entries, err := os.ReadDir(filepath.Join(existingDirectory, filepath.FromSlash(directory)))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("reading %q: %+v", directory, err)
+		}
+		for _, entry := range entries {
+			if resourceLabel, ok := strings.CutSuffix(entry.Name(), "_resource_gen.go"); ok && !entry.IsDir() {
+				output[resourceLabel] = struct{}{}
+			}
+		}
+	}
+	return output, nil
+}
+
 func (i *GenerateCommand) run(ctx context.Context) error {
 	// ensure the output directory exists
 	_ = os.MkdirAll(i.outputDirectory, 0755)
 
-	client := v1.NewClient(i.apiServerEndpoint, i.sourceDataType)
-	health, err := client.Health(ctx)
+	client, err := i.buildClient(ctx)
 	if err != nil {
-		return fmt.Errorf("checking if the Data API is available: %+v", err)
-	}
-	if !health.Available {
-		return fmt.Errorf("the Data API was not available")
+		return err
 	}
 
 	var servicesToLoad []string
-	logging.Log.Info("Loading API Definitions from the Data API..")
+	logging.Log.Info("Loading API Definitions..")
 	if i.serviceNamesRaw != "" {
 		servicesToLoad = strings.Split(i.serviceNamesRaw, ",")
 		logging.Log.Warn(fmt.Sprintf("Limiting the Services to [%s]..", i.serviceNamesRaw))
@@ -109,6 +225,29 @@ func (i *GenerateCommand) run(ctx context.Context) error {
 	return nil
 }
 
+// buildClient returns a Data API SDK client which either reads the API Definitions from the
+// directory specified in `--data-directory` - or from the Data API, once it's available.
+func (i *GenerateCommand) buildClient(ctx context.Context) (*v1.Client, error) {
+	if i.dataDirectory != "" {
+		client, err := inprocess.NewClientForDirectory(i.dataDirectory, i.sourceDataType)
+		if err != nil {
+			return nil, fmt.Errorf("building Data API client for the directory %q: %+v", i.dataDirectory, err)
+		}
+		return client, nil
+	}
+
+	client := v1.NewClient(i.apiServerEndpoint, i.sourceDataType)
+	health, err := client.Health(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("checking if the Data API is available: %+v", err)
+	}
+	if !health.Available {
+		return nil, fmt.Errorf("the Data API was not available")
+	}
+
+	return client, nil
+}
+
 func (*GenerateCommand) Synopsis() string {
 	return "Generates the Terraform Data Sources & Resources"
 }
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generationcheck
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+type ChangeType string
+
+const (
+	// AddedChangeType specifies that the file would be added by regenerating.
+	AddedChangeType ChangeType = "Added"
+
+	// ChangedChangeType specifies that the file would be changed by regenerating.
+	ChangedChangeType ChangeType = "Changed"
+
+	// RemovedChangeType specifies that the file would be removed by regenerating.
+	RemovedChangeType ChangeType = "Removed"
+)
+
+// FileChange describes a file which differs between the existing and the freshly generated files.
+type FileChange struct {
+	// Path is the path to this file, relative to the directories being compared, using forward slashes.
+	Path string
+
+	// Type specifies how this file would be changed by regenerating.
+	Type ChangeType
+
+	// Diff is a Unified Diff describing the change to this file.
+	Diff string
+}
+
+// Options configures which files are compared.
+type Options struct {
+	// Directories is a list of directories (relative to the directories being compared) which should be
+	// checked for removed files. When empty the entire existing directory is checked for removed files.
+	Directories []string
+
+	// IgnoreFile is an optional func which returns whether the file at the (relative) path should be ignored
+	// entirely, for example a file which isn't part of the generated code.
+	IgnoreFile func(path string) bool
+
+	// IsGeneratedFile is an optional func which returns whether the file at the (relative) path, which exists
+	// only in the existing directory, is output by the generator - and as such would be removed by regenerating.
+	// When not specified every file within Directories is assumed to be generated.
+	IsGeneratedFile func(path string) bool
+
+	// IsScaffoldingFile is an optional func which returns whether the file at the (relative) path is only output
+	// by the generator when it doesn't already exist (and so can be changed by hand) - as such any changes to
+	// these files are ignored when the file already exists.
+	IsScaffoldingFile func(path string) bool
+}
+
+// CompareDirectories compares the files within existingDirectory to the freshly generated files within
+// generatedDirectory, returning the files which would be added, changed or removed by regenerating - sorted by path.
+func CompareDirectories(existingDirectory, generatedDirectory string, options Options) ([]FileChange, error) {
+	generatedFiles, err := filesWithinDirectory(generatedDirectory, ".")
+	if err != nil {
+		return nil, fmt.Errorf("finding the generated files within %q: %+v", generatedDirectory, err)
+	}
+
+	directories := options.Directories
+	if len(directories) == 0 {
+		directories = []string{"."}
+	}
+	existingFiles := make(map[string]struct{})
+	for _, directory := range directories {
+		files, err := filesWithinDirectory(existingDirectory, directory)
+		if err != nil {
+			return nil, fmt.Errorf("finding the existing files within %q: %+v", filepath.Join(existingDirectory, directory), err)
+		}
+		for path := range files {
+			existingFiles[path] = struct{}{}
+		}
+	}
+
+	output := make([]FileChange, 0)
+	for path := range generatedFiles {
+		if options.IgnoreFile != nil && options.IgnoreFile(path) {
+			continue
+		}
+
+		generatedContents, err := os.ReadFile(filepath.Join(generatedDirectory, filepath.FromSlash(path)))
+		if err != nil {
+			return nil, fmt.Errorf("reading the generated file %q: %+v", path, err)
+		}
+
+		existingContents, err := os.ReadFile(filepath.Join(existingDirectory, filepath.FromSlash(path)))
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("reading the existing file %q: %+v", path, err)
+			}
+			output = append(output, FileChange{
+				Path: path,
+				Type: AddedChangeType,
+				Diff: UnifiedDiff("/dev/null", fmt.Sprintf("b/%s", path), "", string(generatedContents)),
+			})
+			continue
+		}
+
+		if options.IsScaffoldingFile != nil && options.IsScaffoldingFile(path) {
+			continue
+		}
+		if bytes.Equal(existingContents, generatedContents) {
+			continue
+		}
+		output = append(output, FileChange{
+			Path: path,
+			Type: ChangedChangeType,
+			Diff: UnifiedDiff(fmt.Sprintf("a/%s", path), fmt.Sprintf("b/%s", path), string(existingContents), string(generatedContents)),
+		})
+	}
+
+	for path := range existingFiles {
+		if _, ok := generatedFiles[path]; ok {
+			continue
+		}
+		if options.IgnoreFile != nil && options.IgnoreFile(path) {
+			continue
+		}
+		if options.IsGeneratedFile != nil && !options.IsGeneratedFile(path) {
+			continue
+		}
+
+		existingContents, err := os.ReadFile(filepath.Join(existingDirectory, filepath.FromSlash(path)))
+		if err != nil {
+			return nil, fmt.Errorf("reading the existing file %q: %+v", path, err)
+		}
+		output = append(output, FileChange{
+			Path: path,
+			Type: RemovedChangeType,
+			Diff: UnifiedDiff(fmt.Sprintf("a/%s", path), "/dev/null", string(existingContents), ""),
+		})
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].Path < output[j].Path
+	})
+	return output, nil
+}
+
+// Summary returns a summary of the number of files which would be added, changed and removed by regenerating.
+func Summary(changes []FileChange) string {
+	counts := make(map[ChangeType]int)
+	for _, change := range changes {
+		counts[change.Type]++
+	}
+	return fmt.Sprintf("%d files would be added, %d changed and %d removed by regenerating", counts[AddedChangeType], counts[ChangedChangeType], counts[RemovedChangeType])
+}
+
+// filesWithinDirectory returns the paths (relative to root, using forward slashes) to each file within
+// the directory, returning an empty map when the directory doesn't exist.
+func filesWithinDirectory(root, directory string) (map[string]struct{}, error) {
+	output := make(map[string]struct{})
+	err := filepath.WalkDir(filepath.Join(root, directory), func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return fmt.Errorf("determining the relative path for %q: %+v", path, err)
+		}
+		output[filepath.ToSlash(relativePath)] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
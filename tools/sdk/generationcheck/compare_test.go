@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generationcheck
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	from := "package example\n\nfunc a() {}\n\nfunc b() {}\n\nfunc c() {}\n"
+	to := "package example\n\nfunc a() {}\n\nfunc b(input string) {}\n\nfunc c() {}\n"
+	expected := strings.Join([]string{
+		"--- a/example.go",
+		"+++ b/example.go",
+		"@@ -2,6 +2,6 @@",
+		" ",
+		" func a() {}",
+		" ",
+		"-func b() {}",
+		"+func b(input string) {}",
+		" ",
+		" func c() {}",
+		"",
+	}, "\n")
+	actual := UnifiedDiff("a/example.go", "b/example.go", from, to)
+	if actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestUnifiedDiff_AddedFile(t *testing.T) {
+	expected := `--- /dev/null
++++ b/example.go
+@@ -0,0 +1,2 @@
++package example
++
+`
+	actual := UnifiedDiff("/dev/null", "b/example.go", "", "package example\n\n")
+	if actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
+
+func TestUnifiedDiff_MultipleHunks(t *testing.T) {
+	lines := make([]string, 0)
+	for i := 0; i < 20; i++ {
+		lines = append(lines, fmt.Sprintf("line %d", i))
+	}
+	from := strings.Join(lines, "\n") + "\n"
+	lines[1] = "first"
+	lines[18] = "second"
+	to := strings.Join(lines, "\n") + "\n"
+
+	actual := UnifiedDiff("a", "b", from, to)
+	for _, expected := range []string{"@@ -1,5 +1,5 @@\n", "@@ -16,5 +16,5 @@\n", "-line 1\n+first\n", "-line 18\n+second\n"} {
+		if !strings.Contains(actual, expected) {
+			t.Fatalf("expected the diff to contain %q but got:\n%s", expected, actual)
+		}
+	}
+}
+
+func TestCompareDirectories(t *testing.T) {
+	existing := t.TempDir()
+	generated := t.TempDir()
+	writeTestFiles(t, existing, map[string]string{
+		"services/compute/changed_gen.go":   "package compute\n\nvar a = 1\n",
+		"services/compute/unchanged_gen.go": "package compute\n",
+		"services/compute/removed_gen.go":   "package compute\n",
+		"services/compute/handwritten.go":   "package compute\n",
+		"services/compute/registration.go":  "package compute\n\n// changed by hand\n",
+		"services/network/other_gen.go":     "package network\n",
+	})
+	writeTestFiles(t, generated, map[string]string{
+		"services/compute/added_gen.go":     "package compute\n",
+		"services/compute/changed_gen.go":   "package compute\n\nvar a = 2\n",
+		"services/compute/unchanged_gen.go": "package compute\n",
+		"services/compute/registration.go":  "package compute\n",
+		"services/compute/.manifest":        "{}",
+	})
+
+	actual, err := CompareDirectories(existing, generated, Options{
+		// `network` isn't in scope, so shouldn't be removed
+		Directories: []string{"services/compute"},
+		IgnoreFile: func(path string) bool {
+			return strings.HasSuffix(path, "/.manifest")
+		},
+		IsGeneratedFile: func(path string) bool {
+			return strings.HasSuffix(path, "_gen.go")
+		},
+		IsScaffoldingFile: func(path string) bool {
+			return strings.HasSuffix(path, "/registration.go")
+		},
+	})
+	if err != nil {
+		t.Fatalf("comparing: %+v", err)
+	}
+
+	expected := []FileChange{
+		{Path: "services/compute/added_gen.go", Type: AddedChangeType},
+		{Path: "services/compute/changed_gen.go", Type: ChangedChangeType},
+		{Path: "services/compute/removed_gen.go", Type: RemovedChangeType},
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d changes but got %d: %+v", len(expected), len(actual), actual)
+	}
+	for i, change := range expected {
+		if actual[i].Path != change.Path || actual[i].Type != change.Type {
+			t.Fatalf("expected change %d to be %s %q but got %s %q", i, change.Type, change.Path, actual[i].Type, actual[i].Path)
+		}
+	}
+	if !strings.Contains(actual[1].Diff, "-var a = 1\n+var a = 2\n") {
+		t.Fatalf("unexpected diff for the changed file:\n%s", actual[1].Diff)
+	}
+	if summary := Summary(actual); summary != "1 files would be added, 1 changed and 1 removed by regenerating" {
+		t.Fatalf("unexpected summary %q", summary)
+	}
+}
+
+func writeTestFiles(t *testing.T, directory string, files map[string]string) {
+	for path, contents := range files {
+		fullPath := filepath.Join(directory, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(fullPath), 0755); err != nil {
+			t.Fatalf("creating directory for %q: %+v", fullPath, err)
+		}
+		if err := os.WriteFile(fullPath, []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", fullPath, err)
+		}
+	}
+}
+
+func TestUnifiedDiff_NoTrailingNewline(t *testing.T) {
+	expected := strings.Join([]string{
+		"--- a",
+		"+++ b",
+		"@@ -1,2 +1,2 @@",
+		" package example",
+		"-// comment",
+		"\\ No newline at end of file",
+		"+// comment",
+		"",
+	}, "\n")
+	actual := UnifiedDiff("a", "b", "package example\n// comment", "package example\n// comment\n")
+	if actual != expected {
+		t.Fatalf("expected:\n%s\nbut got:\n%s", expected, actual)
+	}
+}
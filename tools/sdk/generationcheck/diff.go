@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package generationcheck
+
+import (
+	"fmt"
+	"strings"
+)
+
+// contextLines is the number of unchanged lines output around each change in a Unified Diff.
+const contextLines = 3
+
+// maxLineComparisons is the maximum size of the table used to determine the changed lines - beyond which the
+// changed section of the file is output as removed and then added in full, rather than line by line.
+const maxLineComparisons = 4 * 1024 * 1024
+
+type diffOperation struct {
+	// kind is either ' ' (unchanged), '-' (removed) or '+' (added)
+	kind byte
+
+	// line is the line in question, without the trailing newline
+	line string
+
+	// fromLine and toLine are the (1-based) line numbers for this line in the original and updated files
+	fromLine int
+	toLine   int
+}
+
+// UnifiedDiff returns a Unified Diff (in the same format as `diff -u`) between the two values, labelled using
+// fromName and toName. An empty string is returned when the values are the same.
+func UnifiedDiff(fromName, toName, from, to string) string {
+	if from == to {
+		return ""
+	}
+
+	operations := diffOperations(splitLines(from), splitLines(to))
+
+	output := strings.Builder{}
+	output.WriteString(fmt.Sprintf("--- %s\n+++ %s\n", fromName, toName))
+	for _, hunk := range hunksForOperations(operations) {
+		output.WriteString(hunk)
+	}
+	return output.String()
+}
+
+func splitLines(input string) []string {
+	if input == "" {
+		return []string{}
+	}
+	lines := strings.Split(strings.TrimSuffix(input, "\n"), "\n")
+	if !strings.HasSuffix(input, "\n") {
+		// as with `diff -u` the lack of a trailing newline is called out - which also means that this line
+		// differs from the same line with a trailing newline
+		lines[len(lines)-1] += "\n\\ No newline at end of file"
+	}
+	return lines
+}
+
+// diffOperations returns the operations required to turn from into to, using the Longest Common Subsequence
+// of lines (after skipping any common prefix/suffix, since generated files tend to differ in a few places).
+func diffOperations(from, to []string) []diffOperation {
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix && from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+
+	output := make([]diffOperation, 0)
+	fromLine, toLine := 0, 0
+	appendOperation := func(kind byte, line string) {
+		operation := diffOperation{
+			kind: kind,
+			line: line,
+		}
+		if kind != '+' {
+			fromLine++
+			operation.fromLine = fromLine
+		}
+		if kind != '-' {
+			toLine++
+			operation.toLine = toLine
+		}
+		output = append(output, operation)
+	}
+
+	for _, line := range from[:prefix] {
+		appendOperation(' ', line)
+	}
+
+	fromMiddle := from[prefix : len(from)-suffix]
+	toMiddle := to[prefix : len(to)-suffix]
+	if len(fromMiddle)*len(toMiddle) > maxLineComparisons {
+		for _, line := range fromMiddle {
+			appendOperation('-', line)
+		}
+		for _, line := range toMiddle {
+			appendOperation('+', line)
+		}
+	} else {
+		// lengths[i][j] is the length of the LCS of fromMiddle[i:] and toMiddle[j:]
+		lengths := make([][]int32, len(fromMiddle)+1)
+		for i := range lengths {
+			lengths[i] = make([]int32, len(toMiddle)+1)
+		}
+		for i := len(fromMiddle) - 1; i >= 0; i-- {
+			for j := len(toMiddle) - 1; j >= 0; j-- {
+				if fromMiddle[i] == toMiddle[j] {
+					lengths[i][j] = lengths[i+1][j+1] + 1
+				} else if lengths[i+1][j] >= lengths[i][j+1] {
+					lengths[i][j] = lengths[i+1][j]
+				} else {
+					lengths[i][j] = lengths[i][j+1]
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(fromMiddle) || j < len(toMiddle) {
+			switch {
+			case i < len(fromMiddle) && j < len(toMiddle) && fromMiddle[i] == toMiddle[j]:
+				appendOperation(' ', fromMiddle[i])
+				i++
+				j++
+			case j == len(toMiddle) || (i < len(fromMiddle) && lengths[i+1][j] >= lengths[i][j+1]):
+				appendOperation('-', fromMiddle[i])
+				i++
+			default:
+				appendOperation('+', toMiddle[j])
+				j++
+			}
+		}
+	}
+
+	for _, line := range from[len(from)-suffix:] {
+		appendOperation(' ', line)
+	}
+
+	return output
+}
+
+// hunksForOperations groups the changed lines into hunks, each surrounded by up to contextLines unchanged lines.
+func hunksForOperations(operations []diffOperation) []string {
+	output := make([]string, 0)
+
+	i := 0
+	for i < len(operations) {
+		if operations[i].kind == ' ' {
+			i++
+			continue
+		}
+
+		// this hunk starts with the context before the first change and continues until there's a run of more
+		// than two sets of context lines without any changes (or the end of the file)
+		start := max(i-contextLines, 0)
+		end := i
+		for end < len(operations) {
+			if operations[end].kind != ' ' {
+				end++
+				continue
+			}
+			nextChange := end
+			for nextChange < len(operations) && operations[nextChange].kind == ' ' {
+				nextChange++
+			}
+			if nextChange == len(operations) || nextChange-end > 2*contextLines {
+				end = min(end+contextLines, len(operations))
+				break
+			}
+			end = nextChange
+		}
+
+		output = append(output, hunkFor(operations[start:end]))
+		i = end
+	}
+
+	return output
+}
+
+func hunkFor(operations []diffOperation) string {
+	fromStart, fromCount := 0, 0
+	toStart, toCount := 0, 0
+	lines := make([]string, 0)
+	for _, operation := range operations {
+		if operation.kind != '+' {
+			if fromCount == 0 {
+				fromStart = operation.fromLine
+			}
+			fromCount++
+		}
+		if operation.kind != '-' {
+			if toCount == 0 {
+				toStart = operation.toLine
+			}
+			toCount++
+		}
+		lines = append(lines, fmt.Sprintf("%c%s\n", operation.kind, operation.line))
+	}
+
+	// since each hunk contains the surrounding unchanged lines, a side can only be empty when that file is empty
+	// in which case (as with `diff -u`) the range is output as `0,0`
+	return fmt.Sprintf("@@ -%s +%s @@\n%s", hunkRange(fromStart, fromCount), hunkRange(toStart, toCount), strings.Join(lines, ""))
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return "0,0"
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start)
+	}
+	return fmt.Sprintf("%d,%d", start, count)
+}
@@ -46,6 +46,7 @@ func (c GoSDKGenerator) Run(args []string) int {
 
 	pipelineOpts := pipeline.Options{
 		APIDefinitionsDirectory: opts.apiDefinitionsDirectory,
+		DataSource:              pipeline.DataSource(opts.dataSource),
 		Logger:                  c.logger,
 		OutputDirectory:         opts.outputDirectory,
 		SourceDataType:          c.sourceDataType,
@@ -8,12 +8,18 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+
+	"github.com/hashicorp/pandora/tools/wrapper-automation/internal/pipeline"
 )
 
 type options struct {
 	// apiDefinitionsDirectory specifies the directory where the API Definitions are located.
 	apiDefinitionsDirectory string
 
+	// dataSource specifies where the generators should retrieve the API Definitions from, either `endpoint`
+	// (launching an instance of the Data API) or `directory` (reading these directly from disk).
+	dataSource string
+
 	// outputDirectory specifies the output directory where the Go SDK should be generated.
 	outputDirectory string
 }
@@ -23,6 +29,10 @@ func (o options) validate() error {
 		return fmt.Errorf("'api-definitions-dir' must be specified")
 	}
 
+	if o.dataSource != string(pipeline.DirectoryDataSource) && o.dataSource != string(pipeline.EndpointDataSource) {
+		return fmt.Errorf("'data-source' must be either %q or %q but got %q", string(pipeline.EndpointDataSource), string(pipeline.DirectoryDataSource), o.dataSource)
+	}
+
 	abs, err := filepath.Abs(o.apiDefinitionsDirectory)
 	if err != nil {
 		return fmt.Errorf("determining absolute path to %q: %+v", o.apiDefinitionsDirectory, err)
@@ -45,6 +55,7 @@ func parseCLIArguments(args []string) (*options, error) {
 	f := flag.NewFlagSet("wrapper-automation", flag.ExitOnError)
 	f.StringVar(&o.apiDefinitionsDirectory, "api-definitions-dir", "", "--api-definitions-dir=./api-definitions")
 	f.StringVar(&o.outputDirectory, "output-dir", "", "--output-dir=../output")
+	f.StringVar(&o.dataSource, "data-source", string(pipeline.EndpointDataSource), "--data-source=directory (either `endpoint` to launch the Data API, or `directory` to read the API Definitions from disk)")
 
 	if err := f.Parse(args); err != nil {
 		return nil, fmt.Errorf("parsing cli arguments: %+v", err)
@@ -11,16 +11,16 @@ import (
 	"os/exec"
 )
 
-// RunGoSDKGenerator launches an instance of the Data API and runs the Go SDK Generator against it.
+// RunGoSDKGenerator launches an instance of the Data API (unless reading the API Definitions directly from disk)
+// and runs the Go SDK Generator against it.
 func RunGoSDKGenerator(ctx context.Context, opts Options) error {
 	pipeline := Pipeline{
 		logger: opts.Logger,
 	}
 	defer pipeline.close()
 
-	pipeline.logger.Info("Launching the Data API..")
-	if err := pipeline.launchDataAPI(ctx, opts); err != nil {
-		return fmt.Errorf("launching the Data API: %+v", err)
+	if err := pipeline.prepareDataSource(ctx, opts); err != nil {
+		return fmt.Errorf("preparing the Data Source: %+v", err)
 	}
 
 	pipeline.logger.Info("Running the Go SDK Generator..")
@@ -32,16 +32,17 @@ func RunGoSDKGenerator(ctx context.Context, opts Options) error {
 	return nil
 }
 
-// runGoSDKGenerator runs the Go SDK Generator tool against the running instance of the Data API.
+// runGoSDKGenerator runs the Go SDK Generator tool against the configured Data Source.
 func (p *Pipeline) runGoSDKGenerator(opts Options) error {
-	if p.endpoint == nil {
-		return fmt.Errorf("internal-error: the Data API is not running")
+	dataSourceArgument, err := p.dataSourceArgument(opts)
+	if err != nil {
+		return err
 	}
 
 	args := []string{
 		string(opts.SourceDataType),
 		"generate",
-		fmt.Sprintf("-data-api=%s", *p.endpoint),
+		*dataSourceArgument,
 	}
 	if opts.OutputDirectory != "" {
 		args = append(args, fmt.Sprintf("-output-dir=%s", opts.OutputDirectory))
@@ -32,10 +32,24 @@ type Pipeline struct {
 	logger hclog.Logger
 }
 
+type DataSource string
+
+const (
+	// DirectoryDataSource specifies that the generators should read the API Definitions directly from disk.
+	DirectoryDataSource DataSource = "directory"
+
+	// EndpointDataSource specifies that an instance of the Data API should be launched, which the generators
+	// then retrieve the API Definitions from.
+	EndpointDataSource DataSource = "endpoint"
+)
+
 type Options struct {
 	// APIDefinitionsDirectory specifies the path to the directory containing the API Definitions (e.g. `./api-definitions`).
 	APIDefinitionsDirectory string
 
+	// DataSource specifies where the generators should retrieve the API Definitions from.
+	DataSource DataSource
+
 	// Logger is an instance of a Logger, used for debugging purposes.
 	Logger hclog.Logger
 
@@ -73,6 +87,35 @@ func (p *Pipeline) launchDataAPI(ctx context.Context, opts Options) error {
 	return nil
 }
 
+// prepareDataSource launches the Data API when the API Definitions should be retrieved from the Data API,
+// otherwise the generators read the API Definitions directly from disk and there's nothing to do.
+func (p *Pipeline) prepareDataSource(ctx context.Context, opts Options) error {
+	if opts.DataSource == DirectoryDataSource {
+		p.logger.Info(fmt.Sprintf("Reading the API Definitions directly from %q..", opts.APIDefinitionsDirectory))
+		return nil
+	}
+
+	p.logger.Info("Launching the Data API..")
+	if err := p.launchDataAPI(ctx, opts); err != nil {
+		return fmt.Errorf("launching the Data API: %+v", err)
+	}
+
+	return nil
+}
+
+// dataSourceArgument returns the command-line argument used to tell the generators where the API Definitions
+// should be retrieved from.
+func (p *Pipeline) dataSourceArgument(opts Options) (*string, error) {
+	if opts.DataSource == DirectoryDataSource {
+		return pointer.To(fmt.Sprintf("-data-directory=%s", opts.APIDefinitionsDirectory)), nil
+	}
+
+	if p.endpoint == nil {
+		return nil, fmt.Errorf("internal-error: the Data API is not running")
+	}
+	return pointer.To(fmt.Sprintf("-data-api=%s", *p.endpoint)), nil
+}
+
 // waitForDataAPIToBecomeAvailable waits for the Data API to be available
 // This means that we will try hitting the `/health` endpoint for 30s, if it's not loaded
 // after this time then there's likely a data issue.
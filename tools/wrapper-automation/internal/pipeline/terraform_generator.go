@@ -11,16 +11,16 @@ import (
 	"os/exec"
 )
 
-// RunTerraformGenerator launches the Data API and then runs the Terraform Generator using that.
+// RunTerraformGenerator launches the Data API (unless reading the API Definitions directly from disk) and then
+// runs the Terraform Generator using that.
 func RunTerraformGenerator(ctx context.Context, opts Options) error {
 	pipeline := Pipeline{
 		logger: opts.Logger,
 	}
 	defer pipeline.close()
 
-	pipeline.logger.Info("Launching the Data API..")
-	if err := pipeline.launchDataAPI(ctx, opts); err != nil {
-		return fmt.Errorf("launching the Data API: %+v", err)
+	if err := pipeline.prepareDataSource(ctx, opts); err != nil {
+		return fmt.Errorf("preparing the Data Source: %+v", err)
 	}
 
 	pipeline.logger.Info("Running the Terraform Generator..")
@@ -32,16 +32,17 @@ func RunTerraformGenerator(ctx context.Context, opts Options) error {
 	return nil
 }
 
-// runTerraformGenerator runs the Terraform Generator tool against the running instance of the Data API.
+// runTerraformGenerator runs the Terraform Generator tool against the configured Data Source.
 func (p *Pipeline) runTerraformGenerator(opts Options) error {
-	if p.endpoint == nil {
-		return fmt.Errorf("internal-error: the Data API is not running")
+	dataSourceArgument, err := p.dataSourceArgument(opts)
+	if err != nil {
+		return err
 	}
 
 	args := []string{
 		string(opts.SourceDataType),
 		"generate",
-		fmt.Sprintf("-data-api=%s", *p.endpoint),
+		*dataSourceArgument,
 	}
 	if opts.OutputDirectory != "" {
 		args = append(args, fmt.Sprintf("-output-dir=%s", opts.OutputDirectory))